@@ -0,0 +1,124 @@
+package binding
+
+import (
+	"reflect"
+
+	"github.com/blizzy78/ebitenui/event"
+)
+
+// Bindable is an observable value. Its events are fired immediately, so that all bound widgets and
+// values are kept in sync synchronously.
+type Bindable[T any] struct {
+	// ChangedEvent fires an event with *BindableChangedEventArgs[T] when the value changes.
+	ChangedEvent *event.Of[*BindableChangedEventArgs[T]]
+
+	// InvalidEvent fires an event with *BindableInvalidEventArgs[T] when Set is called with a value
+	// that is rejected by a validator.
+	InvalidEvent *event.Of[*BindableInvalidEventArgs[T]]
+
+	value      *T
+	validators []ValidatorFunc[T]
+	err        error
+}
+
+// BindableChangedEventArgs are the arguments of a Bindable's ChangedEvent.
+type BindableChangedEventArgs[T any] struct {
+	Bindable      *Bindable[T]
+	Value         T
+	PreviousValue T
+}
+
+// BindableChangedHandlerFunc is a function that handles a Bindable's ChangedEvent.
+type BindableChangedHandlerFunc[T any] func(args *BindableChangedEventArgs[T])
+
+// BindableInvalidEventArgs are the arguments of a Bindable's InvalidEvent.
+type BindableInvalidEventArgs[T any] struct {
+	Bindable *Bindable[T]
+	Value    T
+	Err      error
+}
+
+// BindableInvalidHandlerFunc is a function that handles a Bindable's InvalidEvent.
+type BindableInvalidHandlerFunc[T any] func(args *BindableInvalidEventArgs[T])
+
+// ValidatorFunc is a function that validates value v. It returns an error if v is invalid.
+type ValidatorFunc[T any] func(v T) error
+
+// NewBindable constructs a new Bindable that holds value v.
+func NewBindable[T any](v T) *Bindable[T] {
+	return NewBindableFor(&v)
+}
+
+// NewBindableFor constructs a new Bindable that reads and writes the value that p points to, for example
+// a field of a settings struct. The value should not be changed other than through the Bindable.
+func NewBindableFor[T any](p *T) *Bindable[T] {
+	return &Bindable[T]{
+		ChangedEvent: &event.Of[*BindableChangedEventArgs[T]]{
+			Immediate: true,
+		},
+
+		InvalidEvent: &event.Of[*BindableInvalidEventArgs[T]]{
+			Immediate: true,
+		},
+
+		value: p,
+	}
+}
+
+// AddValidator adds validator f to b. Validators are called by Set, in the order they have been added.
+func (b *Bindable[T]) AddValidator(f ValidatorFunc[T]) {
+	b.validators = append(b.validators, f)
+}
+
+// Get returns b's current value.
+func (b *Bindable[T]) Get() T {
+	return *b.value
+}
+
+// Set validates v and sets b's value to v. If a validator rejects v, b's value is not changed, InvalidEvent
+// is fired, and the validator's error is returned. ChangedEvent is only fired if v is different from the
+// current value.
+func (b *Bindable[T]) Set(v T) error {
+	if err := b.validate(v); err != nil {
+		b.err = err
+
+		b.InvalidEvent.Fire(&BindableInvalidEventArgs[T]{
+			Bindable: b,
+			Value:    v,
+			Err:      err,
+		})
+
+		return err
+	}
+
+	b.err = nil
+
+	prev := *b.value
+	if reflect.DeepEqual(v, prev) {
+		return nil
+	}
+
+	*b.value = v
+
+	b.ChangedEvent.Fire(&BindableChangedEventArgs[T]{
+		Bindable:      b,
+		Value:         v,
+		PreviousValue: prev,
+	})
+
+	return nil
+}
+
+// Err returns the error of the last call to Set, or nil if the last value passed to Set was valid.
+func (b *Bindable[T]) Err() error {
+	return b.err
+}
+
+func (b *Bindable[T]) validate(v T) error {
+	for _, f := range b.validators {
+		if err := f(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package binding
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestBindable_Set(t *testing.T) {
+	is := is.New(t)
+
+	b := NewBindable(1)
+
+	changed := []*BindableChangedEventArgs[int]{}
+	b.ChangedEvent.AddHandler(func(args *BindableChangedEventArgs[int]) {
+		changed = append(changed, args)
+	})
+
+	is.NoErr(b.Set(2))
+	is.NoErr(b.Set(2))
+
+	is.Equal(b.Get(), 2)
+	is.Equal(len(changed), 1)
+	is.Equal(changed[0].Value, 2)
+	is.Equal(changed[0].PreviousValue, 1)
+}
+
+func TestBindable_Set_Validator(t *testing.T) {
+	is := is.New(t)
+
+	b := NewBindable(5)
+	b.AddValidator(Range(0, 10))
+
+	var invalid *BindableInvalidEventArgs[int]
+	b.InvalidEvent.AddHandler(func(args *BindableInvalidEventArgs[int]) {
+		invalid = args
+	})
+
+	err := b.Set(11)
+	is.True(errors.Is(err, ErrInvalid))
+	is.Equal(b.Err(), err)
+	is.Equal(b.Get(), 5)
+	is.Equal(invalid.Value, 11)
+	is.Equal(invalid.Err, err)
+
+	is.NoErr(b.Set(10))
+	is.NoErr(b.Err())
+	is.Equal(b.Get(), 10)
+}
+
+func TestNewBindableFor(t *testing.T) {
+	is := is.New(t)
+
+	settings := struct {
+		Name string
+	}{
+		Name: "foo",
+	}
+
+	b := NewBindableFor(&settings.Name)
+	is.Equal(b.Get(), "foo")
+
+	is.NoErr(b.Set("bar"))
+	is.Equal(settings.Name, "bar")
+}
@@ -0,0 +1,140 @@
+package binding
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/blizzy78/ebitenui/widget"
+)
+
+// Converter converts values between a Bindable and a widget property of a different type.
+type Converter[T any, W any] struct {
+	// To converts value v of the Bindable to a value of the widget property.
+	To func(v T) W
+
+	// From converts value v of the widget property back to a value of the Bindable. It returns
+	// an error if v cannot be converted.
+	From func(v W) (T, error)
+}
+
+// ErrConversion is returned if a value cannot be converted.
+var ErrConversion = errors.New("conversion failed")
+
+// Convert returns a new Bindable that is kept in sync with b in both directions, converting values using c.
+//
+// Values that cannot be converted back to b's type, or that are rejected by b's validators, are also
+// rejected by the returned Bindable.
+func Convert[T any, W any](b *Bindable[T], c Converter[T, W]) *Bindable[W] {
+	w := NewBindable(c.To(b.Get()))
+
+	w.AddValidator(func(v W) error {
+		t, err := c.From(v)
+		if err != nil {
+			return err
+		}
+		return b.validate(t)
+	})
+
+	b.ChangedEvent.AddHandler(func(args *BindableChangedEventArgs[T]) {
+		_ = w.Set(c.To(args.Value))
+	})
+
+	w.ChangedEvent.AddHandler(func(args *BindableChangedEventArgs[W]) {
+		if t, err := c.From(args.Value); err == nil {
+			_ = b.Set(t)
+		}
+	})
+
+	return w
+}
+
+// IntString returns a Converter that converts ints to decimal strings, for example to bind an int to a TextInput.
+func IntString() Converter[int, string] {
+	return Converter[int, string]{
+		To: strconv.Itoa,
+
+		From: func(v string) (int, error) {
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				return 0, fmt.Errorf("%w: not a number: %s", ErrConversion, v)
+			}
+			return i, nil
+		},
+	}
+}
+
+// FloatString returns a Converter that converts float64s to strings, using format and precision prec,
+// as in strconv.FormatFloat.
+func FloatString(format byte, prec int) Converter[float64, string] {
+	return Converter[float64, string]{
+		To: func(v float64) string {
+			return strconv.FormatFloat(v, format, prec, 64)
+		},
+
+		From: func(v string) (float64, error) {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return 0, fmt.Errorf("%w: not a number: %s", ErrConversion, v)
+			}
+			return f, nil
+		},
+	}
+}
+
+// FloatInt returns a Converter that converts float64s to ints by multiplying them with scale, for example
+// to bind a volume between 0 and 1 to a Slider ranging from 0 to 100.
+func FloatInt(scale float64) Converter[float64, int] {
+	return Converter[float64, int]{
+		To: func(v float64) int {
+			return int(math.Round(v * scale))
+		},
+
+		From: func(v int) (float64, error) {
+			return float64(v) / scale, nil
+		},
+	}
+}
+
+// BoolCheckboxState returns a Converter that converts bools to checkbox states, to bind a bool to a
+// non-tri state Checkbox.
+func BoolCheckboxState() Converter[bool, widget.CheckboxState] {
+	return Converter[bool, widget.CheckboxState]{
+		To: func(v bool) widget.CheckboxState {
+			if v {
+				return widget.CheckboxChecked
+			}
+			return widget.CheckboxUnchecked
+		},
+
+		From: func(v widget.CheckboxState) (bool, error) {
+			switch v {
+			case widget.CheckboxChecked:
+				return true, nil
+			case widget.CheckboxUnchecked:
+				return false, nil
+			default:
+				return false, fmt.Errorf("%w: not a bool: %v", ErrConversion, v)
+			}
+		},
+	}
+}
+
+// Entry returns a Converter that converts values of type T to list entries, to bind a value to a List
+// whose entries are of type T.
+func Entry[T any]() Converter[T, interface{}] {
+	return Converter[T, interface{}]{
+		To: func(v T) interface{} {
+			return v
+		},
+
+		From: func(v interface{}) (T, error) {
+			t, ok := v.(T)
+			if !ok {
+				return t, fmt.Errorf("%w: unexpected entry: %v", ErrConversion, v)
+			}
+			return t, nil
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package binding
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/blizzy78/ebitenui/widget"
+
+	"github.com/matryer/is"
+)
+
+func TestConvert(t *testing.T) {
+	is := is.New(t)
+
+	b := NewBindable(5)
+	b.AddValidator(Range(0, 10))
+
+	s := Convert(b, IntString())
+	is.Equal(s.Get(), "5")
+
+	is.NoErr(b.Set(7))
+	is.Equal(s.Get(), "7")
+
+	is.NoErr(s.Set("3"))
+	is.Equal(b.Get(), 3)
+
+	err := s.Set("x")
+	is.True(errors.Is(err, ErrConversion))
+	is.Equal(s.Get(), "3")
+
+	err = s.Set("11")
+	is.True(errors.Is(err, ErrInvalid))
+	is.Equal(s.Get(), "3")
+	is.Equal(b.Get(), 3)
+}
+
+func TestFloatInt(t *testing.T) {
+	is := is.New(t)
+
+	b := NewBindable(0.5)
+	i := Convert(b, FloatInt(100))
+	is.Equal(i.Get(), 50)
+
+	is.NoErr(i.Set(25))
+	is.Equal(b.Get(), 0.25)
+}
+
+func TestBoolCheckboxState(t *testing.T) {
+	is := is.New(t)
+
+	b := NewBindable(true)
+	s := Convert(b, BoolCheckboxState())
+	is.Equal(s.Get(), widget.CheckboxChecked)
+
+	is.NoErr(s.Set(widget.CheckboxUnchecked))
+	is.Equal(b.Get(), false)
+
+	is.True(errors.Is(s.Set(widget.CheckboxGreyed), ErrConversion))
+}
+
+func TestEntry(t *testing.T) {
+	is := is.New(t)
+
+	b := NewBindable("foo")
+	e := Convert(b, Entry[string]())
+	is.Equal(e.Get(), "foo")
+
+	is.NoErr(e.Set("bar"))
+	is.Equal(b.Get(), "bar")
+
+	is.True(errors.Is(e.Set(1), ErrConversion))
+}
@@ -0,0 +1,7 @@
+// Package binding provides observable values and two-way data binding between those values and widget
+// properties, such as a slider's current value or a text input's text.
+//
+// A Bindable holds a value, or points to a value, for example a field of a settings struct. Binders
+// such as SliderCurrent keep a widget property and a Bindable in sync in both directions. Convert can be
+// used to bind values of a different type, and validators can be added to reject invalid values.
+package binding
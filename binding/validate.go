@@ -0,0 +1,38 @@
+package binding
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ordered is a constraint for types that support the < and > operators.
+type ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+// ErrInvalid is returned by validators if a value is invalid.
+var ErrInvalid = errors.New("invalid value")
+
+// Range returns a validator that rejects values that are lower than min or greater than max.
+func Range[T ordered](min T, max T) ValidatorFunc[T] {
+	return func(v T) error {
+		if v < min || v > max {
+			return fmt.Errorf("%w: %v is not between %v and %v", ErrInvalid, v, min, max)
+		}
+		return nil
+	}
+}
+
+// NotBlank returns a validator that rejects strings that are empty or only contain white space.
+func NotBlank() ValidatorFunc[string] {
+	return func(v string) error {
+		if strings.TrimSpace(v) == "" {
+			return fmt.Errorf("%w: must not be blank", ErrInvalid)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,89 @@
+package binding
+
+import (
+	"github.com/blizzy78/ebitenui/event"
+	"github.com/blizzy78/ebitenui/widget"
+)
+
+// Binding is a two-way binding between a Bindable and a widget property.
+type Binding struct {
+	removeHandlers []event.RemoveHandlerFunc
+}
+
+// SliderCurrent binds s's current value to b. s.Current is set to b's value immediately.
+func SliderCurrent(s *widget.Slider, b *Bindable[int]) *Binding {
+	return bind(b,
+		func(v int) {
+			s.Current = v
+		},
+
+		func(f func(v int)) event.RemoveHandlerFunc {
+			return s.ChangedEvent.AddHandler(func(args *widget.SliderChangedEventArgs) {
+				f(args.Current)
+			})
+		})
+}
+
+// CheckboxState binds c's state to b. c's state is set to b's value immediately.
+// BoolCheckboxState can be used to bind a bool.
+func CheckboxState(c *widget.Checkbox, b *Bindable[widget.CheckboxState]) *Binding {
+	return bind(b, c.SetState,
+		func(f func(v widget.CheckboxState)) event.RemoveHandlerFunc {
+			return c.ChangedEvent.AddHandler(func(args *widget.CheckboxChangedEventArgs) {
+				f(args.State)
+			})
+		})
+}
+
+// TextInputText binds t's input text to b. t.InputText is set to b's value immediately.
+//
+// If b rejects the text entered by the user, t keeps the text, and b keeps its value.
+func TextInputText(t *widget.TextInput, b *Bindable[string]) *Binding {
+	return bind(b,
+		func(v string) {
+			t.InputText = v
+		},
+
+		func(f func(v string)) event.RemoveHandlerFunc {
+			return t.ChangedEvent.AddHandler(func(args *widget.TextInputChangedEventArgs) {
+				f(args.InputText)
+			})
+		})
+}
+
+// ListSelectedEntry binds l's selected entry to b. l's selected entry is set to b's value immediately.
+// Entry can be used to bind a value of the list's entry type.
+func ListSelectedEntry(l *widget.List, b *Bindable[interface{}]) *Binding {
+	return bind(b, l.SetSelectedEntry,
+		func(f func(v interface{})) event.RemoveHandlerFunc {
+			return l.EntrySelectedEvent.AddHandler(func(args *widget.ListEntrySelectedEventArgs) {
+				f(args.Entry)
+			})
+		})
+}
+
+// Unbind removes the binding. The widget property and the Bindable are no longer kept in sync.
+func (b *Binding) Unbind() {
+	for _, r := range b.removeHandlers {
+		r()
+	}
+	b.removeHandlers = nil
+}
+
+// bind sets the widget property to b's value using set, and keeps it in sync with b in both directions.
+// addChangedHandler must add a handler to the widget's change event that calls f with the new value.
+func bind[T any](b *Bindable[T], set func(v T), addChangedHandler func(f func(v T)) event.RemoveHandlerFunc) *Binding {
+	set(b.Get())
+
+	return &Binding{
+		removeHandlers: []event.RemoveHandlerFunc{
+			b.ChangedEvent.AddHandler(func(args *BindableChangedEventArgs[T]) {
+				set(args.Value)
+			}),
+
+			addChangedHandler(func(v T) {
+				_ = b.Set(v)
+			}),
+		},
+	}
+}
@@ -0,0 +1,111 @@
+package binding
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/blizzy78/ebitenui/event"
+	"github.com/blizzy78/ebitenui/widget"
+
+	"github.com/matryer/is"
+)
+
+func TestSliderCurrent(t *testing.T) {
+	is := is.New(t)
+
+	s := widget.NewSlider(widget.SliderOpts.MinMax(0, 100))
+	b := NewBindable(10)
+	SliderCurrent(s, b)
+	is.Equal(s.Current, 10)
+
+	is.NoErr(b.Set(20))
+	is.Equal(s.Current, 20)
+
+	s.Current = 30
+	s.ChangedEvent.Fire(&widget.SliderChangedEventArgs{
+		Slider:  s,
+		Current: s.Current,
+	})
+	event.ExecuteDeferred()
+	is.Equal(b.Get(), 30)
+}
+
+func TestCheckboxState(t *testing.T) {
+	is := is.New(t)
+
+	settings := struct {
+		Fullscreen bool
+	}{}
+
+	c := widget.NewCheckbox()
+	CheckboxState(c, Convert(NewBindableFor(&settings.Fullscreen), BoolCheckboxState()))
+	event.ExecuteDeferred()
+	is.Equal(c.State(), widget.CheckboxUnchecked)
+
+	c.SetState(widget.CheckboxChecked)
+	event.ExecuteDeferred()
+	is.True(settings.Fullscreen)
+}
+
+func TestTextInputText(t *testing.T) {
+	is := is.New(t)
+
+	ti := widget.NewTextInput()
+	b := NewBindable(5)
+	b.AddValidator(Range(0, 10))
+	bnd := TextInputText(ti, Convert(b, IntString()))
+	event.ExecuteDeferred()
+	is.Equal(ti.InputText, "5")
+
+	textInputChanged(ti, "7")
+	is.Equal(b.Get(), 7)
+
+	textInputChanged(ti, "70")
+	is.Equal(b.Get(), 7)
+	is.Equal(ti.InputText, "70")
+
+	bnd.Unbind()
+	event.ExecuteDeferred()
+
+	textInputChanged(ti, "3")
+	is.Equal(b.Get(), 7)
+}
+
+func TestListSelectedEntry(t *testing.T) {
+	is := is.New(t)
+
+	l := widget.NewList(
+		widget.ListOpts.Entries([]interface{}{"a", "b", "c"}),
+		widget.ListOpts.EntryLabelFunc(func(e interface{}) string {
+			return e.(string)
+		}),
+		widget.ListOpts.EntryColor(&widget.ListEntryColor{
+			Unselected:                 color.Transparent,
+			Selected:                   color.Transparent,
+			DisabledUnselected:         color.Transparent,
+			DisabledSelected:           color.Transparent,
+			SelectedBackground:         color.Transparent,
+			DisabledSelectedBackground: color.Transparent,
+		}))
+	b := NewBindable("b")
+	ListSelectedEntry(l, Convert(b, Entry[string]()))
+	event.ExecuteDeferred()
+	is.Equal(l.SelectedEntry(), "b")
+
+	l.SetSelectedEntry("c")
+	event.ExecuteDeferred()
+	is.Equal(b.Get(), "c")
+
+	is.NoErr(b.Set("a"))
+	is.Equal(l.SelectedEntry(), "a")
+}
+
+// textInputChanged sets t's input text to s and fires t's ChangedEvent, as if the user entered s.
+func textInputChanged(t *widget.TextInput, s string) {
+	t.InputText = s
+	t.ChangedEvent.Fire(&widget.TextInputChangedEventArgs{
+		TextInput: t,
+		InputText: s,
+	})
+	event.ExecuteDeferred()
+}
@@ -35,25 +35,23 @@ const (
 	separatorColor = listDisabledSelectedBackground
 )
 
+// UiResources contains the theme of the demo, and the resources of widgets that cannot be themed.
 type UiResources struct {
 	Fonts *Fonts
 
+	Theme *widget.Theme
+
 	Background *image.NineSlice
 
 	SeparatorColor color.Color
 
-	Text        *TextResources
-	Button      *buttonResources
-	Label       *labelResources
-	Checkbox    *checkboxResources
-	ComboButton *comboButtonResources
-	List        *listResources
-	Slider      *sliderResources
-	Panel       *panelResources
-	TabBook     *tabBookResources
-	Header      *headerResources
-	TextInput   *textInputResources
-	ToolTip     *toolTipResources
+	Text     *TextResources
+	Checkbox *checkboxResources
+	List     *listResources
+	Slider   *sliderResources
+	Panel    *panelResources
+	Header   *headerResources
+	ToolTip  *toolTipResources
 }
 
 type TextResources struct {
@@ -65,46 +63,16 @@ type TextResources struct {
 	SmallFace     font.Face
 }
 
-type buttonResources struct {
-	Image   *widget.ButtonImage
-	Text    *widget.ButtonTextColor
-	Face    font.Face
-	Padding widget.Insets
-}
-
 type checkboxResources struct {
-	Image   *widget.ButtonImage
-	Graphic *widget.CheckboxGraphicImage
 	Spacing int
 }
 
-type labelResources struct {
-	Text *widget.LabelColor
-	Face font.Face
-}
-
-type comboButtonResources struct {
-	Image   *widget.ButtonImage
-	Text    *widget.ButtonTextColor
-	Face    font.Face
-	Graphic *widget.ButtonImageImage
-	Padding widget.Insets
-}
-
 type listResources struct {
-	Image        *widget.ScrollContainerImage
-	Track        *widget.SliderTrackImage
 	TrackPadding widget.Insets
-	Handle       *widget.ButtonImage
 	HandleSize   int
-	Face         font.Face
-	Entry        *widget.ListEntryColor
-	EntryPadding widget.Insets
 }
 
 type sliderResources struct {
-	TrackImage *widget.SliderTrackImage
-	Handle     *widget.ButtonImage
 	HandleSize int
 }
 
@@ -113,14 +81,6 @@ type panelResources struct {
 	Padding widget.Insets
 }
 
-type tabBookResources struct {
-	IdleButton     *widget.ButtonImage
-	SelectedButton *widget.ButtonImage
-	ButtonFace     font.Face
-	ButtonText     *widget.ButtonTextColor
-	ButtonPadding  widget.Insets
-}
-
 type headerResources struct {
 	Background *image.NineSlice
 	Padding    widget.Insets
@@ -128,13 +88,6 @@ type headerResources struct {
 	Color      color.Color
 }
 
-type textInputResources struct {
-	Image   *widget.TextInputImage
-	Padding widget.Insets
-	Face    font.Face
-	Color   *widget.TextInputColor
-}
-
 type toolTipResources struct {
 	background *image.NineSlice
 	padding    widget.Insets
@@ -150,27 +103,27 @@ func NewUIResources() (*UiResources, error) {
 		return nil, err
 	}
 
-	button, err := newButtonResources(fonts)
+	button, err := newButtonTheme(fonts)
 	if err != nil {
 		return nil, err
 	}
 
-	checkbox, err := newCheckboxResources()
+	checkbox, err := newCheckboxTheme()
 	if err != nil {
 		return nil, err
 	}
 
-	comboButton, err := newComboButtonResources(fonts)
+	comboButton, err := newComboButtonTheme(fonts)
 	if err != nil {
 		return nil, err
 	}
 
-	list, err := newListResources(fonts)
+	list, err := newListTheme(fonts)
 	if err != nil {
 		return nil, err
 	}
 
-	slider, err := newSliderResources()
+	slider, err := newSliderTheme()
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +133,7 @@ func NewUIResources() (*UiResources, error) {
 		return nil, err
 	}
 
-	tabBook, err := newTabBookResources(fonts)
+	tabBook, err := newTabBookTheme(fonts)
 	if err != nil {
 		return nil, err
 	}
@@ -190,7 +143,7 @@ func NewUIResources() (*UiResources, error) {
 		return nil, err
 	}
 
-	textInput, err := newTextInputResources(fonts)
+	textInput, err := newTextInputTheme(fonts)
 	if err != nil {
 		return nil, err
 	}
@@ -203,6 +156,41 @@ func NewUIResources() (*UiResources, error) {
 	return &UiResources{
 		Fonts: fonts,
 
+		Theme: &widget.Theme{
+			Button:   button,
+			Checkbox: checkbox,
+
+			Label: &widget.LabelTheme{
+				Face: fonts.face,
+				Color: &widget.LabelColor{
+					Idle:     hexToColor(labelIdleColor),
+					Disabled: hexToColor(labelDisabledColor),
+				},
+			},
+
+			Text: &widget.TextTheme{
+				Face:  fonts.face,
+				Color: hexToColor(textIdleColor),
+			},
+
+			TextInput:   textInput,
+			Slider:      slider,
+			List:        list,
+			TabBook:     tabBook,
+			ComboButton: comboButton,
+
+			TextToolTip: &widget.TextToolTipTheme{
+				Text: &widget.TextTheme{
+					Face:  toolTip.face,
+					Color: toolTip.color,
+				},
+			},
+
+			Window: &widget.WindowTheme{
+				BackgroundImage: panel.Image,
+			},
+		},
+
 		Background: background,
 
 		SeparatorColor: hexToColor(separatorColor),
@@ -216,66 +204,81 @@ func NewUIResources() (*UiResources, error) {
 			SmallFace:     fonts.toolTipFace,
 		},
 
-		Button:      button,
-		Label:       newLabelResources(fonts),
-		Checkbox:    checkbox,
-		ComboButton: comboButton,
-		List:        list,
-		Slider:      slider,
-		Panel:       panel,
-		TabBook:     tabBook,
-		Header:      header,
-		TextInput:   textInput,
-		ToolTip:     toolTip,
+		Checkbox: &checkboxResources{
+			Spacing: 10,
+		},
+
+		List: &listResources{
+			TrackPadding: widget.Insets{
+				Top:    5,
+				Bottom: 24,
+			},
+			HandleSize: 5,
+		},
+
+		Slider: &sliderResources{
+			HandleSize: 6,
+		},
+
+		Panel:   panel,
+		Header:  header,
+		ToolTip: toolTip,
 	}, nil
 }
 
-func newButtonResources(fonts *Fonts) (*buttonResources, error) {
-	idle, err := LoadImageNineSlice("demorun/graphics/button-idle.png", 12, 0)
+func newButtonImage(name string) (*widget.ButtonImage, error) {
+	idle, err := LoadImageNineSlice("demorun/graphics/"+name+"-idle.png", 12, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	hover, err := LoadImageNineSlice("demorun/graphics/button-hover.png", 12, 0)
+	hover, err := LoadImageNineSlice("demorun/graphics/"+name+"-hover.png", 12, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	pressed, err := LoadImageNineSlice("demorun/graphics/button-pressed.png", 12, 0)
+	pressed, err := LoadImageNineSlice("demorun/graphics/"+name+"-pressed.png", 12, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	disabled, err := LoadImageNineSlice("demorun/graphics/button-disabled.png", 12, 0)
+	disabled, err := LoadImageNineSlice("demorun/graphics/"+name+"-disabled.png", 12, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	i := &widget.ButtonImage{
+	return &widget.ButtonImage{
 		Idle:     idle,
 		Hover:    hover,
 		Pressed:  pressed,
 		Disabled: disabled,
+	}, nil
+}
+
+func newButtonTheme(fonts *Fonts) (*widget.ButtonTheme, error) {
+	i, err := newButtonImage("button")
+	if err != nil {
+		return nil, err
 	}
 
-	return &buttonResources{
+	return &widget.ButtonTheme{
 		Image: i,
 
-		Text: &widget.ButtonTextColor{
+		TextColor: &widget.ButtonTextColor{
 			Idle:     hexToColor(buttonIdleColor),
 			Disabled: hexToColor(buttonDisabledColor),
 		},
 
-		Face: fonts.face,
+		TextFace: fonts.face,
 
-		Padding: widget.Insets{
+		TextPadding: &widget.Insets{
 			Left:  30,
 			Right: 30,
 		},
 	}, nil
 }
 
-func newCheckboxResources() (*checkboxResources, error) {
+func newCheckboxTheme() (*widget.CheckboxTheme, error) {
 	idle, err := LoadImageNineSlice("demorun/graphics/checkbox-idle.png", 20, 0)
 	if err != nil {
 		return nil, err
@@ -306,86 +309,54 @@ func newCheckboxResources() (*checkboxResources, error) {
 		return nil, err
 	}
 
-	return &checkboxResources{
-		Image: &widget.ButtonImage{
+	return &widget.CheckboxTheme{
+		ButtonImage: &widget.ButtonImage{
 			Idle:     idle,
 			Hover:    hover,
 			Pressed:  hover,
 			Disabled: disabled,
 		},
 
-		Graphic: &widget.CheckboxGraphicImage{
+		Image: &widget.CheckboxGraphicImage{
 			Checked:   checked,
 			Unchecked: unchecked,
 			Greyed:    greyed,
 		},
-
-		Spacing: 10,
 	}, nil
 }
 
-func newLabelResources(fonts *Fonts) *labelResources {
-	return &labelResources{
-		Text: &widget.LabelColor{
-			Idle:     hexToColor(labelIdleColor),
-			Disabled: hexToColor(labelDisabledColor),
-		},
-		Face: fonts.face,
-	}
-}
-
-func newComboButtonResources(fonts *Fonts) (*comboButtonResources, error) {
-	idle, err := LoadImageNineSlice("demorun/graphics/combo-button-idle.png", 12, 0)
-	if err != nil {
-		return nil, err
-	}
-
-	hover, err := LoadImageNineSlice("demorun/graphics/combo-button-hover.png", 12, 0)
+func newComboButtonTheme(fonts *Fonts) (*widget.ComboButtonTheme, error) {
+	i, err := newButtonImage("combo-button")
 	if err != nil {
 		return nil, err
 	}
 
-	pressed, err := LoadImageNineSlice("demorun/graphics/combo-button-pressed.png", 12, 0)
-	if err != nil {
-		return nil, err
-	}
-
-	disabled, err := LoadImageNineSlice("demorun/graphics/combo-button-disabled.png", 12, 0)
-	if err != nil {
-		return nil, err
-	}
-
-	i := &widget.ButtonImage{
-		Idle:     idle,
-		Hover:    hover,
-		Pressed:  pressed,
-		Disabled: disabled,
-	}
-
 	arrowDown, err := LoadGraphicImages("demorun/graphics/arrow-down-idle.png", "demorun/graphics/arrow-down-disabled.png")
 	if err != nil {
 		return nil, err
 	}
 
-	return &comboButtonResources{
-		Image: i,
+	return &widget.ComboButtonTheme{
+		Button: &widget.ButtonTheme{
+			Image: i,
 
-		Text: &widget.ButtonTextColor{
-			Idle:     hexToColor(buttonIdleColor),
-			Disabled: hexToColor(buttonDisabledColor),
-		},
+			TextColor: &widget.ButtonTextColor{
+				Idle:     hexToColor(buttonIdleColor),
+				Disabled: hexToColor(buttonDisabledColor),
+			},
 
-		Face:    fonts.face,
-		Graphic: arrowDown,
+			TextFace:     fonts.face,
+			GraphicImage: arrowDown,
 
-		Padding: widget.Insets{
-			Left:  30,
-			Right: 30,
+			TextPadding: &widget.Insets{
+				Left:  30,
+				Right: 30,
+			},
 		},
 	}, nil
 }
 
-func newListResources(fonts *Fonts) (*listResources, error) {
+func newListTheme(fonts *Fonts) (*widget.ListTheme, error) {
 	idle, _, err := ebitenutil.NewImageFromFile("demorun/graphics/list-idle.png")
 	if err != nil {
 		return nil, err
@@ -421,35 +392,29 @@ func newListResources(fonts *Fonts) (*listResources, error) {
 		return nil, err
 	}
 
-	return &listResources{
-		Image: &widget.ScrollContainerImage{
+	return &widget.ListTheme{
+		ScrollContainerImage: &widget.ScrollContainerImage{
 			Idle:     image.NewNineSlice(idle, [3]int{25, 12, 22}, [3]int{25, 12, 25}),
 			Disabled: image.NewNineSlice(disabled, [3]int{25, 12, 22}, [3]int{25, 12, 25}),
 			Mask:     image.NewNineSlice(mask, [3]int{26, 10, 23}, [3]int{26, 10, 26}),
 		},
 
-		Track: &widget.SliderTrackImage{
+		SliderTrackImage: &widget.SliderTrackImage{
 			Idle:     image.NewNineSlice(trackIdle, [3]int{5, 0, 0}, [3]int{25, 12, 25}),
 			Hover:    image.NewNineSlice(trackIdle, [3]int{5, 0, 0}, [3]int{25, 12, 25}),
 			Disabled: image.NewNineSlice(trackDisabled, [3]int{0, 5, 0}, [3]int{25, 12, 25}),
 		},
 
-		TrackPadding: widget.Insets{
-			Top:    5,
-			Bottom: 24,
-		},
-
-		Handle: &widget.ButtonImage{
+		SliderHandleImage: &widget.ButtonImage{
 			Idle:     image.NewNineSliceSimple(handleIdle, 0, 5),
 			Hover:    image.NewNineSliceSimple(handleHover, 0, 5),
 			Pressed:  image.NewNineSliceSimple(handleHover, 0, 5),
 			Disabled: image.NewNineSliceSimple(handleIdle, 0, 5),
 		},
 
-		HandleSize: 5,
-		Face:       fonts.face,
+		EntryFace: fonts.face,
 
-		Entry: &widget.ListEntryColor{
+		EntryColor: &widget.ListEntryColor{
 			Unselected:         hexToColor(textIdleColor),
 			DisabledUnselected: hexToColor(textDisabledColor),
 
@@ -460,7 +425,7 @@ func newListResources(fonts *Fonts) (*listResources, error) {
 			DisabledSelectedBackground: hexToColor(listDisabledSelectedBackground),
 		},
 
-		EntryPadding: widget.Insets{
+		EntryTextPadding: &widget.Insets{
 			Left:   30,
 			Right:  30,
 			Top:    2,
@@ -469,7 +434,7 @@ func newListResources(fonts *Fonts) (*listResources, error) {
 	}, nil
 }
 
-func newSliderResources() (*sliderResources, error) {
+func newSliderTheme() (*widget.SliderTheme, error) {
 	idle, _, err := ebitenutil.NewImageFromFile("demorun/graphics/slider-track-idle.png")
 	if err != nil {
 		return nil, err
@@ -495,21 +460,19 @@ func newSliderResources() (*sliderResources, error) {
 		return nil, err
 	}
 
-	return &sliderResources{
+	return &widget.SliderTheme{
 		TrackImage: &widget.SliderTrackImage{
 			Idle:     image.NewNineSlice(idle, [3]int{0, 19, 0}, [3]int{6, 0, 0}),
 			Hover:    image.NewNineSlice(idle, [3]int{0, 19, 0}, [3]int{6, 0, 0}),
 			Disabled: image.NewNineSlice(disabled, [3]int{0, 19, 0}, [3]int{6, 0, 0}),
 		},
 
-		Handle: &widget.ButtonImage{
+		HandleImage: &widget.ButtonImage{
 			Idle:     image.NewNineSliceSimple(handleIdle, 0, 5),
 			Hover:    image.NewNineSliceSimple(handleHover, 0, 5),
 			Pressed:  image.NewNineSliceSimple(handleHover, 0, 5),
 			Disabled: image.NewNineSliceSimple(handleDisabled, 0, 5),
 		},
-
-		HandleSize: 6,
 	}, nil
 }
 
@@ -530,75 +493,26 @@ func newPanelResources() (*panelResources, error) {
 	}, nil
 }
 
-func newTabBookResources(fonts *Fonts) (*tabBookResources, error) {
-	selectedIdle, err := LoadImageNineSlice("demorun/graphics/button-selected-idle.png", 12, 0)
-	if err != nil {
-		return nil, err
-	}
-
-	selectedHover, err := LoadImageNineSlice("demorun/graphics/button-selected-hover.png", 12, 0)
+func newTabBookTheme(fonts *Fonts) (*widget.TabBookTheme, error) {
+	selected, err := newButtonImage("button-selected")
 	if err != nil {
 		return nil, err
 	}
 
-	selectedPressed, err := LoadImageNineSlice("demorun/graphics/button-selected-pressed.png", 12, 0)
+	unselected, err := newButtonImage("button")
 	if err != nil {
 		return nil, err
 	}
 
-	selectedDisabled, err := LoadImageNineSlice("demorun/graphics/button-selected-disabled.png", 12, 0)
-	if err != nil {
-		return nil, err
-	}
-
-	selected := &widget.ButtonImage{
-		Idle:     selectedIdle,
-		Hover:    selectedHover,
-		Pressed:  selectedPressed,
-		Disabled: selectedDisabled,
-	}
+	return &widget.TabBookTheme{
+		TabButtonImage:         unselected,
+		TabButtonSelectedImage: selected,
+		TabButtonFace:          fonts.face,
 
-	idle, err := LoadImageNineSlice("demorun/graphics/button-idle.png", 12, 0)
-	if err != nil {
-		return nil, err
-	}
-
-	hover, err := LoadImageNineSlice("demorun/graphics/button-hover.png", 12, 0)
-	if err != nil {
-		return nil, err
-	}
-
-	pressed, err := LoadImageNineSlice("demorun/graphics/button-pressed.png", 12, 0)
-	if err != nil {
-		return nil, err
-	}
-
-	disabled, err := LoadImageNineSlice("demorun/graphics/button-disabled.png", 12, 0)
-	if err != nil {
-		return nil, err
-	}
-
-	unselected := &widget.ButtonImage{
-		Idle:     idle,
-		Hover:    hover,
-		Pressed:  pressed,
-		Disabled: disabled,
-	}
-
-	return &tabBookResources{
-		SelectedButton: selected,
-		IdleButton:     unselected,
-		ButtonFace:     fonts.face,
-
-		ButtonText: &widget.ButtonTextColor{
+		TabButtonColor: &widget.ButtonTextColor{
 			Idle:     hexToColor(buttonIdleColor),
 			Disabled: hexToColor(buttonDisabledColor),
 		},
-
-		ButtonPadding: widget.Insets{
-			Left:  30,
-			Right: 30,
-		},
 	}, nil
 }
 
@@ -623,7 +537,7 @@ func newHeaderResources(fonts *Fonts) (*headerResources, error) {
 	}, nil
 }
 
-func newTextInputResources(fonts *Fonts) (*textInputResources, error) {
+func newTextInputTheme(fonts *Fonts) (*widget.TextInputTheme, error) {
 	idle, _, err := ebitenutil.NewImageFromFile("demorun/graphics/text-input-idle.png")
 	if err != nil {
 		return nil, err
@@ -634,17 +548,17 @@ func newTextInputResources(fonts *Fonts) (*textInputResources, error) {
 		return nil, err
 	}
 
-	return &textInputResources{
+	return &widget.TextInputTheme{
 		Image: &widget.TextInputImage{
 			Idle:     image.NewNineSlice(idle, [3]int{9, 14, 6}, [3]int{9, 14, 6}),
 			Disabled: image.NewNineSlice(disabled, [3]int{9, 14, 6}, [3]int{9, 14, 6}),
 		},
 
-		Padding: widget.Insets{
-			Left:   8,
-			Right:  8,
-			Top:    4,
-			Bottom: 4,
+		Padding: &widget.Insets{
+			Left:   13,
+			Right:  13,
+			Top:    7,
+			Bottom: 7,
 		},
 
 		Face: fonts.face,
@@ -655,6 +569,8 @@ func newTextInputResources(fonts *Fonts) (*textInputResources, error) {
 			Caret:         hexToColor(textInputCaretColor),
 			DisabledCaret: hexToColor(textInputDisabledCaretColor),
 		},
+
+		CaretWidth: 2,
 	}, nil
 }
 
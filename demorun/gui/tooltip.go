@@ -32,7 +32,7 @@ func (t *ToolTipContents) Create(w widget.HasWidget) widget.ToolTipWidget {
 
 	t.text = widget.NewTextToolTip(
 		widget.TextToolTipOpts.TextOpts(
-			widget.TextOpts.Text("", t.Res.ToolTip.face, t.Res.ToolTip.color),
+			widget.TextOpts.Text("", nil, nil),
 		),
 	)
 	c.AddChild(t.text)
@@ -40,7 +40,7 @@ func (t *ToolTipContents) Create(w widget.HasWidget) widget.ToolTipWidget {
 	if t.ShowTime && t.CanShowTime(w) {
 		t.timeText = widget.NewTextToolTip(
 			widget.TextToolTipOpts.TextOpts(
-				widget.TextOpts.Text("", t.Res.ToolTip.face, t.Res.ToolTip.color),
+				widget.TextOpts.Text("", nil, nil),
 			),
 		)
 		c.AddChild(t.timeText)
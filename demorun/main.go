@@ -1,9 +1,11 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"github.com/blizzy78/ebitenui/demorun/gui"
 	"log"
+	"os"
 	"sort"
 	"time"
 
@@ -16,11 +18,13 @@ import (
 
 	"github.com/blizzy78/ebitenui"
 	"github.com/blizzy78/ebitenui/image"
+	"github.com/blizzy78/ebitenui/input"
 	"github.com/blizzy78/ebitenui/widget"
 )
 
 type Game struct {
-	ui *ebitenui.UI
+	ui     *ebitenui.UI
+	player *input.Player
 }
 
 type pageContainer struct {
@@ -30,6 +34,10 @@ type pageContainer struct {
 }
 
 func main() {
+	record := flag.String("record", "", "record user input to `file`")
+	replay := flag.String("replay", "", "replay user input from `file`")
+	flag.Parse()
+
 	ebiten.SetWindowSize(900, 800)
 	ebiten.SetWindowTitle("Ebiten UI Demo")
 	ebiten.SetWindowResizable(true)
@@ -46,12 +54,75 @@ func main() {
 		ui: ui,
 	}
 
+	switch {
+	case *record != "":
+		closeRecorder, err := recordInput(ui, *record)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer closeRecorder()
+
+	case *replay != "":
+		game.player, err = replayInput(ui, *replay)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	err = ebiten.RunGame(game)
 	if err != nil {
 		log.Print(err)
 	}
 }
 
+func recordInput(ui *ebitenui.UI, path string) (func(), error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := input.NewRecorder(&input.EbitenInputSource{}, f)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	ui.InputSource = r
+
+	return func() {
+		if err := r.Close(); err != nil {
+			log.Print(err)
+		}
+
+		if err := f.Close(); err != nil {
+			log.Print(err)
+		}
+
+		log.Printf("recorded %d frames to %s", r.Frames(), path)
+	}, nil
+}
+
+func replayInput(ui *ebitenui.UI, path string) (*input.Player, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	p, err := input.NewPlayer(f)
+	if err != nil {
+		return nil, err
+	}
+
+	ui.InputSource = p
+
+	log.Printf("replaying %d frames from %s", p.Frames(), path)
+
+	return p, nil
+}
+
 func createUI() (*ebitenui.UI, func(), error) {
 	res, err := gui.NewUIResources()
 	if err != nil {
@@ -109,6 +180,8 @@ func createUI() (*ebitenui.UI, func(), error) {
 	ui = &ebitenui.UI{
 		Container: rootContainer,
 
+		Theme: res.Theme,
+
 		ToolTip: toolTip,
 
 		DragAndDrop: dnd,
@@ -144,7 +217,7 @@ func headerContainer(res *gui.UiResources) widget.PreferredSizeLocateableWidget
 	c.AddChild(c2)
 
 	c2.AddChild(widget.NewText(
-		widget.TextOpts.Text("This program is a showcase of Ebiten UI widgets and layouts.", res.Text.Face, res.Text.IdleColor)))
+		widget.TextOpts.Text("This program is a showcase of Ebiten UI widgets and layouts.", nil, nil)))
 
 	return c
 }
@@ -212,15 +285,10 @@ func demoContainer(res *gui.UiResources, toolTips *gui.ToolTipContents, toolTip
 		widget.ListOpts.EntryLabelFunc(func(e interface{}) string {
 			return e.(*page).title
 		}),
-		widget.ListOpts.ScrollContainerOpts(widget.ScrollContainerOpts.Image(res.List.Image)),
 		widget.ListOpts.SliderOpts(
-			widget.SliderOpts.Images(res.List.Track, res.List.Handle),
 			widget.SliderOpts.HandleSize(res.List.HandleSize),
 			widget.SliderOpts.TrackPadding(res.List.TrackPadding),
 		),
-		widget.ListOpts.EntryColor(res.List.Entry),
-		widget.ListOpts.EntryFontFace(res.List.Face),
-		widget.ListOpts.EntryTextPadding(res.List.EntryPadding),
 		widget.ListOpts.HideHorizontalSlider(),
 
 		widget.ListOpts.EntrySelectedHandler(func(args *widget.ListEntrySelectedEventArgs) {
@@ -275,14 +343,12 @@ func newCheckbox(label string, changedHandler widget.CheckboxChangedHandlerFunc,
 	return widget.NewLabeledCheckbox(
 		widget.LabeledCheckboxOpts.Spacing(res.Checkbox.Spacing),
 		widget.LabeledCheckboxOpts.CheckboxOpts(
-			widget.CheckboxOpts.ButtonOpts(widget.ButtonOpts.Image(res.Checkbox.Image)),
-			widget.CheckboxOpts.Image(res.Checkbox.Graphic),
 			widget.CheckboxOpts.ChangedHandler(func(args *widget.CheckboxChangedEventArgs) {
 				if changedHandler != nil {
 					changedHandler(args)
 				}
 			})),
-		widget.LabeledCheckboxOpts.LabelOpts(widget.LabelOpts.Text(label, res.Label.Face, res.Label.Text)))
+		widget.LabeledCheckboxOpts.LabelOpts(widget.LabelOpts.Text(label, nil, nil)))
 }
 
 func newPageContentContainer() *widget.Container {
@@ -300,27 +366,12 @@ func newListComboButton(entries []interface{}, buttonLabel widget.SelectComboBut
 	entrySelectedHandler widget.ListComboButtonEntrySelectedHandlerFunc, res *gui.UiResources) *widget.ListComboButton {
 
 	return widget.NewListComboButton(
-		widget.ListComboButtonOpts.SelectComboButtonOpts(
-			widget.SelectComboButtonOpts.ComboButtonOpts(
-				widget.ComboButtonOpts.ButtonOpts(
-					widget.ButtonOpts.Image(res.ComboButton.Image),
-					widget.ButtonOpts.TextPadding(res.ComboButton.Padding),
-				),
-			),
-		),
-		widget.ListComboButtonOpts.Text(res.ComboButton.Face, res.ComboButton.Graphic, res.ComboButton.Text),
+		widget.ListComboButtonOpts.Text(nil, nil, nil),
 		widget.ListComboButtonOpts.ListOpts(
 			widget.ListOpts.Entries(entries),
-			widget.ListOpts.ScrollContainerOpts(
-				widget.ScrollContainerOpts.Image(res.List.Image),
-			),
 			widget.ListOpts.SliderOpts(
-				widget.SliderOpts.Images(res.List.Track, res.List.Handle),
 				widget.SliderOpts.HandleSize(res.List.HandleSize),
 				widget.SliderOpts.TrackPadding(res.List.TrackPadding)),
-			widget.ListOpts.EntryFontFace(res.List.Face),
-			widget.ListOpts.EntryColor(res.List.Entry),
-			widget.ListOpts.EntryTextPadding(res.List.EntryPadding),
 		),
 		widget.ListComboButtonOpts.EntryLabelFunc(buttonLabel, entryLabel),
 		widget.ListComboButtonOpts.EntrySelectedHandler(entrySelectedHandler))
@@ -329,9 +380,7 @@ func newListComboButton(entries []interface{}, buttonLabel widget.SelectComboBut
 func newList(entries []interface{}, res *gui.UiResources, widgetOpts ...widget.WidgetOpt) *widget.List {
 	return widget.NewList(
 		widget.ListOpts.ContainerOpts(widget.ContainerOpts.WidgetOpts(widgetOpts...)),
-		widget.ListOpts.ScrollContainerOpts(widget.ScrollContainerOpts.Image(res.List.Image)),
 		widget.ListOpts.SliderOpts(
-			widget.SliderOpts.Images(res.List.Track, res.List.Handle),
 			widget.SliderOpts.HandleSize(res.List.HandleSize),
 			widget.SliderOpts.TrackPadding(res.List.TrackPadding),
 		),
@@ -340,9 +389,6 @@ func newList(entries []interface{}, res *gui.UiResources, widgetOpts ...widget.W
 		widget.ListOpts.EntryLabelFunc(func(e interface{}) string {
 			return e.(string)
 		}),
-		widget.ListOpts.EntryFontFace(res.List.Face),
-		widget.ListOpts.EntryColor(res.List.Entry),
-		widget.ListOpts.EntryTextPadding(res.List.EntryPadding),
 	)
 }
 
@@ -373,6 +419,14 @@ func (g *Game) Layout(outsideWidth int, outsideHeight int) (int, int) {
 
 func (g *Game) Update() error {
 	g.ui.Update()
+
+	if g.player != nil && g.player.Done() {
+		log.Print("replay done")
+
+		g.ui.InputSource = nil
+		g.player = nil
+	}
+
 	return nil
 }
 
@@ -396,9 +450,7 @@ func buttonPage(res *gui.UiResources) *page {
 			widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
 				Stretch: true,
 			})),
-			widget.ButtonOpts.Image(res.Button.Image),
-			widget.ButtonOpts.Text(fmt.Sprintf("Button %d", i+1), res.Button.Face, res.Button.Text),
-			widget.ButtonOpts.TextPadding(res.Button.Padding),
+			widget.ButtonOpts.Text(fmt.Sprintf("Button %d", i+1), nil, nil),
 		)
 		c.AddChild(b)
 		bs = append(bs, b)
@@ -429,10 +481,8 @@ func checkboxPage(res *gui.UiResources) *page {
 	cb2 := widget.NewLabeledCheckbox(
 		widget.LabeledCheckboxOpts.Spacing(res.Checkbox.Spacing),
 		widget.LabeledCheckboxOpts.CheckboxOpts(
-			widget.CheckboxOpts.ButtonOpts(widget.ButtonOpts.Image(res.Checkbox.Image)),
-			widget.CheckboxOpts.Image(res.Checkbox.Graphic),
 			widget.CheckboxOpts.TriState()),
-		widget.LabeledCheckboxOpts.LabelOpts(widget.LabelOpts.Text("Tri-State Checkbox", res.Label.Face, res.Label.Text)))
+		widget.LabeledCheckboxOpts.LabelOpts(widget.LabelOpts.Text("Tri-State Checkbox", nil, nil)))
 	c.AddChild(cb2)
 
 	c.AddChild(newSeparator(res, widget.RowLayoutData{
@@ -482,9 +532,7 @@ func listPage(res *gui.UiResources) *page {
 			widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
 				Stretch: true,
 			})),
-			widget.ButtonOpts.Image(res.Button.Image),
-			widget.ButtonOpts.TextPadding(res.Button.Padding),
-			widget.ButtonOpts.Text(fmt.Sprintf("Action %d", i+1), res.Button.Face, res.Button.Text))
+			widget.ButtonOpts.Text(fmt.Sprintf("Action %d", i+1), nil, nil))
 		buttonsContainer.AddChild(b)
 		bs = append(bs, b)
 	}
@@ -563,9 +611,7 @@ func tabBookPage(res *gui.UiResources) *page {
 
 		for j := 0; j < 3; j++ {
 			b := widget.NewButton(
-				widget.ButtonOpts.Image(res.Button.Image),
-				widget.ButtonOpts.TextPadding(res.Button.Padding),
-				widget.ButtonOpts.Text(fmt.Sprintf("Button %d on Tab %d", j+1, i+1), res.Button.Face, res.Button.Text))
+				widget.ButtonOpts.Text(fmt.Sprintf("Button %d on Tab %d", j+1, i+1), nil, nil))
 			tc.AddChild(b)
 		}
 
@@ -579,9 +625,6 @@ func tabBookPage(res *gui.UiResources) *page {
 
 	t := widget.NewTabBook(
 		widget.TabBookOpts.Tabs(tabs...),
-		widget.TabBookOpts.TabButtonImage(res.TabBook.IdleButton, res.TabBook.SelectedButton),
-		widget.TabBookOpts.TabButtonText(res.TabBook.ButtonFace, res.TabBook.ButtonText),
-		widget.TabBookOpts.TabButtonOpts(widget.StateButtonOpts.ButtonOpts(widget.ButtonOpts.TextPadding(res.TabBook.ButtonPadding))),
 		widget.TabBookOpts.TabButtonSpacing(10),
 		widget.TabBookOpts.Spacing(15))
 	c.AddChild(t)
@@ -617,8 +660,7 @@ func gridLayoutPage(res *gui.UiResources) *page {
 	for row := 0; row < 3; row++ {
 		for col := 0; col < 4; col++ {
 			b := widget.NewButton(
-				widget.ButtonOpts.Image(res.Button.Image),
-				widget.ButtonOpts.Text(fmt.Sprintf("%s %d", string(rune('A'+i)), i+1), res.Button.Face, res.Button.Text))
+				widget.ButtonOpts.Text(fmt.Sprintf("%s %d", string(rune('A'+i)), i+1), nil, nil))
 			bc.AddChild(b)
 
 			i++
@@ -635,7 +677,7 @@ func rowLayoutPage(res *gui.UiResources) *page {
 	c := newPageContentContainer()
 
 	c.AddChild(widget.NewText(
-		widget.TextOpts.Text("Horizontal", res.Text.Face, res.Text.IdleColor)))
+		widget.TextOpts.Text("Horizontal", nil, nil)))
 
 	bc := widget.NewContainer(
 		widget.ContainerOpts.Layout(widget.NewRowLayout(
@@ -644,14 +686,12 @@ func rowLayoutPage(res *gui.UiResources) *page {
 
 	for col := 0; col < 5; col++ {
 		b := widget.NewButton(
-			widget.ButtonOpts.Image(res.Button.Image),
-			widget.ButtonOpts.TextPadding(res.Button.Padding),
-			widget.ButtonOpts.Text(fmt.Sprintf("%s %d", string(rune('A'+col)), col+1), res.Button.Face, res.Button.Text))
+			widget.ButtonOpts.Text(fmt.Sprintf("%s %d", string(rune('A'+col)), col+1), nil, nil))
 		bc.AddChild(b)
 	}
 
 	c.AddChild(widget.NewText(
-		widget.TextOpts.Text("Vertical", res.Text.Face, res.Text.IdleColor)))
+		widget.TextOpts.Text("Vertical", nil, nil)))
 
 	bc = widget.NewContainer(
 		widget.ContainerOpts.Layout(widget.NewRowLayout(
@@ -665,9 +705,7 @@ func rowLayoutPage(res *gui.UiResources) *page {
 			widget.ButtonOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
 				Stretch: true,
 			})),
-			widget.ButtonOpts.Image(res.Button.Image),
-			widget.ButtonOpts.TextPadding(res.Button.Padding),
-			widget.ButtonOpts.Text(l, res.Button.Face, res.Button.Text))
+			widget.ButtonOpts.Text(l, nil, nil))
 		bc.AddChild(b)
 	}
 
@@ -700,7 +738,6 @@ func sliderPage(res *gui.UiResources) *page {
 				Position: widget.RowLayoutPositionCenter,
 			})),
 			widget.SliderOpts.MinMax(1, 20),
-			widget.SliderOpts.Images(res.Slider.TrackImage, res.Slider.Handle),
 			widget.SliderOpts.HandleSize(res.Slider.HandleSize),
 			widget.SliderOpts.PageSizeFunc(func() int {
 				return ps
@@ -716,7 +753,7 @@ func sliderPage(res *gui.UiResources) *page {
 			widget.LabelOpts.TextOpts(widget.TextOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
 				Position: widget.RowLayoutPositionCenter,
 			}))),
-			widget.LabelOpts.Text(fmt.Sprintf("%d", s.Current), res.Label.Face, res.Label.Text),
+			widget.LabelOpts.Text(fmt.Sprintf("%d", s.Current), nil, nil),
 		)
 		sc.AddChild(text)
 	}
@@ -741,7 +778,7 @@ func toolTipPage(res *gui.UiResources, toolTips *gui.ToolTipContents, toolTip *w
 	c := newPageContentContainer()
 
 	c.AddChild(widget.NewText(
-		widget.TextOpts.Text("Hover over these buttons to see their tool tips.", res.Text.Face, res.Text.IdleColor)))
+		widget.TextOpts.Text("Hover over these buttons to see their tool tips.", nil, nil)))
 
 	bc := widget.NewContainer(
 		widget.ContainerOpts.Layout(widget.NewRowLayout(
@@ -750,9 +787,7 @@ func toolTipPage(res *gui.UiResources, toolTips *gui.ToolTipContents, toolTip *w
 
 	for col := 0; col < 4; col++ {
 		b := widget.NewButton(
-			widget.ButtonOpts.Image(res.Button.Image),
-			widget.ButtonOpts.TextPadding(res.Button.Padding),
-			widget.ButtonOpts.Text(fmt.Sprintf("%s %d", string(rune('A'+col)), col+1), res.Button.Face, res.Button.Text))
+			widget.ButtonOpts.Text(fmt.Sprintf("%s %d", string(rune('A'+col)), col+1), nil, nil))
 
 		if col == 2 {
 			b.GetWidget().Disabled = true
@@ -835,8 +870,7 @@ func dragAndDropPage(res *gui.UiResources, dnd *widget.DragAndDrop, drag *gui.Dr
 
 	targetPanel.Container().AddChild(targetText)
 
-	dnd.DroppedEvent.AddHandler(func(args interface{}) {
-		a := args.(*widget.DragAndDropDroppedEventArgs)
+	dnd.DroppedEvent.AddHandler(func(a *widget.DragAndDropDroppedEventArgs) {
 		if !drag.IsTarget(a.Target.GetWidget()) {
 			return
 		}
@@ -863,18 +897,6 @@ func textInputPage(res *gui.UiResources) *page {
 		widget.TextInputOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.RowLayoutData{
 			Stretch: true,
 		})),
-		widget.TextInputOpts.Image(res.TextInput.Image),
-		widget.TextInputOpts.Color(res.TextInput.Color),
-		widget.TextInputOpts.Padding(widget.Insets{
-			Left:   13,
-			Right:  13,
-			Top:    7,
-			Bottom: 7,
-		}),
-		widget.TextInputOpts.Face(res.TextInput.Face),
-		widget.TextInputOpts.CaretOpts(
-			widget.CaretOpts.Size(res.TextInput.Face, 2),
-		),
 	}
 
 	t := widget.NewTextInput(append(
@@ -927,9 +949,7 @@ func windowPage(res *gui.UiResources, ui func() *ebitenui.UI) *page {
 	c := newPageContentContainer()
 
 	b := widget.NewButton(
-		widget.ButtonOpts.Image(res.Button.Image),
-		widget.ButtonOpts.TextPadding(res.Button.Padding),
-		widget.ButtonOpts.Text("Open Window", res.Button.Face, res.Button.Text),
+		widget.ButtonOpts.Text("Open Window", nil, nil),
 		widget.ButtonOpts.ClickedHandler(func(args *widget.ButtonClickedEventArgs) {
 			openWindow(res, ui)
 		}),
@@ -946,7 +966,6 @@ func openWindow(res *gui.UiResources, ui func() *ebitenui.UI) {
 	var rw ebitenui.RemoveWindowFunc
 
 	c := widget.NewContainer(
-		widget.ContainerOpts.BackgroundImage(res.Panel.Image),
 		widget.ContainerOpts.Layout(widget.NewRowLayout(
 			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
 			widget.RowLayoutOpts.Padding(res.Panel.Padding),
@@ -959,7 +978,7 @@ func openWindow(res *gui.UiResources, ui func() *ebitenui.UI) {
 	))
 
 	c.AddChild(widget.NewText(
-		widget.TextOpts.Text("This window blocks all input to widgets below it.", res.Text.Face, res.Text.IdleColor),
+		widget.TextOpts.Text("This window blocks all input to widgets below it.", nil, nil),
 	))
 
 	bc := widget.NewContainer(
@@ -970,9 +989,7 @@ func openWindow(res *gui.UiResources, ui func() *ebitenui.UI) {
 	c.AddChild(bc)
 
 	o2b := widget.NewButton(
-		widget.ButtonOpts.Image(res.Button.Image),
-		widget.ButtonOpts.TextPadding(res.Button.Padding),
-		widget.ButtonOpts.Text("Open Another", res.Button.Face, res.Button.Text),
+		widget.ButtonOpts.Text("Open Another", nil, nil),
 		widget.ButtonOpts.ClickedHandler(func(args *widget.ButtonClickedEventArgs) {
 			openWindow2(res, ui)
 		}),
@@ -980,9 +997,7 @@ func openWindow(res *gui.UiResources, ui func() *ebitenui.UI) {
 	bc.AddChild(o2b)
 
 	cb := widget.NewButton(
-		widget.ButtonOpts.Image(res.Button.Image),
-		widget.ButtonOpts.TextPadding(res.Button.Padding),
-		widget.ButtonOpts.Text("Close", res.Button.Face, res.Button.Text),
+		widget.ButtonOpts.Text("Close", nil, nil),
 		widget.ButtonOpts.ClickedHandler(func(args *widget.ButtonClickedEventArgs) {
 			rw()
 		}),
@@ -1006,7 +1021,6 @@ func openWindow2(res *gui.UiResources, ui func() *ebitenui.UI) {
 	var rw ebitenui.RemoveWindowFunc
 
 	c := widget.NewContainer(
-		widget.ContainerOpts.BackgroundImage(res.Panel.Image),
 		widget.ContainerOpts.Layout(widget.NewRowLayout(
 			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
 			widget.RowLayoutOpts.Padding(res.Panel.Padding),
@@ -1019,9 +1033,7 @@ func openWindow2(res *gui.UiResources, ui func() *ebitenui.UI) {
 	))
 
 	cb := widget.NewButton(
-		widget.ButtonOpts.Image(res.Button.Image),
-		widget.ButtonOpts.TextPadding(res.Button.Padding),
-		widget.ButtonOpts.Text("Close", res.Button.Face, res.Button.Text),
+		widget.ButtonOpts.Text("Close", nil, nil),
 		widget.ButtonOpts.ClickedHandler(func(args *widget.ButtonClickedEventArgs) {
 			rw()
 		}),
@@ -1078,7 +1090,7 @@ func anchorLayoutPage(res *gui.UiResources) *page {
 	)
 	posC.AddChild(hPosC)
 
-	hPosC.AddChild(widget.NewLabel(widget.LabelOpts.Text("Horizontal", res.Label.Face, res.Label.Text)))
+	hPosC.AddChild(widget.NewLabel(widget.LabelOpts.Text("Horizontal", nil, nil)))
 
 	labels := []string{"Start", "Center", "End"}
 	hCBs := []*widget.Checkbox{}
@@ -1107,7 +1119,7 @@ func anchorLayoutPage(res *gui.UiResources) *page {
 	)
 	posC.AddChild(vPosC)
 
-	vPosC.AddChild(widget.NewLabel(widget.LabelOpts.Text("Vertical", res.Label.Face, res.Label.Text)))
+	vPosC.AddChild(widget.NewLabel(widget.LabelOpts.Text("Vertical", nil, nil)))
 
 	vCBs := []*widget.Checkbox{}
 	for _, l := range labels {
@@ -1134,7 +1146,7 @@ func anchorLayoutPage(res *gui.UiResources) *page {
 	)
 	posC.AddChild(stretchC)
 
-	stretchC.AddChild(widget.NewText(widget.TextOpts.Text("Stretch", res.Text.Face, res.Text.IdleColor)))
+	stretchC.AddChild(widget.NewText(widget.TextOpts.Text("Stretch", nil, nil)))
 
 	stretchHorizontalCheckbox := newCheckbox("Horizontal", func(args *widget.CheckboxChangedEventArgs) {
 		ald := sp.Container().GetWidget().LayoutData.(widget.AnchorLayoutData)
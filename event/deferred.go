@@ -2,8 +2,28 @@ package event
 
 import internalevent "github.com/blizzy78/ebitenui/internal/event"
 
-// ExecuteDeferred processes the queue of deferred actions and executes them. This should only be called by UI.
+// Queue is a queue of deferred actions, such as fired events. Each UI owns a queue, so that multiple
+// UIs do not interfere with each other.
+type Queue = internalevent.Queue
+
+// QueueFunc is a function that returns the queue that an event should put fired events into. It may
+// return nil to use the current queue.
+type QueueFunc func() *Queue
+
+// ExecuteDeferred processes the current queue of deferred actions and executes them. This should only be called by UI.
 // Additionally, it can be called in unit tests to process events programmatically.
+//
+// While a UI is drawing, its own queue is the current queue. Otherwise, a default queue is used.
 func ExecuteDeferred() {
 	internalevent.ExecuteDeferred()
 }
+
+// queue returns the queue returned by f, or the current queue if f is nil or returns nil.
+func queue(f QueueFunc) *Queue {
+	if f != nil {
+		if q := f(); q != nil {
+			return q
+		}
+	}
+	return internalevent.CurrentQueue()
+}
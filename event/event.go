@@ -1,11 +1,14 @@
 package event
 
-import internalevent "github.com/blizzy78/ebitenui/internal/event"
-
 // Event encapsulates an arbitrary event that event handlers may be interested in.
+// Of should be preferred for events with arguments of a known type.
 type Event struct {
-	idCounter uint32
-	handlers  []handler
+	// Immediate specifies whether handlers are added and events are fired immediately, instead of
+	// being put into the deferred queue.
+	Immediate bool
+
+	handlers  handlerList[interface{}]
+	queueFunc QueueFunc
 }
 
 // A HandlerFunc is a function that receives and handles an event. When firing an event using
@@ -15,11 +18,6 @@ type HandlerFunc func(args interface{})
 // RemoveHandlerFunc is a function that removes a handler from an event.
 type RemoveHandlerFunc func()
 
-type handler struct {
-	id uint32
-	h  HandlerFunc
-}
-
 type deferredEvent struct {
 	event *Event
 	args  interface{}
@@ -27,78 +25,63 @@ type deferredEvent struct {
 
 type deferredAddHandler struct {
 	event   *Event
-	handler handler
+	handler *handlerOf[interface{}]
 }
 
-// AddHandler registers event handler h with e. It returns a function to remove h from e if desired.
-func (e *Event) AddHandler(h HandlerFunc) RemoveHandlerFunc {
-	e.idCounter++
-
-	id := e.idCounter
-
-	internalevent.AddDeferred(&deferredAddHandler{
-		event: e,
-		handler: handler{
-			id: id,
-			h:  h,
-		},
-	})
+// AddHandler registers event handler h with e, configured with opts. It returns a function to remove h
+// from e if desired.
+func (e *Event) AddHandler(h HandlerFunc, opts ...HandlerOpt) RemoveHandlerFunc {
+	hd := newHandler[interface{}](h, opts)
+
+	if e.Immediate {
+		e.handlers.add(hd)
+	} else {
+		queue(e.queueFunc).Add(&deferredAddHandler{
+			event:   e,
+			handler: hd,
+		})
+	}
 
 	return func() {
-		e.removeHandler(id)
+		e.handlers.remove(hd)
 	}
 }
 
-func (e *Event) removeHandler(id uint32) {
-	index := -1
-	for i, h := range e.handlers {
-		if h.id == id {
-			index = i
-			break
-		}
-	}
-
-	if index < 0 {
-		return
-	}
-
-	e.handlers = append(e.handlers[:index], e.handlers[index+1:]...)
+// SetQueueFunc configures e to put fired events, as well as added handlers, into the queue returned by f.
+func (e *Event) SetQueueFunc(f QueueFunc) {
+	e.queueFunc = f
 }
 
 // Fire fires an event to all registered handlers. Arbitrary event arguments may be passed
 // which are in turn passed on to event handlers.
 //
-// Events are not fired directly, but are put into a deferred queue. This queue is then
-// processed by the UI.
+// Unless e.Immediate is set, events are not fired directly, but are put into a deferred queue.
+// This queue is then processed by the UI.
 func (e *Event) Fire(args interface{}) {
-	internalevent.AddDeferred(&deferredEvent{
+	if e.Immediate {
+		e.handlers.handle(args)
+		return
+	}
+
+	queue(e.queueFunc).Add(&deferredEvent{
 		event: e,
 		args:  args,
 	})
 }
 
-func (e *Event) handle(args interface{}) {
-	for _, h := range e.handlers {
-		h.h(args)
-	}
-}
-
 // Do implements DeferredAction.
 func (e *deferredEvent) Do() {
-	e.event.handle(e.args)
+	e.event.handlers.handle(e.args)
 }
 
 // Do implements DeferredAction.
 func (a *deferredAddHandler) Do() {
-	a.event.handlers = append(a.event.handlers, a.handler)
+	a.event.handlers.add(a.handler)
 }
 
 // AddEventHandlerOneShot registers event handler h with e. When e fires an event, h is removed from e immediately.
+//
+// Deprecated: Use e.AddHandler with HandlerOpts.Once instead.
 func AddEventHandlerOneShot(e *Event, h HandlerFunc) {
-	var r RemoveHandlerFunc
-	rh := func(args interface{}) {
-		r()
-		h(args)
-	}
-	r = e.AddHandler(rh)
+	e.AddHandler(h, HandlerOpts.Once())
 }
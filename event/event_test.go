@@ -0,0 +1,175 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestEvent_Fire(t *testing.T) {
+	is := is.New(t)
+
+	e := &Event{}
+
+	values := []interface{}{}
+	e.AddHandler(func(args interface{}) {
+		values = append(values, args)
+	})
+
+	e.Fire(1)
+	is.Equal(len(values), 0) // deferred
+	ExecuteDeferred()
+	is.Equal(values, []interface{}{1})
+}
+
+func TestEvent_Fire_Immediate(t *testing.T) {
+	is := is.New(t)
+
+	e := &Event{
+		Immediate: true,
+	}
+
+	values := []interface{}{}
+	remove := e.AddHandler(func(args interface{}) {
+		values = append(values, args)
+	})
+
+	e.Fire(1)
+	is.Equal(values, []interface{}{1})
+
+	remove()
+	e.Fire(2)
+	is.Equal(values, []interface{}{1})
+}
+
+func TestEvent_AddHandler_Priority(t *testing.T) {
+	is := is.New(t)
+
+	e := &Event{
+		Immediate: true,
+	}
+
+	calls := []string{}
+	e.AddHandler(func(args interface{}) {
+		calls = append(calls, "default")
+	})
+	e.AddHandler(func(args interface{}) {
+		calls = append(calls, "low")
+	}, HandlerOpts.Priority(-1))
+	e.AddHandler(func(args interface{}) {
+		calls = append(calls, "high")
+	}, HandlerOpts.Priority(10))
+	e.AddHandler(func(args interface{}) {
+		calls = append(calls, "default 2")
+	})
+
+	e.Fire(nil)
+
+	is.Equal(calls, []string{"high", "default", "default 2", "low"})
+}
+
+func TestEvent_AddHandler_Once(t *testing.T) {
+	is := is.New(t)
+
+	e := &Event{}
+
+	calls := 0
+	e.AddHandler(func(args interface{}) {
+		calls++
+	}, HandlerOpts.Once())
+
+	e.Fire(nil)
+	e.Fire(nil)
+	ExecuteDeferred()
+
+	is.Equal(calls, 1)
+}
+
+func TestEvent_AddHandler_RemoveBeforeAdded(t *testing.T) {
+	is := is.New(t)
+
+	e := &Event{}
+
+	called := false
+	remove := e.AddHandler(func(args interface{}) {
+		called = true
+	})
+	remove()
+
+	e.Fire(nil)
+	ExecuteDeferred()
+
+	is.True(!called)
+}
+
+func TestEvent_Fire_Reentrant(t *testing.T) {
+	is := is.New(t)
+
+	e := &Event{
+		Immediate: true,
+	}
+
+	values := []int{}
+	onceCalls := 0
+	e.AddHandler(func(args interface{}) {
+		onceCalls++
+	}, HandlerOpts.Once(), HandlerOpts.Priority(1))
+	e.AddHandler(func(args interface{}) {
+		v := args.(int)
+		values = append(values, v)
+
+		if v < 3 {
+			e.Fire(v + 1)
+		}
+	})
+
+	e.Fire(1)
+
+	is.Equal(values, []int{1, 2, 3})
+	is.Equal(onceCalls, 1)
+}
+
+func TestEvent_Fire_Reentrant_AddRemove(t *testing.T) {
+	is := is.New(t)
+
+	e := &Event{
+		Immediate: true,
+	}
+
+	calls := []string{}
+	var removeSecond RemoveHandlerFunc
+	e.AddHandler(func(args interface{}) {
+		calls = append(calls, "first")
+
+		removeSecond()
+		e.AddHandler(func(args interface{}) {
+			calls = append(calls, "added")
+		}, HandlerOpts.Once())
+	}, HandlerOpts.Once())
+	removeSecond = e.AddHandler(func(args interface{}) {
+		calls = append(calls, "second")
+	})
+
+	e.Fire(nil)
+	is.Equal(calls, []string{"first"})
+
+	e.Fire(nil)
+	is.Equal(calls, []string{"first", "added"})
+}
+
+func TestAddEventHandlerOneShot(t *testing.T) {
+	is := is.New(t)
+
+	e := &Event{}
+
+	calls := 0
+	AddEventHandlerOneShot(e, func(args interface{}) {
+		calls++
+	})
+
+	e.Fire(nil)
+	e.Fire(nil)
+	ExecuteDeferred()
+
+	is.Equal(calls, 1)
+}
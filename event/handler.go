@@ -0,0 +1,107 @@
+package event
+
+import "sort"
+
+// HandlerOpt is a function that configures an event handler when it is added to an event.
+type HandlerOpt func(o *handlerOptions)
+
+type HandlerOptions struct {
+}
+
+// HandlerOpts contains functions that configure event handlers.
+var HandlerOpts HandlerOptions
+
+type handlerOptions struct {
+	priority int
+	once     bool
+}
+
+// handlerList is a list of event handlers, ordered by descending priority. Handlers of the same
+// priority are ordered by the time they have been added.
+type handlerList[T any] struct {
+	handlers []*handlerOf[T]
+}
+
+type handlerOf[T any] struct {
+	h        func(args T)
+	priority int
+	once     bool
+	removed  bool
+}
+
+// Priority configures an event handler with priority p. Handlers with higher priority are called
+// before handlers with lower priority. The default priority is 0.
+func (o HandlerOptions) Priority(p int) HandlerOpt {
+	return func(ho *handlerOptions) {
+		ho.priority = p
+	}
+}
+
+// Once configures an event handler to be removed from the event when the event fires for the first time.
+func (o HandlerOptions) Once() HandlerOpt {
+	return func(ho *handlerOptions) {
+		ho.once = true
+	}
+}
+
+func newHandler[T any](h func(args T), opts []HandlerOpt) *handlerOf[T] {
+	o := handlerOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &handlerOf[T]{
+		h:        h,
+		priority: o.priority,
+		once:     o.once,
+	}
+}
+
+func (l *handlerList[T]) add(h *handlerOf[T]) {
+	if h.removed {
+		return
+	}
+
+	i := sort.Search(len(l.handlers), func(i int) bool {
+		return l.handlers[i].priority < h.priority
+	})
+
+	l.handlers = append(l.handlers, nil)
+	copy(l.handlers[i+1:], l.handlers[i:])
+	l.handlers[i] = h
+}
+
+func (l *handlerList[T]) remove(h *handlerOf[T]) {
+	h.removed = true
+
+	for i, lh := range l.handlers {
+		if lh == h {
+			l.handlers = append(l.handlers[:i], l.handlers[i+1:]...)
+			return
+		}
+	}
+}
+
+// handle calls all handlers with args. Handlers that are added or removed while handling, for example
+// by firing events re-entrantly, do not affect the handlers called for args, except that removed
+// handlers are no longer called.
+func (l *handlerList[T]) handle(args T) {
+	if len(l.handlers) == 0 {
+		return
+	}
+
+	hs := make([]*handlerOf[T], len(l.handlers))
+	copy(hs, l.handlers)
+
+	for _, h := range hs {
+		if h.removed {
+			continue
+		}
+
+		if h.once {
+			l.remove(h)
+		}
+
+		h.h(args)
+	}
+}
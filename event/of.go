@@ -0,0 +1,75 @@
+package event
+
+// Of is a type-safe variant of Event. Its handlers receive event arguments of type T, so that they
+// do not need to use type assertions.
+//
+// Like Event, Of does not fire events directly by default, but puts them into the same deferred queue.
+type Of[T any] struct {
+	// Immediate specifies whether handlers are added and events are fired immediately, instead of
+	// being put into the deferred queue.
+	Immediate bool
+
+	handlers  handlerList[T]
+	queueFunc QueueFunc
+}
+
+type deferredEventOf[T any] struct {
+	event *Of[T]
+	args  T
+}
+
+type deferredAddHandlerOf[T any] struct {
+	event   *Of[T]
+	handler *handlerOf[T]
+}
+
+// AddHandler registers event handler h with e, configured with opts. It returns a function to remove h
+// from e if desired.
+func (e *Of[T]) AddHandler(h func(args T), opts ...HandlerOpt) RemoveHandlerFunc {
+	hd := newHandler(h, opts)
+
+	if e.Immediate {
+		e.handlers.add(hd)
+	} else {
+		queue(e.queueFunc).Add(&deferredAddHandlerOf[T]{
+			event:   e,
+			handler: hd,
+		})
+	}
+
+	return func() {
+		e.handlers.remove(hd)
+	}
+}
+
+// SetQueueFunc configures e to put fired events, as well as added handlers, into the queue returned by f.
+// Widgets use this to put events into the queue of the UI they belong to.
+func (e *Of[T]) SetQueueFunc(f QueueFunc) {
+	e.queueFunc = f
+}
+
+// Fire fires an event with arguments args to all registered handlers.
+//
+// Unless e.Immediate is set, events are not fired directly, but are put into a deferred queue.
+// This queue is then processed by the UI.
+func (e *Of[T]) Fire(args T) {
+	if e.Immediate {
+		e.handlers.handle(args)
+		return
+	}
+
+	queue(e.queueFunc).Add(&deferredEventOf[T]{
+		event: e,
+		args:  args,
+	})
+}
+
+// Do implements DeferredAction.
+func (e *deferredEventOf[T]) Do() {
+	e.event.handlers.handle(e.args)
+}
+
+// Do implements DeferredAction.
+func (a *deferredAddHandlerOf[T]) Do() {
+	a.event.handlers.add(a.handler)
+}
@@ -0,0 +1,74 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+type testEventArgs struct {
+	value int
+}
+
+func TestOf_Fire(t *testing.T) {
+	is := is.New(t)
+
+	e := &Of[*testEventArgs]{}
+
+	values := []int{}
+	remove := e.AddHandler(func(args *testEventArgs) {
+		values = append(values, args.value)
+	})
+
+	e.Fire(&testEventArgs{value: 1})
+	is.Equal(len(values), 0) // deferred
+	ExecuteDeferred()
+	is.Equal(values, []int{1})
+
+	remove()
+	e.Fire(&testEventArgs{value: 2})
+	ExecuteDeferred()
+	is.Equal(values, []int{1})
+}
+
+func TestOf_AddHandler_Once(t *testing.T) {
+	is := is.New(t)
+
+	e := &Of[string]{}
+
+	calls := 0
+	e.AddHandler(func(args string) {
+		is.Equal(args, "foo")
+		calls++
+	}, HandlerOpts.Once())
+
+	e.Fire("foo")
+	e.Fire("foo")
+	ExecuteDeferred()
+
+	is.Equal(calls, 1)
+}
+
+func TestOf_Fire_Immediate(t *testing.T) {
+	is := is.New(t)
+
+	e := &Of[int]{
+		Immediate: true,
+	}
+
+	values := []int{}
+	e.AddHandler(func(args int) {
+		values = append(values, args)
+	}, HandlerOpts.Priority(-1))
+	e.AddHandler(func(args int) {
+		values = append(values, args*10)
+
+		if args < 2 {
+			e.Fire(args + 1)
+		}
+	})
+
+	e.Fire(1)
+
+	is.Equal(values, []int{10, 20, 2, 1})
+}
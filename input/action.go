@@ -0,0 +1,375 @@
+package input
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Action is the name of an action that user input can be bound to, such as "ui.cancel".
+type Action string
+
+// BindingType is the type of user input of a Binding.
+type BindingType int
+
+// Binding is user input that is bound to an action: a key chord, a mouse button, or a gamepad button.
+//
+// A binding can be converted to and from its textual representation, such as "Ctrl+S", "MouseRight",
+// or "Gamepad0".
+type Binding struct {
+	Type          BindingType
+	KeyChord      KeyChord
+	MouseButton   ebiten.MouseButton
+	GamepadButton ebiten.GamepadButton
+}
+
+// ActionMap maps actions to bindings. It can be serialized to and from JSON, so that user remappings
+// can be persisted.
+type ActionMap struct {
+	bindings map[Action][]Binding
+}
+
+const (
+	BindingTypeKey = BindingType(iota)
+	BindingTypeMouseButton
+	BindingTypeGamepadButton
+)
+
+const (
+	ActionConfirm        = Action("ui.confirm")
+	ActionCancel         = Action("ui.cancel")
+	ActionNext           = Action("ui.next")
+	ActionPrevious       = Action("ui.previous")
+	ActionLeft           = Action("ui.left")
+	ActionRight          = Action("ui.right")
+	ActionUp             = Action("ui.up")
+	ActionDown           = Action("ui.down")
+	ActionStart          = Action("ui.start")
+	ActionEnd            = Action("ui.end")
+	ActionDeleteBackward = Action("ui.deleteBackward")
+	ActionDeleteForward  = Action("ui.deleteForward")
+)
+
+// Actions is the action map used by built-in widgets, unless configured otherwise. It initially contains
+// the bindings returned by DefaultActionMap.
+var Actions = DefaultActionMap()
+
+// ErrInvalidBinding is returned when parsing a binding fails.
+var ErrInvalidBinding = errors.New("invalid binding")
+
+var mouseButtonNames = map[ebiten.MouseButton]string{
+	ebiten.MouseButtonLeft:   "MouseLeft",
+	ebiten.MouseButtonMiddle: "MouseMiddle",
+	ebiten.MouseButtonRight:  "MouseRight",
+}
+
+const gamepadButtonPrefix = "Gamepad"
+
+// KeyBinding returns a binding for key k, pressed together with modifiers m.
+func KeyBinding(k ebiten.Key, m ...Modifiers) Binding {
+	return Binding{
+		Type:     BindingTypeKey,
+		KeyChord: NewKeyChord(k, m...),
+	}
+}
+
+// MouseButtonBinding returns a binding for mouse button b.
+func MouseButtonBinding(b ebiten.MouseButton) Binding {
+	return Binding{
+		Type:        BindingTypeMouseButton,
+		MouseButton: b,
+	}
+}
+
+// GamepadButtonBinding returns a binding for button b of any gamepad.
+func GamepadButtonBinding(b ebiten.GamepadButton) Binding {
+	return Binding{
+		Type:          BindingTypeGamepadButton,
+		GamepadButton: b,
+	}
+}
+
+// ParseBinding parses the textual representation of a binding, as returned by Binding.String.
+func ParseBinding(s string) (Binding, error) {
+	for b, n := range mouseButtonNames {
+		if strings.EqualFold(s, n) {
+			return MouseButtonBinding(b), nil
+		}
+	}
+
+	if len(s) > len(gamepadButtonPrefix) && strings.EqualFold(s[:len(gamepadButtonPrefix)], gamepadButtonPrefix) {
+		b, err := strconv.Atoi(s[len(gamepadButtonPrefix):])
+		if err != nil || b < 0 || b > int(ebiten.GamepadButtonMax) {
+			return Binding{}, fmt.Errorf("%w: %s", ErrInvalidBinding, s)
+		}
+
+		return GamepadButtonBinding(ebiten.GamepadButton(b)), nil
+	}
+
+	c, err := ParseKeyChord(s)
+	if err != nil {
+		return Binding{}, err
+	}
+
+	return Binding{
+		Type:     BindingTypeKey,
+		KeyChord: c,
+	}, nil
+}
+
+// ParseKeyChord parses the textual representation of a key chord, as returned by KeyChord.String.
+func ParseKeyChord(s string) (KeyChord, error) {
+	parts := strings.Split(s, "+")
+
+	c := KeyChord{}
+
+loop:
+	for _, p := range parts[:len(parts)-1] {
+		for _, mk := range modifierKeys {
+			if strings.EqualFold(p, mk.name) {
+				c.Modifiers |= mk.modifier
+				continue loop
+			}
+		}
+
+		return KeyChord{}, fmt.Errorf("%w: unknown modifier in %s", ErrInvalidBinding, s)
+	}
+
+	name := parts[len(parts)-1]
+	for k := ebiten.Key(0); k <= ebiten.KeyMax; k++ {
+		if n := k.String(); n != "" && strings.EqualFold(n, name) {
+			c.Key = k
+			return c, nil
+		}
+	}
+
+	return KeyChord{}, fmt.Errorf("%w: unknown key in %s", ErrInvalidBinding, s)
+}
+
+// String returns the textual representation of b.
+func (b Binding) String() string {
+	switch b.Type {
+	case BindingTypeMouseButton:
+		return mouseButtonNames[b.MouseButton]
+	case BindingTypeGamepadButton:
+		return gamepadButtonPrefix + strconv.Itoa(int(b.GamepadButton))
+	default:
+		return b.KeyChord.String()
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (b Binding) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (b *Binding) UnmarshalText(text []byte) error {
+	pb, err := ParseBinding(string(text))
+	if err != nil {
+		return err
+	}
+
+	*b = pb
+	return nil
+}
+
+// Pressed returns whether b is currently pressed. Key bindings without modifiers are pressed while their
+// key is pressed, regardless of modifier keys, so that for example Backspace also works while Shift is held.
+// Key bindings with modifiers are only pressed while exactly their modifier keys are pressed.
+func (b Binding) Pressed() bool {
+	switch b.Type {
+	case BindingTypeMouseButton:
+		return MouseButtonPressed(b.MouseButton)
+	case BindingTypeGamepadButton:
+		return AnyGamepadButtonPressed(b.GamepadButton)
+	default:
+		return KeyPressed(b.KeyChord.Key) && b.modifiersPressed()
+	}
+}
+
+// JustPressed returns whether b has just been pressed. It only returns true during the first frame
+// that b is pressed.
+func (b Binding) JustPressed() bool {
+	switch b.Type {
+	case BindingTypeMouseButton:
+		return MouseButtonJustPressed(b.MouseButton)
+	case BindingTypeGamepadButton:
+		return AnyGamepadButtonJustPressed(b.GamepadButton)
+	default:
+		return KeyJustPressed(b.KeyChord.Key) && b.modifiersPressed()
+	}
+}
+
+// modifiersPressed returns whether the modifier keys of key binding b are pressed, as described in Pressed.
+func (b Binding) modifiersPressed() bool {
+	return b.KeyChord.Modifiers == 0 || CurrentModifiers() == b.KeyChord.Modifiers
+}
+
+// NewActionMap constructs a new, empty action map.
+func NewActionMap() *ActionMap {
+	return &ActionMap{
+		bindings: map[Action][]Binding{},
+	}
+}
+
+// DefaultActionMap constructs a new action map containing the default bindings of all actions used
+// by built-in widgets.
+func DefaultActionMap() *ActionMap {
+	m := NewActionMap()
+	m.Bind(ActionConfirm, KeyBinding(ebiten.KeyEnter), KeyBinding(ebiten.KeyNumpadEnter))
+	m.Bind(ActionCancel, KeyBinding(ebiten.KeyEscape))
+	m.Bind(ActionNext, KeyBinding(ebiten.KeyTab))
+	m.Bind(ActionPrevious, KeyBinding(ebiten.KeyTab, ModifierShift))
+	m.Bind(ActionLeft, KeyBinding(ebiten.KeyArrowLeft))
+	m.Bind(ActionRight, KeyBinding(ebiten.KeyArrowRight))
+	m.Bind(ActionUp, KeyBinding(ebiten.KeyArrowUp))
+	m.Bind(ActionDown, KeyBinding(ebiten.KeyArrowDown))
+	m.Bind(ActionStart, KeyBinding(ebiten.KeyHome))
+	m.Bind(ActionEnd, KeyBinding(ebiten.KeyEnd))
+	m.Bind(ActionDeleteBackward, KeyBinding(ebiten.KeyBackspace))
+	m.Bind(ActionDeleteForward, KeyBinding(ebiten.KeyDelete))
+	return m
+}
+
+// Bind adds bindings bs to action a. Bindings that are already bound to a are ignored.
+func (m *ActionMap) Bind(a Action, bs ...Binding) {
+	for _, b := range bs {
+		if !m.BoundTo(a, b) {
+			m.bindings[a] = append(m.bindings[a], b)
+		}
+	}
+}
+
+// SetBindings replaces all bindings of action a with bs.
+func (m *ActionMap) SetBindings(a Action, bs ...Binding) {
+	delete(m.bindings, a)
+	m.Bind(a, bs...)
+}
+
+// Unbind removes binding b from action a.
+func (m *ActionMap) Unbind(a Action, b Binding) {
+	bs := m.bindings[a]
+	for i, ab := range bs {
+		if ab == b {
+			m.bindings[a] = append(bs[:i:i], bs[i+1:]...)
+			return
+		}
+	}
+}
+
+// Bindings returns all bindings of action a.
+func (m *ActionMap) Bindings(a Action) []Binding {
+	return append([]Binding(nil), m.bindings[a]...)
+}
+
+// BoundTo returns whether binding b is bound to action a.
+func (m *ActionMap) BoundTo(a Action, b Binding) bool {
+	for _, ab := range m.bindings[a] {
+		if ab == b {
+			return true
+		}
+	}
+	return false
+}
+
+// ActionsBoundTo returns all actions that binding b is bound to, in alphabetical order. It can be used
+// to detect conflicting bindings.
+func (m *ActionMap) ActionsBoundTo(b Binding) []Action {
+	as := []Action{}
+	for _, a := range m.Actions() {
+		if m.BoundTo(a, b) {
+			as = append(as, a)
+		}
+	}
+	return as
+}
+
+// Actions returns all actions that have bindings, in alphabetical order.
+func (m *ActionMap) Actions() []Action {
+	as := make([]Action, 0, len(m.bindings))
+	for a, bs := range m.bindings {
+		if len(bs) > 0 {
+			as = append(as, a)
+		}
+	}
+
+	sort.Slice(as, func(i int, j int) bool {
+		return as[i] < as[j]
+	})
+
+	return as
+}
+
+// ActionPressed returns whether any binding of action a is currently pressed. A key binding without
+// modifiers is ignored while another binding of m matches its key together with the currently pressed
+// modifier keys, so that for example Tab and Shift+Tab can be bound to different actions.
+func (m *ActionMap) ActionPressed(a Action) bool {
+	for _, b := range m.bindings[a] {
+		if b.Pressed() && !m.shadowed(b) {
+			return true
+		}
+	}
+	return false
+}
+
+// ActionJustPressed returns whether any binding of action a has just been pressed. It only returns true
+// during the first frame that the binding is pressed. Key bindings are treated as in ActionPressed.
+func (m *ActionMap) ActionJustPressed(a Action) bool {
+	for _, b := range m.bindings[a] {
+		if b.JustPressed() && !m.shadowed(b) {
+			return true
+		}
+	}
+	return false
+}
+
+// shadowed returns whether b is a key binding without modifiers, and m contains a binding of b's key
+// together with the currently pressed modifier keys.
+func (m *ActionMap) shadowed(b Binding) bool {
+	if b.Type != BindingTypeKey || b.KeyChord.Modifiers != 0 {
+		return false
+	}
+
+	mods := CurrentModifiers()
+	if mods == 0 {
+		return false
+	}
+
+	sb := KeyBinding(b.KeyChord.Key, mods)
+	for _, bs := range m.bindings {
+		for _, ab := range bs {
+			if ab == sb {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. Actions are encoded as an object that maps each action
+// to a list of the textual representations of its bindings.
+func (m *ActionMap) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.bindings)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. All existing bindings of m are replaced.
+func (m *ActionMap) UnmarshalJSON(data []byte) error {
+	bindings := map[Action][]Binding{}
+	if err := json.Unmarshal(data, &bindings); err != nil {
+		return err
+	}
+
+	m.bindings = map[Action][]Binding{}
+	for a, bs := range bindings {
+		m.Bind(a, bs...)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package input
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestParseBinding(t *testing.T) {
+	is := is.New(t)
+
+	for _, b := range []Binding{
+		KeyBinding(ebiten.KeyS, ModifierControl, ModifierShift),
+		KeyBinding(ebiten.KeyF1),
+		KeyBinding(ebiten.KeyArrowLeft),
+		MouseButtonBinding(ebiten.MouseButtonRight),
+		GamepadButtonBinding(ebiten.GamepadButton3),
+	} {
+		pb, err := ParseBinding(b.String())
+		is.NoErr(err)
+		is.Equal(pb, b)
+	}
+
+	b, err := ParseBinding("ctrl+s")
+	is.NoErr(err)
+	is.Equal(b, KeyBinding(ebiten.KeyS, ModifierControl))
+
+	for _, s := range []string{"", "Foo", "Hyper+S", "Gamepad99", "Gamepadx"} {
+		_, err = ParseBinding(s)
+		is.True(errors.Is(err, ErrInvalidBinding)) // s
+	}
+}
+
+func TestActionMap_Bind(t *testing.T) {
+	is := is.New(t)
+
+	m := NewActionMap()
+	m.Bind("a", KeyBinding(ebiten.KeyA), KeyBinding(ebiten.KeyB))
+	m.Bind("a", KeyBinding(ebiten.KeyA))
+	m.Bind("b", KeyBinding(ebiten.KeyB))
+
+	is.Equal(m.Bindings("a"), []Binding{KeyBinding(ebiten.KeyA), KeyBinding(ebiten.KeyB)})
+	is.Equal(m.ActionsBoundTo(KeyBinding(ebiten.KeyB)), []Action{"a", "b"})
+
+	m.Unbind("a", KeyBinding(ebiten.KeyA))
+	is.Equal(m.Bindings("a"), []Binding{KeyBinding(ebiten.KeyB)})
+
+	m.SetBindings("b")
+	is.Equal(m.Actions(), []Action{"a"})
+}
+
+func TestActionMap_JSON(t *testing.T) {
+	is := is.New(t)
+
+	m := NewActionMap()
+	m.Bind(ActionConfirm, KeyBinding(ebiten.KeyEnter), GamepadButtonBinding(ebiten.GamepadButton0))
+	m.Bind(ActionCancel, KeyBinding(ebiten.KeyEscape), MouseButtonBinding(ebiten.MouseButtonRight))
+
+	data, err := json.Marshal(m)
+	is.NoErr(err)
+	is.Equal(string(data), `{"ui.cancel":["Escape","MouseRight"],"ui.confirm":["Enter","Gamepad0"]}`)
+
+	m2 := DefaultActionMap()
+	is.NoErr(json.Unmarshal(data, m2))
+	is.Equal(m2.Actions(), []Action{ActionCancel, ActionConfirm})
+	is.Equal(m2.Bindings(ActionConfirm), m.Bindings(ActionConfirm))
+	is.Equal(m2.Bindings(ActionCancel), m.Bindings(ActionCancel))
+
+	is.True(json.Unmarshal([]byte(`{"ui.cancel":["Foo"]}`), m2) != nil)
+}
+
+func TestActionMap_ActionPressed(t *testing.T) {
+	is := is.New(t)
+
+	m := NewActionMap()
+	m.Bind(ActionPrevious, KeyBinding(ebiten.KeyTab, ModifierShift))
+	m.Bind(ActionNext, KeyBinding(ebiten.KeyTab))
+
+	src := &FakeInputSource{}
+	src.SetKey(ebiten.KeyTab, true)
+	keyFrame(t, src)
+	is.True(m.ActionPressed(ActionNext))
+	is.True(m.ActionJustPressed(ActionNext))
+	is.True(!m.ActionPressed(ActionPrevious))
+
+	keyFrame(t, src)
+	is.True(m.ActionPressed(ActionNext))
+	is.True(!m.ActionJustPressed(ActionNext))
+
+	src.SetKey(ebiten.KeyShift, true)
+	keyFrame(t, src)
+	is.True(!m.ActionPressed(ActionNext))
+	is.True(m.ActionPressed(ActionPrevious))
+}
+
+func TestActionMap_ActionPressed_ExtraModifiers(t *testing.T) {
+	is := is.New(t)
+
+	m := NewActionMap()
+	m.Bind(ActionDeleteBackward, KeyBinding(ebiten.KeyBackspace))
+
+	src := &FakeInputSource{}
+	src.SetKey(ebiten.KeyShift, true)
+	src.SetKey(ebiten.KeyBackspace, true)
+	keyFrame(t, src)
+	is.True(m.ActionPressed(ActionDeleteBackward))
+	is.True(m.ActionJustPressed(ActionDeleteBackward))
+}
+
+func TestActionMap_ActionPressed_Gamepad(t *testing.T) {
+	is := is.New(t)
+
+	m := NewActionMap()
+	m.Bind(ActionConfirm, GamepadButtonBinding(ebiten.GamepadButton0))
+
+	gamepadInput(t, Gamepad{ID: 4, Buttons: []ebiten.GamepadButton{ebiten.GamepadButton0}})
+	is.True(m.ActionPressed(ActionConfirm))
+	is.True(m.ActionJustPressed(ActionConfirm))
+
+	gamepadInput(t, Gamepad{ID: 4, Buttons: []ebiten.GamepadButton{ebiten.GamepadButton0}})
+	is.True(m.ActionPressed(ActionConfirm))
+	is.True(!m.ActionJustPressed(ActionConfirm))
+
+	gamepadInput(t)
+	is.True(!m.ActionPressed(ActionConfirm))
+}
@@ -1,4 +1,5 @@
-// Package input deals with user input such as mouse button clicks, scroll wheel movement etc.
+// Package input deals with user input such as mouse button clicks, scroll wheel movement, touch gestures,
+// gamepads etc.
 // It also provides access to the input layer stack to handle staggered input.
 //
 // Widget implementations should always use this package to handle user input rather than using
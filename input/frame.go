@@ -0,0 +1,119 @@
+package input
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Frame is a snapshot of the user input of a single frame. Frame implements InputSource itself,
+// providing the input of the snapshot.
+type Frame struct {
+	// MouseButtons are the mouse buttons that are pressed.
+	MouseButtons []ebiten.MouseButton
+
+	CursorX int
+	CursorY int
+	WheelX  float64
+	WheelY  float64
+
+	// Chars are the characters that have been typed.
+	Chars []rune
+
+	// Keys are the keys that are pressed.
+	Keys []ebiten.Key
+
+	// TouchPoints are the current touches.
+	TouchPoints []Touch
+
+	// GamepadStates are the states of all connected gamepads.
+	GamepadStates []Gamepad
+}
+
+// FrameSource may be implemented by input sources that provide user input frame by frame.
+type FrameSource interface {
+	InputSource
+
+	// ReadFrame returns the user input of the next frame.
+	ReadFrame() *Frame
+}
+
+var _ InputSource = &Frame{}
+
+var frameMouseButtons = []ebiten.MouseButton{ebiten.MouseButtonLeft, ebiten.MouseButtonMiddle, ebiten.MouseButtonRight}
+
+// ReadFrame returns a snapshot of the user input provided by src. If src implements FrameSource,
+// its ReadFrame method is used.
+//
+// This function is called by the UI once per frame.
+func ReadFrame(src InputSource) *Frame {
+	if fs, ok := src.(FrameSource); ok {
+		return fs.ReadFrame()
+	}
+
+	f := Frame{}
+
+	for _, b := range frameMouseButtons {
+		if src.MouseButtonPressed(b) {
+			f.MouseButtons = append(f.MouseButtons, b)
+		}
+	}
+
+	f.CursorX, f.CursorY = src.CursorPosition()
+	f.WheelX, f.WheelY = src.Wheel()
+	f.Chars = append(f.Chars, src.InputChars()...)
+
+	for k := ebiten.Key(0); k <= ebiten.KeyMax; k++ {
+		if src.KeyPressed(k) {
+			f.Keys = append(f.Keys, k)
+		}
+	}
+
+	f.TouchPoints = append(f.TouchPoints, src.Touches()...)
+	f.GamepadStates = append(f.GamepadStates, src.Gamepads()...)
+
+	return &f
+}
+
+// MouseButtonPressed implements InputSource.
+func (f *Frame) MouseButtonPressed(b ebiten.MouseButton) bool {
+	for _, mb := range f.MouseButtons {
+		if mb == b {
+			return true
+		}
+	}
+	return false
+}
+
+// CursorPosition implements InputSource.
+func (f *Frame) CursorPosition() (int, int) {
+	return f.CursorX, f.CursorY
+}
+
+// Wheel implements InputSource.
+func (f *Frame) Wheel() (float64, float64) {
+	return f.WheelX, f.WheelY
+}
+
+// InputChars implements InputSource.
+func (f *Frame) InputChars() []rune { //nolint:golint
+	return f.Chars
+}
+
+// KeyPressed implements InputSource.
+func (f *Frame) KeyPressed(k ebiten.Key) bool {
+	for _, fk := range f.Keys {
+		if fk == k {
+			return true
+		}
+	}
+	return false
+}
+
+// Touches implements InputSource.
+func (f *Frame) Touches() []Touch {
+	return f.TouchPoints
+}
+
+// Gamepads implements InputSource.
+func (f *Frame) Gamepads() []Gamepad {
+	return f.GamepadStates
+}
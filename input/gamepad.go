@@ -0,0 +1,184 @@
+package input
+
+import (
+	"math"
+
+	internalinput "github.com/blizzy78/ebitenui/internal/input"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Gamepad is the state of a single gamepad.
+type Gamepad = internalinput.Gamepad
+
+var (
+	// GamepadDeadZone is the dead zone of gamepad axes. Axis values closer to the axis' rest position
+	// are reported as being at the rest position.
+	GamepadDeadZone = 0.2
+
+	// GamepadStickAxes are the horizontal and vertical axes of the analog stick used to adjust
+	// widgets such as Slider.
+	GamepadStickAxes = [2]int{0, 1}
+)
+
+// standardGamepadTriggerAxes are the axes of the left and right triggers of standard gamepads, such as
+// Xbox controllers. They are used unless the UI is configured otherwise.
+var standardGamepadTriggerAxes = [2]int{4, 5}
+
+// GamepadIDs returns the IDs of all connected gamepads, in ascending order.
+func GamepadIDs() []ebiten.GamepadID {
+	ids := make([]ebiten.GamepadID, len(state().Gamepads))
+	for i, g := range state().Gamepads {
+		ids[i] = g.ID
+	}
+	return ids
+}
+
+// GamepadsJustConnected returns the IDs of all gamepads that have just been connected.
+// It only returns gamepads during the first frame that they are connected.
+func GamepadsJustConnected() []ebiten.GamepadID {
+	return state().GamepadsJustConnected
+}
+
+// GamepadsJustDisconnected returns the IDs of all gamepads that have just been disconnected.
+// It only returns gamepads during the first frame that they are disconnected.
+func GamepadsJustDisconnected() []ebiten.GamepadID {
+	return state().GamepadsJustDisconnected
+}
+
+// GamepadButtonPressed returns whether button b of gamepad id is currently pressed.
+func GamepadButtonPressed(id ebiten.GamepadID, b ebiten.GamepadButton) bool {
+	return internalinput.FindGamepad(state().Gamepads, id).ButtonPressed(b)
+}
+
+// GamepadButtonJustPressed returns whether button b of gamepad id has just been pressed.
+// It only returns true during the first frame that the button is pressed.
+func GamepadButtonJustPressed(id ebiten.GamepadID, b ebiten.GamepadButton) bool {
+	return GamepadButtonPressed(id, b) && !internalinput.FindGamepad(state().LastGamepads, id).ButtonPressed(b)
+}
+
+// AnyGamepadButtonPressed returns whether button b of any gamepad is currently pressed.
+func AnyGamepadButtonPressed(b ebiten.GamepadButton) bool {
+	for _, g := range state().Gamepads {
+		if g.ButtonPressed(b) {
+			return true
+		}
+	}
+	return false
+}
+
+// AnyGamepadButtonJustPressed returns whether button b of any gamepad has just been pressed.
+// It only returns true during the first frame that the button is pressed.
+func AnyGamepadButtonJustPressed(b ebiten.GamepadButton) bool {
+	for _, g := range state().Gamepads {
+		if GamepadButtonJustPressed(g.ID, b) {
+			return true
+		}
+	}
+	return false
+}
+
+// GamepadButtonPressedLayer returns whether button b of gamepad id is currently pressed if input layer l
+// is eligible to handle it. Eligibility is determined by the cursor position.
+func GamepadButtonPressedLayer(id ebiten.GamepadID, b ebiten.GamepadButton, l *Layer) bool {
+	return GamepadButtonPressed(id, b) && gamepadLayerActive(l)
+}
+
+// GamepadButtonJustPressedLayer returns whether button b of gamepad id has just been pressed if input
+// layer l is eligible to handle it. Eligibility is determined by the cursor position. It only returns true
+// during the first frame that the button is pressed.
+func GamepadButtonJustPressedLayer(id ebiten.GamepadID, b ebiten.GamepadButton, l *Layer) bool {
+	return GamepadButtonJustPressed(id, b) && gamepadLayerActive(l)
+}
+
+// GamepadAxis returns the value of axis a of gamepad id, in the range [-1,1]. GamepadDeadZone is
+// applied to the value.
+func GamepadAxis(id ebiten.GamepadID, a int) float64 {
+	return applyDeadZone(internalinput.FindGamepad(state().Gamepads, id).Axis(a))
+}
+
+// GamepadAxisLayer returns the value of axis a of gamepad id if input layer l is eligible to handle it.
+// Eligibility is determined by the cursor position. If l is not eligible, it returns 0.
+func GamepadAxisLayer(id ebiten.GamepadID, a int, l *Layer) float64 {
+	if !gamepadLayerActive(l) {
+		return 0
+	}
+	return GamepadAxis(id, a)
+}
+
+// GamepadStick returns the values of the analog stick axes configured in GamepadStickAxes, of the first
+// gamepad whose stick is not at its rest position.
+func GamepadStick() (float64, float64) {
+	for _, g := range state().Gamepads {
+		x, y := GamepadAxis(g.ID, GamepadStickAxes[0]), GamepadAxis(g.ID, GamepadStickAxes[1])
+		if x != 0 || y != 0 {
+			return x, y
+		}
+	}
+	return 0, 0
+}
+
+// GamepadStickLayer returns the values of the analog stick axes if input layer l is eligible to handle them.
+// Eligibility is determined by the cursor position. If l is not eligible, it returns 0, 0.
+func GamepadStickLayer(l *Layer) (float64, float64) {
+	x, y := GamepadStick()
+	if (x == 0 && y == 0) || !gamepadLayerActive(l) {
+		return 0, 0
+	}
+	return x, y
+}
+
+// GamepadTriggerScroll returns the scroll amount indicated by the triggers of the first gamepad that has
+// a trigger pressed. The value is in the range [-1,1], with the left trigger scrolling up, and the right
+// trigger scrolling down. The trigger axes are configured per UI, see ebitenui.UI.GamepadTriggerAxes.
+func GamepadTriggerScroll() float64 {
+	axes := standardGamepadTriggerAxes
+	if a := state().GamepadTriggerAxes; a != nil {
+		axes = *a
+	}
+
+	for _, g := range state().Gamepads {
+		if s := gamepadTrigger(g, axes[1]) - gamepadTrigger(g, axes[0]); s != 0 {
+			return s
+		}
+	}
+	return 0
+}
+
+// GamepadTriggerScrollLayer returns the scroll amount indicated by the triggers if input layer l is
+// eligible to handle it. Eligibility is determined by the cursor position. If l is not eligible,
+// it returns 0.
+func GamepadTriggerScrollLayer(l *Layer) float64 {
+	s := GamepadTriggerScroll()
+	if s == 0 || !gamepadLayerActive(l) {
+		return 0
+	}
+	return s
+}
+
+// gamepadTrigger returns the value of trigger axis a of g, in the range [0,1].
+func gamepadTrigger(g Gamepad, a int) float64 {
+	if a < 0 || a >= len(g.Axes) {
+		return 0
+	}
+	return applyDeadZone((g.Axes[a] + 1) / 2)
+}
+
+func gamepadLayerActive(l *Layer) bool {
+	x, y := CursorPosition()
+	return l.ActiveFor(x, y, LayerEventTypeGamepad)
+}
+
+func applyDeadZone(v float64) float64 {
+	a := math.Abs(v)
+	if a <= GamepadDeadZone {
+		return 0
+	}
+
+	a = (a - GamepadDeadZone) / (1 - GamepadDeadZone)
+	if a > 1 {
+		a = 1
+	}
+
+	return math.Copysign(a, v)
+}
@@ -0,0 +1,128 @@
+package input
+
+import (
+	"image"
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestGamepadButtonJustPressed(t *testing.T) {
+	is := is.New(t)
+
+	gamepadInput(t, Gamepad{ID: 1})
+	gamepadInput(t, Gamepad{ID: 1, Buttons: []ebiten.GamepadButton{ebiten.GamepadButton3}})
+	is.True(GamepadButtonPressed(1, ebiten.GamepadButton3))
+	is.True(GamepadButtonJustPressed(1, ebiten.GamepadButton3))
+	is.True(AnyGamepadButtonJustPressed(ebiten.GamepadButton3))
+	is.True(!GamepadButtonPressed(2, ebiten.GamepadButton3))
+
+	gamepadInput(t, Gamepad{ID: 1, Buttons: []ebiten.GamepadButton{ebiten.GamepadButton3}})
+	is.True(GamepadButtonPressed(1, ebiten.GamepadButton3))
+	is.True(!GamepadButtonJustPressed(1, ebiten.GamepadButton3))
+	is.True(AnyGamepadButtonPressed(ebiten.GamepadButton3))
+
+	gamepadInput(t)
+}
+
+func TestGamepadsJustConnected(t *testing.T) {
+	is := is.New(t)
+
+	gamepadInput(t, Gamepad{ID: 2}, Gamepad{ID: 1})
+	is.Equal(GamepadsJustConnected(), []ebiten.GamepadID{1, 2})
+	is.Equal(GamepadIDs(), []ebiten.GamepadID{1, 2})
+
+	gamepadInput(t, Gamepad{ID: 2})
+	is.Equal(len(GamepadsJustConnected()), 0)
+	is.Equal(GamepadsJustDisconnected(), []ebiten.GamepadID{1})
+
+	gamepadInput(t)
+	is.Equal(GamepadsJustDisconnected(), []ebiten.GamepadID{2})
+}
+
+func TestGamepadAxis_DeadZone(t *testing.T) {
+	is := is.New(t)
+
+	gamepadInput(t, Gamepad{ID: 0, Axes: []float64{0.1, -0.6, 1}})
+	is.Equal(GamepadAxis(0, 0), 0.0)
+	is.True(math.Abs(GamepadAxis(0, 1)+0.5) < 0.0001)
+	is.Equal(GamepadAxis(0, 2), 1.0)
+	is.Equal(GamepadAxis(0, 3), 0.0)
+
+	x, y := GamepadStick()
+	is.Equal(x, 0.0)
+	is.True(math.Abs(y+0.5) < 0.0001)
+
+	gamepadInput(t)
+}
+
+func TestGamepadTriggerScroll(t *testing.T) {
+	is := is.New(t)
+
+	gamepadInput(t, Gamepad{ID: 0, Axes: []float64{0, 0, 0, 0, -1, -1}})
+	is.Equal(GamepadTriggerScroll(), 0.0)
+
+	gamepadInput(t, Gamepad{ID: 0, Axes: []float64{0, 0, 0, 0, -1, 1}})
+	is.Equal(GamepadTriggerScroll(), 1.0)
+
+	gamepadInput(t, Gamepad{ID: 0, Axes: []float64{0, 0, 0, 0, 1, -1}})
+	is.Equal(GamepadTriggerScroll(), -1.0)
+
+	// gamepad without triggers
+	gamepadInput(t, Gamepad{ID: 0, Axes: []float64{0, 0}})
+	is.Equal(GamepadTriggerScroll(), 0.0)
+
+	gamepadInput(t)
+}
+
+func TestGamepadTriggerScroll_Axes(t *testing.T) {
+	is := is.New(t)
+
+	state().GamepadTriggerAxes = &[2]int{2, 3}
+	defer func() {
+		state().GamepadTriggerAxes = nil
+	}()
+
+	gamepadInput(t, Gamepad{ID: 0, Axes: []float64{0, 0, -1, 1, -1, -1}})
+	is.Equal(GamepadTriggerScroll(), 1.0)
+
+	state().GamepadTriggerAxes = &[2]int{-1, -1}
+
+	gamepadInput(t, Gamepad{ID: 0, Axes: []float64{0, 0, -1, 1, -1, 1}})
+	is.Equal(GamepadTriggerScroll(), 0.0)
+
+	gamepadInput(t)
+}
+
+func TestGamepadButtonPressedLayer(t *testing.T) {
+	is := is.New(t)
+
+	l := &Layer{
+		EventTypes: LayerEventTypeAll,
+		BlockLower: true,
+		RectFunc: func() image.Rectangle {
+			return image.Rect(0, 0, 10, 10)
+		},
+	}
+	SetupInputLayersWithDeferred(nil)
+	AddLayer(l)
+	defer SetupInputLayersWithDeferred(nil)
+
+	gamepadInput(t, Gamepad{ID: 0, Buttons: []ebiten.GamepadButton{ebiten.GamepadButton0}})
+	is.True(GamepadButtonPressedLayer(0, ebiten.GamepadButton0, l))
+	is.True(!GamepadButtonPressedLayer(0, ebiten.GamepadButton0, &DefaultLayer))
+
+	gamepadInput(t)
+}
+
+// gamepadInput simulates the gamepads gs for a single frame.
+func gamepadInput(t *testing.T, gs ...Gamepad) {
+	t.Helper()
+
+	src := &FakeInputSource{}
+	src.SetGamepads(gs...)
+
+	keyFrame(t, src)
+}
@@ -5,15 +5,20 @@ import (
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
+// state returns the input state of the UI that is currently being updated or drawn.
+func state() *internalinput.State {
+	return internalinput.CurrentState()
+}
+
 // MouseButtonPressed returns whether mouse button b is currently pressed.
 func MouseButtonPressed(b ebiten.MouseButton) bool {
 	switch b {
 	case ebiten.MouseButtonLeft:
-		return internalinput.LeftMouseButtonPressed
+		return state().LeftMouseButtonPressed
 	case ebiten.MouseButtonMiddle:
-		return internalinput.MiddleMouseButtonPressed
+		return state().MiddleMouseButtonPressed
 	case ebiten.MouseButtonRight:
-		return internalinput.RightMouseButtonPressed
+		return state().RightMouseButtonPressed
 	default:
 		return false
 	}
@@ -24,11 +29,11 @@ func MouseButtonPressed(b ebiten.MouseButton) bool {
 func MouseButtonJustPressed(b ebiten.MouseButton) bool {
 	switch b {
 	case ebiten.MouseButtonLeft:
-		return internalinput.LeftMouseButtonJustPressed
+		return state().LeftMouseButtonJustPressed
 	case ebiten.MouseButtonMiddle:
-		return internalinput.MiddleMouseButtonJustPressed
+		return state().MiddleMouseButtonJustPressed
 	case ebiten.MouseButtonRight:
-		return internalinput.RightMouseButtonJustPressed
+		return state().RightMouseButtonJustPressed
 	default:
 		return false
 	}
@@ -58,12 +63,14 @@ func MouseButtonJustPressedLayer(b ebiten.MouseButton, l *Layer) bool {
 
 // CursorPosition returns the current cursor position.
 func CursorPosition() (int, int) {
-	return internalinput.CursorX, internalinput.CursorY
+	s := state()
+	return s.CursorX, s.CursorY
 }
 
 // Wheel returns current mouse wheel movement.
 func Wheel() (float64, float64) {
-	return internalinput.WheelX, internalinput.WheelY
+	s := state()
+	return s.WheelX, s.WheelY
 }
 
 // WheelLayer returns current mouse wheel movement if input layer l is eligible to handle it.
@@ -84,16 +91,22 @@ func WheelLayer(l *Layer) (float64, float64) {
 
 // InputChars returns user keyboard input.
 func InputChars() []rune { //nolint:golint
-	return internalinput.InputChars
+	return state().InputChars
 }
 
 // KeyPressed returns whether key k is currently pressed.
 func KeyPressed(k ebiten.Key) bool {
-	p, ok := internalinput.KeyPressed[k]
+	p, ok := state().KeyPressed[k]
 	return ok && p
 }
 
 // AnyKeyPressed returns whether any key is currently pressed.
 func AnyKeyPressed() bool {
-	return internalinput.AnyKeyPressed
+	return state().AnyKeyPressed
+}
+
+// KeyJustPressed returns whether key k has just been pressed.
+// It only returns true during the first frame that the key is pressed.
+func KeyJustPressed(k ebiten.Key) bool {
+	return state().KeyJustPressed[k]
 }
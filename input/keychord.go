@@ -0,0 +1,93 @@
+package input
+
+import (
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Modifiers is a set of modifier keys.
+type Modifiers int
+
+// KeyChord is a key that is pressed together with a set of modifier keys, such as Ctrl+S.
+type KeyChord struct {
+	Key       ebiten.Key
+	Modifiers Modifiers
+}
+
+const (
+	ModifierShift Modifiers = 1 << iota
+	ModifierControl
+	ModifierAlt
+	ModifierMeta
+)
+
+var modifierKeys = []struct {
+	modifier Modifiers
+	key      ebiten.Key
+	name     string
+}{
+	{ModifierControl, ebiten.KeyControl, "Ctrl"},
+	{ModifierAlt, ebiten.KeyAlt, "Alt"},
+	{ModifierShift, ebiten.KeyShift, "Shift"},
+	{ModifierMeta, ebiten.KeyMeta, "Meta"},
+}
+
+// NewKeyChord returns a key chord for key k, pressed together with modifiers m.
+func NewKeyChord(k ebiten.Key, m ...Modifiers) KeyChord {
+	c := KeyChord{
+		Key: k,
+	}
+
+	for _, mod := range m {
+		c.Modifiers |= mod
+	}
+
+	return c
+}
+
+// ModifierKey returns whether k is a modifier key, such as ebiten.KeyShift or ebiten.KeyControlLeft.
+func ModifierKey(k ebiten.Key) bool {
+	switch k {
+	case ebiten.KeyShift, ebiten.KeyShiftLeft, ebiten.KeyShiftRight,
+		ebiten.KeyControl, ebiten.KeyControlLeft, ebiten.KeyControlRight,
+		ebiten.KeyAlt, ebiten.KeyAltLeft, ebiten.KeyAltRight,
+		ebiten.KeyMeta, ebiten.KeyMetaLeft, ebiten.KeyMetaRight:
+		return true
+	default:
+		return false
+	}
+}
+
+// CurrentModifiers returns the modifier keys that are currently pressed.
+func CurrentModifiers() Modifiers {
+	var m Modifiers
+	for _, mk := range modifierKeys {
+		if KeyPressed(mk.key) {
+			m |= mk.modifier
+		}
+	}
+	return m
+}
+
+// KeyChordJustPressed returns whether key chord c has just been pressed, that is, whether its key has
+// just been pressed while exactly its modifier keys are pressed.
+func KeyChordJustPressed(c KeyChord) bool {
+	return KeyJustPressed(c.Key) && CurrentModifiers() == c.Modifiers
+}
+
+// String returns a human-readable representation of c, such as "Ctrl+Shift+S".
+func (c KeyChord) String() string {
+	b := strings.Builder{}
+
+	for _, mk := range modifierKeys {
+		if c.Modifiers&mk.modifier != 0 {
+			b.WriteString(mk.name)
+			b.WriteString("+")
+		}
+	}
+
+	b.WriteString(c.Key.String())
+
+	return b.String()
+}
@@ -0,0 +1,52 @@
+package input
+
+import (
+	"testing"
+
+	internalinput "github.com/blizzy78/ebitenui/internal/input"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestKeyChord_String(t *testing.T) {
+	is := is.New(t)
+
+	is.Equal(NewKeyChord(ebiten.KeyS, ModifierShift, ModifierControl).String(), "Ctrl+Shift+S")
+	is.Equal(NewKeyChord(ebiten.KeyF1).String(), "F1")
+}
+
+func TestKeyChordJustPressed(t *testing.T) {
+	is := is.New(t)
+
+	src := &FakeInputSource{}
+	c := NewKeyChord(ebiten.KeyS, ModifierControl)
+
+	src.SetKey(ebiten.KeyControl, true)
+	keyFrame(t, src)
+	is.True(!KeyChordJustPressed(c))
+
+	src.SetKey(ebiten.KeyS, true)
+	keyFrame(t, src)
+	is.True(KeyChordJustPressed(c))
+	is.True(!KeyChordJustPressed(NewKeyChord(ebiten.KeyS)))
+
+	keyFrame(t, src)
+	is.True(!KeyChordJustPressed(c))
+
+	src.SetKey(ebiten.KeyS, false)
+	src.SetKey(ebiten.KeyShift, true)
+	keyFrame(t, src)
+	src.SetKey(ebiten.KeyS, true)
+	keyFrame(t, src)
+	is.True(!KeyChordJustPressed(c))
+	is.True(KeyChordJustPressed(NewKeyChord(ebiten.KeyS, ModifierControl, ModifierShift)))
+}
+
+// keyFrame makes the input system read the current state of src for a single frame.
+func keyFrame(t *testing.T, src *FakeInputSource) {
+	t.Helper()
+
+	internalinput.Update(src)
+	internalinput.Draw()
+}
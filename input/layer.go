@@ -45,6 +45,14 @@ type Layer struct {
 	RectFunc LayerRectFunc
 
 	invalid bool
+	stack   *LayerStack
+}
+
+// LayerStack is a stack of input layers. Each UI owns a layer stack, so that multiple UIs do not
+// interfere with each other. The zero value is an empty stack.
+type LayerStack struct {
+	layers   []*Layer
+	deferred []SetupInputLayerFunc
 }
 
 // LayerRectFunc is a function that returns a Layer's screen area of interest.
@@ -65,6 +73,12 @@ const (
 	// LayerEventTypeWheel indicates an interest in mouse wheel events.
 	LayerEventTypeWheel
 
+	// LayerEventTypeTouch indicates an interest in touch gestures, such as drags, swipes, and pinches.
+	LayerEventTypeTouch
+
+	// LayerEventTypeGamepad indicates an interest in gamepad events, such as button presses and analog sticks.
+	LayerEventTypeGamepad
+
 	// LayerEventTypeAll indicates an interest in all event types.
 	LayerEventTypeAll = LayerEventType(^uint16(0))
 )
@@ -77,11 +91,24 @@ var DefaultLayer = Layer{
 	FullScreen: true,
 }
 
-var layers []*Layer
+var defaultLayerStack = &LayerStack{}
+
+var currentLayerStack = defaultLayerStack
+
+// SetCurrentLayerStack makes s the current layer stack and returns the previously current layer stack.
+// If s is nil, a default layer stack becomes the current layer stack. This function is called by the UI.
+func SetCurrentLayerStack(s *LayerStack) *LayerStack {
+	prev := currentLayerStack
 
-var deferredSetupInputLayers []SetupInputLayerFunc
+	if s == nil {
+		s = defaultLayerStack
+	}
+	currentLayerStack = s
+
+	return prev
+}
 
-// AddLayer adds l at the top of the layer stack.
+// AddLayer adds l at the top of the current layer stack.
 //
 // Layers are only valid for the duration of a frame. Layers are removed automatically for the next frame.
 func AddLayer(l *Layer) {
@@ -93,7 +120,8 @@ func AddLayer(l *Layer) {
 		panic("LayerEventTypeAny is invalid for an input layer, perhaps you meant to use LayerEventTypeAll instead")
 	}
 
-	layers = append(layers, l)
+	l.stack = currentLayerStack
+	currentLayerStack.layers = append(currentLayerStack.layers, l)
 }
 
 // Valid returns whether l is still valid, that is, it has not been added to the layer stack in previous frames.
@@ -108,8 +136,13 @@ func (l *Layer) ActiveFor(x int, y int, eventType LayerEventType) bool {
 		return false
 	}
 
-	for i := len(layers) - 1; i >= 0; i-- {
-		layer := layers[i]
+	stack := l.stack
+	if stack == nil {
+		stack = currentLayerStack
+	}
+
+	for i := len(stack.layers) - 1; i >= 0; i-- {
+		layer := stack.layers[i]
 
 		if !layer.contains(x, y) {
 			continue
@@ -139,33 +172,36 @@ func (l *Layer) contains(x int, y int) bool {
 	return image.Point{x, y}.In(l.RectFunc())
 }
 
-// SetupInputLayersWithDeferred calls ls to set up input layers. This function is called by the UI.
+// SetupInputLayersWithDeferred calls ls to set up input layers in the current layer stack.
+// This function is called by the UI.
 func SetupInputLayersWithDeferred(ls []Layerer) {
-	for _, layer := range layers {
+	s := currentLayerStack
+
+	for _, layer := range s.layers {
 		layer.invalid = true
 	}
-	layers = layers[:0]
+	s.layers = s.layers[:0]
 
 	for _, l := range ls {
-		appendToDeferredSetupInputLayerQueue(l.SetupInputLayer)
+		s.appendDeferred(l.SetupInputLayer)
 	}
 
-	setupDeferredInputLayers()
+	s.setupDeferred()
 }
 
-func setupDeferredInputLayers() {
+func (s *LayerStack) setupDeferred() {
 	defer func(d []SetupInputLayerFunc) {
-		deferredSetupInputLayers = d[:0]
-	}(deferredSetupInputLayers)
+		s.deferred = d[:0]
+	}(s.deferred)
 
-	for len(deferredSetupInputLayers) > 0 {
-		s := deferredSetupInputLayers[0]
-		deferredSetupInputLayers = deferredSetupInputLayers[1:]
+	for len(s.deferred) > 0 {
+		f := s.deferred[0]
+		s.deferred = s.deferred[1:]
 
-		s(appendToDeferredSetupInputLayerQueue)
+		f(s.appendDeferred)
 	}
 }
 
-func appendToDeferredSetupInputLayerQueue(s SetupInputLayerFunc) {
-	deferredSetupInputLayers = append(deferredSetupInputLayers, s)
+func (s *LayerStack) appendDeferred(f SetupInputLayerFunc) {
+	s.deferred = append(s.deferred, f)
 }
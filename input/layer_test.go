@@ -131,6 +131,38 @@ func TestLayer_ActiveFor_EventType(t *testing.T) {
 	is.True(!l2.ActiveFor(100, 100, LayerEventTypeWheel))
 }
 
+func TestLayerStack(t *testing.T) {
+	is := is.New(t)
+
+	s1 := &LayerStack{}
+	s2 := &LayerStack{}
+
+	defer SetCurrentLayerStack(SetCurrentLayerStack(s1))
+
+	l1 := Layer{
+		EventTypes: LayerEventTypeAll,
+		BlockLower: true,
+		FullScreen: true,
+	}
+	AddLayer(&l1)
+
+	SetCurrentLayerStack(s2)
+
+	l2 := Layer{
+		EventTypes: LayerEventTypeAll,
+		BlockLower: true,
+		FullScreen: true,
+	}
+	AddLayer(&l2)
+
+	is.True(l1.ActiveFor(100, 100, LayerEventTypeMouseButton))
+	is.True(l2.ActiveFor(100, 100, LayerEventTypeMouseButton))
+
+	SetupInputLayersWithDeferred(nil)
+	is.True(l1.Valid())
+	is.True(!l2.Valid())
+}
+
 func newLayererMock(f SetupInputLayerFunc) *layererMock {
 	l := layererMock{}
 	l.setupInputLayerCall = l.On("SetupInputLayer", mock.Anything)
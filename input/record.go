@@ -0,0 +1,539 @@
+package input
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Recorder is a FrameSource that records the user input provided by another InputSource, frame by frame.
+// The recording can be replayed using Player.
+//
+// Only frames that differ from their preceding frame are written, together with the number of frames
+// that have passed in between.
+type Recorder struct {
+	src       InputSource
+	w         *bufio.Writer
+	frames    int
+	lastEntry int
+	last      *Frame
+	current   *Frame
+	err       error
+}
+
+// Player is a FrameSource that replays user input previously recorded using Recorder. Each call to
+// ReadFrame advances the replay by exactly one frame, so that input is replayed in sync with the frames
+// it was recorded in.
+type Player struct {
+	entries []recordingEntry
+	frames  int
+	frame   int
+	next    int
+	current *Frame
+}
+
+type recordingEntry struct {
+	frame int
+	input *Frame
+}
+
+const (
+	recordingMagic   = "EBUIREC"
+	recordingVersion = 1
+
+	recordingEntryEnd   = 0
+	recordingEntryFrame = 1
+
+	recordingFlagWheel    = 1
+	recordingFlagChars    = 2
+	recordingFlagKeys     = 4
+	recordingFlagTouches  = 8
+	recordingFlagGamepads = 16
+)
+
+var _ FrameSource = &Recorder{}
+var _ FrameSource = &Player{}
+
+// ErrInvalidRecording is returned by NewPlayer if a recording cannot be read.
+var ErrInvalidRecording = errors.New("invalid input recording")
+
+// NewRecorder constructs a new Recorder that records user input provided by src, writing the recording to w.
+// Close must be called to finish the recording.
+func NewRecorder(src InputSource, w io.Writer) (*Recorder, error) {
+	r := &Recorder{
+		src: src,
+		w:   bufio.NewWriter(w),
+	}
+
+	if _, err := r.w.WriteString(recordingMagic); err != nil {
+		return nil, err
+	}
+
+	if err := r.w.WriteByte(recordingVersion); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// ReadFrame implements FrameSource.
+func (r *Recorder) ReadFrame() *Frame {
+	f := ReadFrame(r.src)
+	r.current = f
+
+	if r.err == nil && (r.last == nil || !reflect.DeepEqual(f, r.last)) {
+		r.err = r.writeFrame(f)
+		r.last = f
+		r.lastEntry = r.frames
+	}
+
+	r.frames++
+
+	return f
+}
+
+// Frames returns the number of frames recorded so far.
+func (r *Recorder) Frames() int {
+	return r.frames
+}
+
+// Close finishes the recording. It does not close the underlying writer. It returns the first error
+// that occurred while recording, if any.
+func (r *Recorder) Close() error {
+	if r.err != nil {
+		return r.err
+	}
+
+	buf := []byte{recordingEntryEnd}
+	buf = appendUvarint(buf, uint64(r.frames-r.lastEntry))
+	if _, err := r.w.Write(buf); err != nil {
+		return err
+	}
+
+	return r.w.Flush()
+}
+
+func (r *Recorder) writeFrame(f *Frame) error {
+	buf := []byte{recordingEntryFrame}
+	buf = appendUvarint(buf, uint64(r.frames-r.lastEntry))
+
+	var buttons byte
+	for _, b := range f.MouseButtons {
+		buttons |= 1 << uint(b)
+	}
+	buf = append(buf, buttons)
+
+	buf = appendVarint(buf, int64(f.CursorX))
+	buf = appendVarint(buf, int64(f.CursorY))
+
+	var flags byte
+	if f.WheelX != 0 || f.WheelY != 0 {
+		flags |= recordingFlagWheel
+	}
+	if len(f.Chars) > 0 {
+		flags |= recordingFlagChars
+	}
+	if len(f.Keys) > 0 {
+		flags |= recordingFlagKeys
+	}
+	if len(f.TouchPoints) > 0 {
+		flags |= recordingFlagTouches
+	}
+	if len(f.GamepadStates) > 0 {
+		flags |= recordingFlagGamepads
+	}
+	buf = append(buf, flags)
+
+	if flags&recordingFlagWheel != 0 {
+		buf = appendFloat64(buf, f.WheelX)
+		buf = appendFloat64(buf, f.WheelY)
+	}
+
+	if flags&recordingFlagChars != 0 {
+		buf = appendUvarint(buf, uint64(len(f.Chars)))
+		for _, c := range f.Chars {
+			buf = appendVarint(buf, int64(c))
+		}
+	}
+
+	if flags&recordingFlagKeys != 0 {
+		buf = appendUvarint(buf, uint64(len(f.Keys)))
+		for _, k := range f.Keys {
+			buf = appendUvarint(buf, uint64(k))
+		}
+	}
+
+	if flags&recordingFlagTouches != 0 {
+		buf = appendUvarint(buf, uint64(len(f.TouchPoints)))
+		for _, t := range f.TouchPoints {
+			buf = appendVarint(buf, int64(t.ID))
+			buf = appendVarint(buf, int64(t.X))
+			buf = appendVarint(buf, int64(t.Y))
+		}
+	}
+
+	if flags&recordingFlagGamepads != 0 {
+		buf = appendUvarint(buf, uint64(len(f.GamepadStates)))
+		for _, g := range f.GamepadStates {
+			buf = appendVarint(buf, int64(g.ID))
+
+			buf = appendUvarint(buf, uint64(len(g.Buttons)))
+			for _, b := range g.Buttons {
+				buf = appendUvarint(buf, uint64(b))
+			}
+
+			buf = appendUvarint(buf, uint64(len(g.Axes)))
+			for _, a := range g.Axes {
+				buf = appendFloat64(buf, a)
+			}
+		}
+	}
+
+	_, err := r.w.Write(buf)
+	return err
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	b := make([]byte, binary.MaxVarintLen64)
+	return append(buf, b[:binary.PutUvarint(b, v)]...)
+}
+
+func appendVarint(buf []byte, v int64) []byte {
+	b := make([]byte, binary.MaxVarintLen64)
+	return append(buf, b[:binary.PutVarint(b, v)]...)
+}
+
+func appendFloat64(buf []byte, v float64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, math.Float64bits(v))
+	return append(buf, b...)
+}
+
+// MouseButtonPressed implements InputSource.
+func (r *Recorder) MouseButtonPressed(b ebiten.MouseButton) bool {
+	return r.currentFrame().MouseButtonPressed(b)
+}
+
+// CursorPosition implements InputSource.
+func (r *Recorder) CursorPosition() (int, int) {
+	return r.currentFrame().CursorPosition()
+}
+
+// Wheel implements InputSource.
+func (r *Recorder) Wheel() (float64, float64) {
+	return r.currentFrame().Wheel()
+}
+
+// InputChars implements InputSource.
+func (r *Recorder) InputChars() []rune { //nolint:golint
+	return r.currentFrame().InputChars()
+}
+
+// KeyPressed implements InputSource.
+func (r *Recorder) KeyPressed(k ebiten.Key) bool {
+	return r.currentFrame().KeyPressed(k)
+}
+
+// Touches implements InputSource.
+func (r *Recorder) Touches() []Touch {
+	return r.currentFrame().Touches()
+}
+
+// Gamepads implements InputSource.
+func (r *Recorder) Gamepads() []Gamepad {
+	return r.currentFrame().Gamepads()
+}
+
+func (r *Recorder) currentFrame() *Frame {
+	if r.current == nil {
+		return &Frame{}
+	}
+	return r.current
+}
+
+// NewPlayer constructs a new Player that replays the recording read from rd.
+func NewPlayer(rd io.Reader) (*Player, error) {
+	br := bufio.NewReader(rd)
+
+	header := make([]byte, len(recordingMagic)+1)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRecording, err)
+	}
+
+	if string(header[:len(recordingMagic)]) != recordingMagic {
+		return nil, fmt.Errorf("%w: unknown format", ErrInvalidRecording)
+	}
+
+	if header[len(recordingMagic)] != recordingVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidRecording, header[len(recordingMagic)])
+	}
+
+	p := &Player{}
+
+	frame := 0
+	for {
+		t, err := br.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRecording, err)
+		}
+
+		delta, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRecording, err)
+		}
+		frame += int(delta)
+
+		if t == recordingEntryEnd {
+			p.frames = frame
+			return p, nil
+		}
+
+		if t != recordingEntryFrame {
+			return nil, fmt.Errorf("%w: unknown entry type %d", ErrInvalidRecording, t)
+		}
+
+		f, err := readRecordedFrame(br)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRecording, err)
+		}
+
+		p.entries = append(p.entries, recordingEntry{
+			frame: frame,
+			input: f,
+		})
+	}
+}
+
+func readRecordedFrame(br *bufio.Reader) (*Frame, error) {
+	f := Frame{}
+
+	buttons, err := br.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range frameMouseButtons {
+		if buttons&(1<<uint(b)) != 0 {
+			f.MouseButtons = append(f.MouseButtons, b)
+		}
+	}
+
+	x, err := binary.ReadVarint(br)
+	if err != nil {
+		return nil, err
+	}
+
+	y, err := binary.ReadVarint(br)
+	if err != nil {
+		return nil, err
+	}
+
+	f.CursorX, f.CursorY = int(x), int(y)
+
+	flags, err := br.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	if flags&recordingFlagWheel != 0 {
+		wheel := make([]byte, 16)
+		if _, err = io.ReadFull(br, wheel); err != nil {
+			return nil, err
+		}
+
+		f.WheelX = math.Float64frombits(binary.LittleEndian.Uint64(wheel))
+		f.WheelY = math.Float64frombits(binary.LittleEndian.Uint64(wheel[8:]))
+	}
+
+	if flags&recordingFlagChars != 0 {
+		n, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := uint64(0); i < n; i++ {
+			c, err := binary.ReadVarint(br)
+			if err != nil {
+				return nil, err
+			}
+
+			f.Chars = append(f.Chars, rune(c))
+		}
+	}
+
+	if flags&recordingFlagKeys != 0 {
+		n, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := uint64(0); i < n; i++ {
+			k, err := binary.ReadUvarint(br)
+			if err != nil {
+				return nil, err
+			}
+
+			f.Keys = append(f.Keys, ebiten.Key(k))
+		}
+	}
+
+	if flags&recordingFlagTouches != 0 {
+		n, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := uint64(0); i < n; i++ {
+			v := [3]int64{}
+			for j := range v {
+				if v[j], err = binary.ReadVarint(br); err != nil {
+					return nil, err
+				}
+			}
+
+			f.TouchPoints = append(f.TouchPoints, Touch{
+				ID: ebiten.TouchID(v[0]),
+				X:  int(v[1]),
+				Y:  int(v[2]),
+			})
+		}
+	}
+
+	if flags&recordingFlagGamepads != 0 {
+		if f.GamepadStates, err = readRecordedGamepads(br); err != nil {
+			return nil, err
+		}
+	}
+
+	return &f, nil
+}
+
+func readRecordedGamepads(br *bufio.Reader) ([]Gamepad, error) {
+	n, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := []Gamepad{}
+	for i := uint64(0); i < n; i++ {
+		id, err := binary.ReadVarint(br)
+		if err != nil {
+			return nil, err
+		}
+
+		g := Gamepad{
+			ID: ebiten.GamepadID(id),
+		}
+
+		nb, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, err
+		}
+
+		for j := uint64(0); j < nb; j++ {
+			b, err := binary.ReadUvarint(br)
+			if err != nil {
+				return nil, err
+			}
+
+			g.Buttons = append(g.Buttons, ebiten.GamepadButton(b))
+		}
+
+		na, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, err
+		}
+
+		axis := make([]byte, 8)
+		for j := uint64(0); j < na; j++ {
+			if _, err := io.ReadFull(br, axis); err != nil {
+				return nil, err
+			}
+
+			g.Axes = append(g.Axes, math.Float64frombits(binary.LittleEndian.Uint64(axis)))
+		}
+
+		gs = append(gs, g)
+	}
+
+	return gs, nil
+}
+
+// ReadFrame implements FrameSource. If the replay is done, it returns frames without any user input.
+func (p *Player) ReadFrame() *Frame {
+	for p.next < len(p.entries) && p.entries[p.next].frame <= p.frame {
+		p.current = p.entries[p.next].input
+		p.next++
+	}
+
+	if p.Done() {
+		p.current = nil
+	}
+
+	p.frame++
+
+	return p.currentFrame()
+}
+
+// Done returns whether all recorded frames have been replayed.
+func (p *Player) Done() bool {
+	return p.frame >= p.frames
+}
+
+// Frame returns the number of frames replayed so far.
+func (p *Player) Frame() int {
+	return p.frame
+}
+
+// Frames returns the total number of frames in the recording.
+func (p *Player) Frames() int {
+	return p.frames
+}
+
+// MouseButtonPressed implements InputSource.
+func (p *Player) MouseButtonPressed(b ebiten.MouseButton) bool {
+	return p.currentFrame().MouseButtonPressed(b)
+}
+
+// CursorPosition implements InputSource.
+func (p *Player) CursorPosition() (int, int) {
+	return p.currentFrame().CursorPosition()
+}
+
+// Wheel implements InputSource.
+func (p *Player) Wheel() (float64, float64) {
+	return p.currentFrame().Wheel()
+}
+
+// InputChars implements InputSource.
+func (p *Player) InputChars() []rune { //nolint:golint
+	return p.currentFrame().InputChars()
+}
+
+// KeyPressed implements InputSource.
+func (p *Player) KeyPressed(k ebiten.Key) bool {
+	return p.currentFrame().KeyPressed(k)
+}
+
+// Touches implements InputSource.
+func (p *Player) Touches() []Touch {
+	return p.currentFrame().Touches()
+}
+
+// Gamepads implements InputSource.
+func (p *Player) Gamepads() []Gamepad {
+	return p.currentFrame().Gamepads()
+}
+
+func (p *Player) currentFrame() *Frame {
+	if p.current == nil {
+		return &Frame{}
+	}
+	return p.current
+}
@@ -0,0 +1,157 @@
+package input
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestReadFrame(t *testing.T) {
+	is := is.New(t)
+
+	f := &FakeInputSource{}
+	f.SetMouseButton(ebiten.MouseButtonRight, true)
+	f.SetCursorPosition(10, 20)
+	f.Scroll(1, 2)
+	f.Type("ab")
+	f.SetKey(ebiten.KeyA, true)
+	f.SetTouches(Touch{ID: 1, X: 3, Y: 4})
+
+	is.Equal(ReadFrame(f), &Frame{
+		MouseButtons: []ebiten.MouseButton{ebiten.MouseButtonRight},
+		CursorX:      10,
+		CursorY:      20,
+		WheelX:       1,
+		WheelY:       2,
+		Chars:        []rune("ab"),
+		Keys:         []ebiten.Key{ebiten.KeyA},
+		TouchPoints:  []Touch{{ID: 1, X: 3, Y: 4}},
+	})
+}
+
+func TestRecorder_Player(t *testing.T) {
+	is := is.New(t)
+
+	src := &FakeInputSource{}
+	buf := bytes.Buffer{}
+	r := newRecorder(t, src, &buf)
+
+	frames := []*Frame{}
+	read := func() {
+		frames = append(frames, r.ReadFrame())
+	}
+
+	src.SetCursorPosition(10, 20)
+	read()
+	read()
+	src.SetMouseButton(ebiten.MouseButtonLeft, true)
+	src.SetCursorPosition(-5, 30)
+	read()
+	src.Scroll(0, -1.5)
+	src.Type("hé")
+	src.SetKey(ebiten.KeyEnter, true)
+	read()
+	src.SetMouseButton(ebiten.MouseButtonLeft, false)
+	src.SetKey(ebiten.KeyEnter, false)
+	src.SetTouches(Touch{ID: 1, X: 1, Y: 2}, Touch{ID: 2, X: 3, Y: 4})
+	read()
+	read()
+	src.SetGamepads(Gamepad{ID: 3, Buttons: []ebiten.GamepadButton{ebiten.GamepadButton1}, Axes: []float64{0.5, -1}})
+	read()
+
+	is.Equal(r.Frames(), 7)
+	is.NoErr(r.Close())
+
+	p := newPlayer(t, &buf)
+	is.Equal(p.Frames(), 7)
+
+	for i, f := range frames {
+		is.True(!p.Done())
+		is.Equal(p.ReadFrame(), f) // frame i
+		is.Equal(p.Frame(), i+1)
+	}
+
+	is.True(p.Done())
+	is.Equal(p.ReadFrame(), &Frame{})
+}
+
+func TestRecorder_SkipsUnchangedFrames(t *testing.T) {
+	is := is.New(t)
+
+	src := &FakeInputSource{}
+	src.SetCursorPosition(100, 200)
+
+	once := bytes.Buffer{}
+	r := newRecorder(t, src, &once)
+	r.ReadFrame()
+	is.NoErr(r.Close())
+
+	many := bytes.Buffer{}
+	r = newRecorder(t, src, &many)
+	for i := 0; i < 1000; i++ {
+		r.ReadFrame()
+	}
+	is.NoErr(r.Close())
+
+	is.True(many.Len()-once.Len() <= 2)
+}
+
+func TestRecorder_InputSource(t *testing.T) {
+	is := is.New(t)
+
+	src := &FakeInputSource{}
+	src.SetCursorPosition(10, 20)
+	src.SetKey(ebiten.KeyA, true)
+
+	r := newRecorder(t, src, &bytes.Buffer{})
+	x, y := r.CursorPosition()
+	is.Equal(x, 0)
+	is.Equal(y, 0)
+
+	r.ReadFrame()
+	x, y = r.CursorPosition()
+	is.Equal(x, 10)
+	is.Equal(y, 20)
+	is.True(r.KeyPressed(ebiten.KeyA))
+}
+
+func TestNewPlayer_Invalid(t *testing.T) {
+	is := is.New(t)
+
+	_, err := NewPlayer(bytes.NewReader([]byte("foo")))
+	is.True(errors.Is(err, ErrInvalidRecording))
+
+	buf := bytes.Buffer{}
+	r := newRecorder(t, &FakeInputSource{}, &buf)
+	r.ReadFrame()
+	is.NoErr(r.Close())
+
+	// truncated
+	_, err = NewPlayer(bytes.NewReader(buf.Bytes()[:buf.Len()-1]))
+	is.True(errors.Is(err, ErrInvalidRecording))
+}
+
+func newRecorder(t *testing.T, src InputSource, buf *bytes.Buffer) *Recorder {
+	t.Helper()
+
+	r, err := NewRecorder(src, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return r
+}
+
+func newPlayer(t *testing.T, buf *bytes.Buffer) *Player {
+	t.Helper()
+
+	p, err := NewPlayer(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return p
+}
@@ -0,0 +1,132 @@
+package input
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+var (
+	// KeyRepeatDelay is the default delay after which a held key starts to repeat.
+	KeyRepeatDelay = 300 * time.Millisecond
+
+	// KeyRepeatInterval is the default interval in which a held key repeats.
+	KeyRepeatInterval = 35 * time.Millisecond
+)
+
+// Repeater implements auto-repeat for held input, such as a key or an action.
+// The zero value is ready to use.
+type Repeater struct {
+	// Delay is the delay after which held input starts to repeat. If zero, KeyRepeatDelay is used.
+	Delay time.Duration
+
+	// Interval is the interval in which held input repeats. If zero, KeyRepeatInterval is used.
+	Interval time.Duration
+
+	pressed bool
+	next    time.Time
+}
+
+// KeyRepeater implements auto-repeat for all keys. The zero value is ready to use.
+type KeyRepeater struct {
+	// Delay is the delay after which held keys start to repeat. If zero, KeyRepeatDelay is used.
+	Delay time.Duration
+
+	// Interval is the interval in which held keys repeat. If zero, KeyRepeatInterval is used.
+	Interval time.Duration
+
+	repeaters map[ebiten.Key]*Repeater
+}
+
+// KeyEvent is a key being pressed, repeated, or released.
+type KeyEvent struct {
+	Key ebiten.Key
+
+	// Released specifies whether the key has been released.
+	Released bool
+
+	// Repeat specifies whether the key is being held and has been repeated.
+	Repeat bool
+}
+
+// Update updates r with the current state of the input, and returns whether the input fires.
+// Input fires during the first frame it is pressed, then repeatedly while it is held. The second
+// return value specifies whether the input has been repeated.
+func (r *Repeater) Update(pressed bool, now time.Time) (bool, bool) {
+	if !pressed {
+		r.pressed = false
+		return false, false
+	}
+
+	if !r.pressed {
+		r.pressed = true
+		r.next = now.Add(durationOrDefault(r.Delay, KeyRepeatDelay))
+		return true, false
+	}
+
+	if now.Before(r.next) {
+		return false, false
+	}
+
+	r.next = now.Add(durationOrDefault(r.Interval, KeyRepeatInterval))
+	return true, true
+}
+
+// Update updates r with the current state of all keys, and returns the keys that have been pressed,
+// repeated, or released, in ascending order.
+func (r *KeyRepeater) Update(now time.Time) []KeyEvent {
+	if r.repeaters == nil {
+		r.repeaters = map[ebiten.Key]*Repeater{}
+	}
+
+	evs := []KeyEvent{}
+
+	for k, kr := range r.repeaters {
+		if !KeyPressed(k) {
+			kr.Update(false, now)
+			delete(r.repeaters, k)
+			evs = append(evs, KeyEvent{
+				Key:      k,
+				Released: true,
+			})
+		}
+	}
+
+	if AnyKeyPressed() {
+		for k := ebiten.Key(0); k <= ebiten.KeyMax; k++ {
+			if !KeyPressed(k) {
+				continue
+			}
+
+			kr, ok := r.repeaters[k]
+			if !ok {
+				kr = &Repeater{
+					Delay:    r.Delay,
+					Interval: r.Interval,
+				}
+				r.repeaters[k] = kr
+			}
+
+			if fire, repeat := kr.Update(true, now); fire {
+				evs = append(evs, KeyEvent{
+					Key:    k,
+					Repeat: repeat,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(evs, func(a int, b int) bool {
+		return evs[a].Key < evs[b].Key
+	})
+
+	return evs
+}
+
+func durationOrDefault(d time.Duration, def time.Duration) time.Duration {
+	if d > 0 {
+		return d
+	}
+	return def
+}
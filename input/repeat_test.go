@@ -0,0 +1,94 @@
+package input
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestRepeater_Update(t *testing.T) {
+	is := is.New(t)
+
+	r := Repeater{
+		Delay:    100 * time.Millisecond,
+		Interval: 10 * time.Millisecond,
+	}
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	update := func(pressed bool, d time.Duration) (bool, bool) {
+		now = now.Add(d)
+		return r.Update(pressed, now)
+	}
+
+	fire, repeat := update(true, 0)
+	is.True(fire)
+	is.True(!repeat)
+
+	fire, _ = update(true, 99*time.Millisecond)
+	is.True(!fire)
+
+	fire, repeat = update(true, time.Millisecond)
+	is.True(fire)
+	is.True(repeat)
+
+	fire, _ = update(true, 5*time.Millisecond)
+	is.True(!fire)
+
+	fire, repeat = update(true, 5*time.Millisecond)
+	is.True(fire)
+	is.True(repeat)
+
+	fire, _ = update(false, 0)
+	is.True(!fire)
+
+	fire, repeat = update(true, 0)
+	is.True(fire)
+	is.True(!repeat)
+}
+
+func TestRepeater_Update_Defaults(t *testing.T) {
+	is := is.New(t)
+
+	r := Repeater{}
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	r.Update(true, now)
+
+	fire, _ := r.Update(true, now.Add(KeyRepeatDelay-time.Millisecond))
+	is.True(!fire)
+
+	fire, _ = r.Update(true, now.Add(KeyRepeatDelay))
+	is.True(fire)
+}
+
+func TestKeyRepeater_Update(t *testing.T) {
+	is := is.New(t)
+
+	r := KeyRepeater{
+		Delay:    100 * time.Millisecond,
+		Interval: 10 * time.Millisecond,
+	}
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	src := &FakeInputSource{}
+	update := func(d time.Duration) []KeyEvent {
+		keyFrame(t, src)
+		now = now.Add(d)
+		return r.Update(now)
+	}
+
+	src.SetKey(ebiten.KeyA, true)
+	src.SetKey(ebiten.KeyShift, true)
+	is.Equal(update(0), []KeyEvent{{Key: ebiten.KeyA}, {Key: ebiten.KeyShift}})
+	is.Equal(update(50*time.Millisecond), []KeyEvent{})
+	is.Equal(update(50*time.Millisecond), []KeyEvent{{Key: ebiten.KeyA, Repeat: true}, {Key: ebiten.KeyShift, Repeat: true}})
+
+	src.SetKey(ebiten.KeyShift, false)
+	is.Equal(update(time.Millisecond), []KeyEvent{{Key: ebiten.KeyShift, Released: true}})
+
+	src.SetKey(ebiten.KeyA, false)
+	is.Equal(update(time.Millisecond), []KeyEvent{{Key: ebiten.KeyA, Released: true}})
+	is.Equal(update(time.Millisecond), []KeyEvent{})
+}
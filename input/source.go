@@ -0,0 +1,205 @@
+package input
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// InputSource provides raw user input to the UI. The UI reads from its input source once per call to
+// its Update method.
+//
+// The default input source is EbitenInputSource, which reads user input from Ebiten. Other implementations
+// can be used to simulate user input, for example FakeInputSource in tests.
+type InputSource interface { //nolint:golint
+	// MouseButtonPressed returns whether mouse button b is currently pressed.
+	MouseButtonPressed(b ebiten.MouseButton) bool
+
+	// CursorPosition returns the current cursor position.
+	CursorPosition() (int, int)
+
+	// Wheel returns mouse wheel movement since the last call.
+	Wheel() (float64, float64)
+
+	// InputChars returns characters typed since the last call.
+	InputChars() []rune
+
+	// KeyPressed returns whether key k is currently pressed.
+	KeyPressed(k ebiten.Key) bool
+
+	// Touches returns all current touches.
+	Touches() []Touch
+
+	// Gamepads returns the state of all connected gamepads.
+	Gamepads() []Gamepad
+}
+
+// EbitenInputSource is an InputSource that reads user input from Ebiten.
+type EbitenInputSource struct {
+}
+
+// FakeInputSource is an InputSource that provides simulated user input. It can be used to deterministically
+// simulate user interaction, for example in tests. The zero value is ready to use.
+type FakeInputSource struct {
+	mouseButtons map[ebiten.MouseButton]bool
+	cursorX      int
+	cursorY      int
+	wheelX       float64
+	wheelY       float64
+	chars        []rune
+	keys         map[ebiten.Key]bool
+	touches      []Touch
+	gamepads     []Gamepad
+}
+
+var _ InputSource = &EbitenInputSource{}
+var _ InputSource = &FakeInputSource{}
+
+// MouseButtonPressed implements InputSource.
+func (e *EbitenInputSource) MouseButtonPressed(b ebiten.MouseButton) bool {
+	return ebiten.IsMouseButtonPressed(b)
+}
+
+// CursorPosition implements InputSource.
+func (e *EbitenInputSource) CursorPosition() (int, int) {
+	return ebiten.CursorPosition()
+}
+
+// Wheel implements InputSource.
+func (e *EbitenInputSource) Wheel() (float64, float64) {
+	return ebiten.Wheel()
+}
+
+// InputChars implements InputSource.
+func (e *EbitenInputSource) InputChars() []rune { //nolint:golint
+	return ebiten.InputChars()
+}
+
+// KeyPressed implements InputSource.
+func (e *EbitenInputSource) KeyPressed(k ebiten.Key) bool {
+	return ebiten.IsKeyPressed(k)
+}
+
+// Touches implements InputSource.
+func (e *EbitenInputSource) Touches() []Touch {
+	ids := ebiten.TouchIDs()
+
+	ts := make([]Touch, 0, len(ids))
+	for _, id := range ids {
+		x, y := ebiten.TouchPosition(id)
+		ts = append(ts, Touch{
+			ID: id,
+			X:  x,
+			Y:  y,
+		})
+	}
+
+	return ts
+}
+
+// Gamepads implements InputSource.
+func (e *EbitenInputSource) Gamepads() []Gamepad {
+	ids := ebiten.GamepadIDs()
+
+	gs := make([]Gamepad, 0, len(ids))
+	for _, id := range ids {
+		g := Gamepad{
+			ID: id,
+		}
+
+		for b := ebiten.GamepadButton(0); int(b) < ebiten.GamepadButtonNum(id); b++ {
+			if ebiten.IsGamepadButtonPressed(id, b) {
+				g.Buttons = append(g.Buttons, b)
+			}
+		}
+
+		for a := 0; a < ebiten.GamepadAxisNum(id); a++ {
+			g.Axes = append(g.Axes, ebiten.GamepadAxis(id, a))
+		}
+
+		gs = append(gs, g)
+	}
+
+	return gs
+}
+
+// SetMouseButton sets whether mouse button b is pressed.
+func (f *FakeInputSource) SetMouseButton(b ebiten.MouseButton, pressed bool) {
+	if f.mouseButtons == nil {
+		f.mouseButtons = map[ebiten.MouseButton]bool{}
+	}
+
+	f.mouseButtons[b] = pressed
+}
+
+// SetCursorPosition sets the cursor position to x,y.
+func (f *FakeInputSource) SetCursorPosition(x int, y int) {
+	f.cursorX, f.cursorY = x, y
+}
+
+// Scroll adds mouse wheel movement x,y. It is returned by the next call to Wheel.
+func (f *FakeInputSource) Scroll(x float64, y float64) {
+	f.wheelX += x
+	f.wheelY += y
+}
+
+// Type adds the characters of s as typed input. They are returned by the next call to InputChars.
+func (f *FakeInputSource) Type(s string) {
+	f.chars = append(f.chars, []rune(s)...)
+}
+
+// SetKey sets whether key k is pressed.
+func (f *FakeInputSource) SetKey(k ebiten.Key, pressed bool) {
+	if f.keys == nil {
+		f.keys = map[ebiten.Key]bool{}
+	}
+
+	f.keys[k] = pressed
+}
+
+// SetTouches sets the current touches to ts.
+func (f *FakeInputSource) SetTouches(ts ...Touch) {
+	f.touches = ts
+}
+
+// SetGamepads sets the connected gamepads to gs.
+func (f *FakeInputSource) SetGamepads(gs ...Gamepad) {
+	f.gamepads = gs
+}
+
+// MouseButtonPressed implements InputSource.
+func (f *FakeInputSource) MouseButtonPressed(b ebiten.MouseButton) bool {
+	return f.mouseButtons[b]
+}
+
+// CursorPosition implements InputSource.
+func (f *FakeInputSource) CursorPosition() (int, int) {
+	return f.cursorX, f.cursorY
+}
+
+// Wheel implements InputSource.
+func (f *FakeInputSource) Wheel() (float64, float64) {
+	x, y := f.wheelX, f.wheelY
+	f.wheelX, f.wheelY = 0, 0
+	return x, y
+}
+
+// InputChars implements InputSource.
+func (f *FakeInputSource) InputChars() []rune { //nolint:golint
+	c := f.chars
+	f.chars = nil
+	return c
+}
+
+// KeyPressed implements InputSource.
+func (f *FakeInputSource) KeyPressed(k ebiten.Key) bool {
+	return f.keys[k]
+}
+
+// Touches implements InputSource.
+func (f *FakeInputSource) Touches() []Touch {
+	return f.touches
+}
+
+// Gamepads implements InputSource.
+func (f *FakeInputSource) Gamepads() []Gamepad {
+	return f.gamepads
+}
@@ -0,0 +1,63 @@
+package input
+
+import (
+	"testing"
+
+	internalinput "github.com/blizzy78/ebitenui/internal/input"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestFakeInputSource_Wheel(t *testing.T) {
+	is := is.New(t)
+
+	f := &FakeInputSource{}
+	f.Scroll(1, 2)
+	f.Scroll(0, 3)
+
+	x, y := f.Wheel()
+	is.Equal(x, 1.0)
+	is.Equal(y, 5.0)
+
+	x, y = f.Wheel()
+	is.Equal(x, 0.0)
+	is.Equal(y, 0.0)
+}
+
+func TestFakeInputSource_InputChars(t *testing.T) {
+	is := is.New(t)
+
+	f := &FakeInputSource{}
+	f.Type("ab")
+	f.Type("c")
+
+	is.Equal(f.InputChars(), []rune("abc"))
+	is.Equal(len(f.InputChars()), 0)
+}
+
+func TestFakeInputSource_Update(t *testing.T) {
+	is := is.New(t)
+
+	f := &FakeInputSource{}
+	f.SetCursorPosition(10, 20)
+	f.SetMouseButton(ebiten.MouseButtonRight, true)
+	f.SetKey(ebiten.KeyA, true)
+	f.Type("x")
+
+	internalinput.Update(f)
+	internalinput.Draw()
+
+	x, y := CursorPosition()
+	is.Equal(x, 10)
+	is.Equal(y, 20)
+	is.True(MouseButtonJustPressed(ebiten.MouseButtonRight))
+	is.True(!MouseButtonPressed(ebiten.MouseButtonLeft))
+	is.True(KeyPressed(ebiten.KeyA))
+	is.True(AnyKeyPressed())
+	is.Equal(InputChars(), []rune("x"))
+
+	internalinput.AfterDraw()
+	internalinput.Update(&FakeInputSource{})
+	internalinput.Draw()
+}
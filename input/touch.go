@@ -0,0 +1,114 @@
+package input
+
+import (
+	internalinput "github.com/blizzy78/ebitenui/internal/input"
+)
+
+// Touch is a single touch point on the screen.
+type Touch = internalinput.Touch
+
+// Touches returns all current touches, ordered by ID.
+//
+// The first touch that starts while no other touches are active is the primary touch. While it is the only
+// touch, it is mapped onto the left mouse button and the cursor position, so that widgets react to it just
+// like to mouse input. A second touch ends that mapping until all touches have ended.
+func Touches() []Touch {
+	return state().Touches
+}
+
+// TouchTapped returns whether the primary touch has just ended as a tap, and where it ended.
+// A tap is a short touch that did not move more than a few pixels.
+func TouchTapped() (int, int, bool) {
+	s := state()
+	return s.TouchTapX, s.TouchTapY, s.TouchTapped
+}
+
+// TouchTappedLayer returns whether the primary touch has just ended as a tap, and where it ended,
+// if input layer l is eligible to handle it.
+func TouchTappedLayer(l *Layer) (int, int, bool) {
+	x, y, ok := TouchTapped()
+	if !ok || !l.ActiveFor(x, y, LayerEventTypeTouch) {
+		return 0, 0, false
+	}
+
+	return x, y, true
+}
+
+// TouchDrag returns whether the primary touch is being dragged, and the distance it moved since the last frame.
+func TouchDrag() (int, int, bool) {
+	s := state()
+	return s.TouchDragX, s.TouchDragY, s.TouchDragging
+}
+
+// TouchDragLayer returns whether the primary touch is being dragged, and the distance it moved since the last
+// frame, if input layer l is eligible to handle it. Eligibility is determined by the position where the drag
+// has started.
+func TouchDragLayer(l *Layer) (int, int, bool) {
+	dx, dy, ok := TouchDrag()
+	st := state()
+	if !ok || !l.ActiveFor(st.TouchDragStartX, st.TouchDragStartY, LayerEventTypeTouch) {
+		return 0, 0, false
+	}
+
+	return dx, dy, true
+}
+
+// TouchSwiped returns whether the primary touch has just ended as a swipe, and the distance it moved in total.
+// A swipe is a short touch that moved a large distance.
+func TouchSwiped() (int, int, bool) {
+	s := state()
+	return s.TouchSwipeX, s.TouchSwipeY, s.TouchSwiped
+}
+
+// TouchSwipedLayer returns whether the primary touch has just ended as a swipe, and the distance it moved in
+// total, if input layer l is eligible to handle it. Eligibility is determined by the position where the swipe
+// has started.
+func TouchSwipedLayer(l *Layer) (int, int, bool) {
+	dx, dy, ok := TouchSwiped()
+	st := state()
+	if !ok || !l.ActiveFor(st.TouchSwipeStartX, st.TouchSwipeStartY, LayerEventTypeTouch) {
+		return 0, 0, false
+	}
+
+	return dx, dy, true
+}
+
+// TouchPinch returns whether two touches are active, and the factor by which the distance between them has
+// changed since the last frame.
+func TouchPinch() (float64, bool) {
+	s := state()
+	return s.TouchPinchScale, s.TouchTwoFinger
+}
+
+// TouchPinchLayer returns whether two touches are active, and the factor by which the distance between them
+// has changed since the last frame, if input layer l is eligible to handle it. Eligibility is determined by
+// the position between both touches.
+func TouchPinchLayer(l *Layer) (float64, bool) {
+	s, ok := TouchPinch()
+	st := state()
+	if !ok || !l.ActiveFor(st.TouchTwoFingerX, st.TouchTwoFingerY, LayerEventTypeTouch) {
+		return 1, false
+	}
+
+	return s, true
+}
+
+// TouchScroll returns whether two touches are active, and the distance the position between them has moved
+// since the last frame.
+func TouchScroll() (float64, float64, bool) {
+	s := state()
+	return s.TouchScrollX, s.TouchScrollY, s.TouchTwoFinger
+}
+
+// TouchScrollLayer returns whether two touches are active, and the distance the position between them has
+// moved since the last frame, if input layer l is eligible to handle it. Eligibility is determined by the
+// position between both touches.
+func TouchScrollLayer(l *Layer) (float64, float64, bool) {
+	x, y, ok := TouchScroll()
+	st := state()
+	if !ok || !l.ActiveFor(st.TouchTwoFingerX, st.TouchTwoFingerY, LayerEventTypeTouch) {
+		return 0, 0, false
+	}
+
+	return x, y, true
+}
@@ -0,0 +1,180 @@
+package input
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	internalinput "github.com/blizzy78/ebitenui/internal/input"
+
+	"github.com/matryer/is"
+)
+
+func TestTouchTapped(t *testing.T) {
+	is := is.New(t)
+
+	now := fakeTouchTime(t)
+
+	touchInput(t, Touch{ID: 1, X: 10, Y: 20})
+	is.True(MouseButtonJustPressed(0))
+
+	*now = now.Add(100 * time.Millisecond)
+	touchInput(t, Touch{ID: 1, X: 12, Y: 20})
+
+	touchInput(t)
+
+	x, y, ok := TouchTapped()
+	is.True(ok)
+	is.Equal(x, 12)
+	is.Equal(y, 20)
+	is.True(!MouseButtonPressed(0))
+}
+
+func TestTouchTapped_TooLong(t *testing.T) {
+	is := is.New(t)
+
+	now := fakeTouchTime(t)
+
+	touchInput(t, Touch{ID: 1, X: 10, Y: 20})
+	*now = now.Add(time.Second)
+	touchInput(t)
+
+	_, _, ok := TouchTapped()
+	is.True(!ok)
+}
+
+func TestTouchDrag(t *testing.T) {
+	is := is.New(t)
+
+	fakeTouchTime(t)
+
+	touchInput(t, Touch{ID: 1, X: 10, Y: 10})
+
+	touchInput(t, Touch{ID: 1, X: 12, Y: 10})
+	_, _, ok := TouchDrag()
+	is.True(!ok)
+
+	touchInput(t, Touch{ID: 1, X: 30, Y: 10})
+	dx, dy, ok := TouchDrag()
+	is.True(ok)
+	is.Equal(dx, 18)
+	is.Equal(dy, 0)
+
+	x, y := CursorPosition()
+	is.Equal(x, 30)
+	is.Equal(y, 10)
+
+	touchInput(t)
+
+	_, _, ok = TouchDrag()
+	is.True(!ok)
+
+	_, _, ok = TouchTapped()
+	is.True(!ok)
+}
+
+func TestTouchSwiped(t *testing.T) {
+	is := is.New(t)
+
+	now := fakeTouchTime(t)
+
+	touchInput(t, Touch{ID: 1, X: 10, Y: 10})
+	*now = now.Add(50 * time.Millisecond)
+	touchInput(t, Touch{ID: 1, X: 50, Y: 10})
+	*now = now.Add(50 * time.Millisecond)
+	touchInput(t, Touch{ID: 1, X: 110, Y: 20})
+	touchInput(t)
+
+	dx, dy, ok := TouchSwiped()
+	is.True(ok)
+	is.Equal(dx, 100)
+	is.Equal(dy, 10)
+}
+
+func TestTouchPinchAndScroll(t *testing.T) {
+	is := is.New(t)
+
+	fakeTouchTime(t)
+
+	touchInput(t, Touch{ID: 1, X: 10, Y: 10})
+	touchInput(t, Touch{ID: 1, X: 10, Y: 10}, Touch{ID: 2, X: 30, Y: 10})
+	is.True(!MouseButtonPressed(0))
+
+	touchInput(t, Touch{ID: 1, X: 0, Y: 20}, Touch{ID: 2, X: 40, Y: 20})
+
+	s, ok := TouchPinch()
+	is.True(ok)
+	is.Equal(s, 2.0)
+
+	dx, dy, ok := TouchScroll()
+	is.True(ok)
+	is.Equal(dx, 0.0)
+	is.Equal(dy, 10.0)
+
+	touchInput(t, Touch{ID: 1, X: 0, Y: 20})
+	touchInput(t)
+
+	_, ok = TouchPinch()
+	is.True(!ok)
+
+	_, _, ok = TouchTapped()
+	is.True(!ok)
+}
+
+func TestTouchDragLayer(t *testing.T) {
+	is := is.New(t)
+
+	fakeTouchTime(t)
+
+	l := &Layer{
+		EventTypes: LayerEventTypeTouch,
+		BlockLower: true,
+		RectFunc: func() image.Rectangle {
+			return image.Rect(0, 0, 20, 20)
+		},
+	}
+	oldLayers := currentLayerStack.layers
+	currentLayerStack.layers = []*Layer{l}
+	defer func() {
+		currentLayerStack.layers = oldLayers
+	}()
+
+	touchInput(t, Touch{ID: 1, X: 10, Y: 10})
+	touchInput(t, Touch{ID: 1, X: 50, Y: 10})
+
+	_, _, ok := TouchDragLayer(l)
+	is.True(ok)
+
+	_, _, ok = TouchDragLayer(&DefaultLayer)
+	is.True(!ok)
+
+	touchInput(t)
+}
+
+// touchInput simulates the touches ts for a single frame.
+func touchInput(t *testing.T, ts ...Touch) {
+	t.Helper()
+
+	src := &FakeInputSource{}
+	src.SetTouches(ts...)
+
+	internalinput.Update(src)
+	internalinput.Draw()
+}
+
+// fakeTouchTime replaces the current time used for touch gestures with the returned value for the duration
+// of the test.
+func fakeTouchTime(t *testing.T) *time.Time {
+	t.Helper()
+
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	internalinput.Now = func() time.Time {
+		return now
+	}
+
+	t.Cleanup(func() {
+		internalinput.Now = time.Now
+	})
+
+	return &now
+}
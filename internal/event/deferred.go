@@ -6,22 +6,58 @@ type DeferredAction interface {
 	Do()
 }
 
-var deferredActions []DeferredAction
+// Queue is a queue of deferred actions. Each UI owns a queue.
+type Queue struct {
+	actions []DeferredAction
+}
+
+// DefaultQueue is the queue used while no other queue is current.
+var DefaultQueue = &Queue{}
+
+var currentQueue = DefaultQueue
+
+// SetCurrentQueue makes q the current queue and returns the previously current queue.
+// If q is nil, DefaultQueue becomes the current queue.
+func SetCurrentQueue(q *Queue) *Queue {
+	prev := currentQueue
+
+	if q == nil {
+		q = DefaultQueue
+	}
+	currentQueue = q
+
+	return prev
+}
+
+// CurrentQueue returns the current queue.
+func CurrentQueue() *Queue {
+	return currentQueue
+}
 
-// AddDeferred adds d to the queue of deferred actions.
+// AddDeferred adds d to the current queue of deferred actions.
 func AddDeferred(d DeferredAction) {
-	deferredActions = append(deferredActions, d)
+	currentQueue.Add(d)
 }
 
-// ExecuteDeferred processes the queue of deferred actions and executes them.
+// ExecuteDeferred processes the current queue of deferred actions and executes them.
 func ExecuteDeferred() {
+	currentQueue.Execute()
+}
+
+// Add adds d to q.
+func (q *Queue) Add(d DeferredAction) {
+	q.actions = append(q.actions, d)
+}
+
+// Execute processes q and executes its actions, including actions that are added while processing.
+func (q *Queue) Execute() {
 	defer func(d []DeferredAction) {
-		deferredActions = d[:0]
-	}(deferredActions)
+		q.actions = d[:0]
+	}(q.actions)
 
-	for len(deferredActions) > 0 {
-		a := deferredActions[0]
-		deferredActions = deferredActions[1:]
+	for len(q.actions) > 0 {
+		a := q.actions[0]
+		q.actions = q.actions[1:]
 
 		a.Do()
 	}
@@ -26,6 +26,22 @@ func TestAddDeferred(t *testing.T) {
 	a2.AssertExpectations(t)
 }
 
+func TestSetCurrentQueue(t *testing.T) {
+	a := &deferredActionMock{}
+	a.On("Do").Once()
+
+	q := &Queue{}
+	prev := SetCurrentQueue(q)
+	AddDeferred(a)
+	SetCurrentQueue(prev)
+
+	ExecuteDeferred()
+	a.AssertNotCalled(t, "Do")
+
+	q.Execute()
+	a.AssertExpectations(t)
+}
+
 func (d *deferredActionMock) Do() {
 	d.Called()
 }
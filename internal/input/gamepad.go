@@ -0,0 +1,74 @@
+package input
+
+import (
+	"sort"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Gamepad is the state of a single gamepad.
+type Gamepad struct {
+	ID ebiten.GamepadID
+
+	// Buttons are the buttons that are pressed.
+	Buttons []ebiten.GamepadButton
+
+	// Axes are the values of all axes, each in the range [-1,1].
+	Axes []float64
+}
+
+// drawGamepads detects gamepads that have been connected or disconnected since the last frame.
+func (s *State) drawGamepads() {
+	sort.Slice(s.Gamepads, func(a int, b int) bool {
+		return s.Gamepads[a].ID < s.Gamepads[b].ID
+	})
+
+	s.LastGamepads = s.drawnGamepads
+	s.drawnGamepads = s.Gamepads
+
+	s.GamepadsJustConnected = s.GamepadsJustConnected[:0]
+	for _, g := range s.Gamepads {
+		if FindGamepad(s.LastGamepads, g.ID) == nil {
+			s.GamepadsJustConnected = append(s.GamepadsJustConnected, g.ID)
+		}
+	}
+
+	s.GamepadsJustDisconnected = s.GamepadsJustDisconnected[:0]
+	for _, g := range s.LastGamepads {
+		if FindGamepad(s.Gamepads, g.ID) == nil {
+			s.GamepadsJustDisconnected = append(s.GamepadsJustDisconnected, g.ID)
+		}
+	}
+}
+
+// FindGamepad returns the gamepad with ID id in gs, or nil if there is none.
+func FindGamepad(gs []Gamepad, id ebiten.GamepadID) *Gamepad {
+	for i := range gs {
+		if gs[i].ID == id {
+			return &gs[i]
+		}
+	}
+	return nil
+}
+
+// ButtonPressed returns whether button b of g is pressed. g may be nil.
+func (g *Gamepad) ButtonPressed(b ebiten.GamepadButton) bool {
+	if g == nil {
+		return false
+	}
+
+	for _, gb := range g.Buttons {
+		if gb == b {
+			return true
+		}
+	}
+	return false
+}
+
+// Axis returns the value of axis a of g, or 0 if g does not have that axis. g may be nil.
+func (g *Gamepad) Axis(a int) float64 {
+	if g == nil || a < 0 || a >= len(g.Axes) {
+		return 0
+	}
+	return g.Axes[a]
+}
@@ -4,7 +4,9 @@ import (
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
-var (
+// State is the state of the input system. Each UI owns a state, so that multiple UIs do not
+// interfere with each other.
+type State struct {
 	LeftMouseButtonPressed   bool
 	MiddleMouseButtonPressed bool
 	RightMouseButtonPressed  bool
@@ -21,48 +23,153 @@ var (
 	LastMiddleMouseButtonPressed bool
 	LastRightMouseButtonPressed  bool
 
-	InputChars    []rune
-	KeyPressed    = map[ebiten.Key]bool{}
-	AnyKeyPressed bool
-)
+	InputChars     []rune
+	KeyPressed     map[ebiten.Key]bool
+	KeyJustPressed map[ebiten.Key]bool
+	LastKeyPressed map[ebiten.Key]bool
+	AnyKeyPressed  bool
+
+	Touches []Touch
+
+	TouchTapped bool
+	TouchTapX   int
+	TouchTapY   int
+
+	TouchDragging    bool
+	TouchDragX       int
+	TouchDragY       int
+	TouchDragStartX  int
+	TouchDragStartY  int
+	TouchSwiped      bool
+	TouchSwipeX      int
+	TouchSwipeY      int
+	TouchSwipeStartX int
+	TouchSwipeStartY int
+
+	TouchTwoFinger  bool
+	TouchPinchScale float64
+	TouchScrollX    float64
+	TouchScrollY    float64
+	TouchTwoFingerX int
+	TouchTwoFingerY int
+
+	Gamepads     []Gamepad
+	LastGamepads []Gamepad
+
+	GamepadsJustConnected    []ebiten.GamepadID
+	GamepadsJustDisconnected []ebiten.GamepadID
+
+	// GamepadTriggerAxes are the axes of the triggers used for scrolling, or nil to use the standard axes.
+	GamepadTriggerAxes *[2]int
+
+	primary        primaryTouch
+	twoFinger      twoFingerTouch
+	lastTouchCount int
+	drawnGamepads  []Gamepad
+}
+
+// Source provides raw user input.
+type Source interface {
+	MouseButtonPressed(b ebiten.MouseButton) bool
+	CursorPosition() (int, int)
+	Wheel() (float64, float64)
+	InputChars() []rune
+	KeyPressed(k ebiten.Key) bool
+	Touches() []Touch
+	Gamepads() []Gamepad
+}
+
+// DefaultState is the state used while no other state is current. The UI replaces it with its own state
+// when it is updated, so that user input can also be queried outside of the UI.
+var DefaultState = &State{}
+
+// currentState is the current state, or nil if DefaultState is current.
+var currentState *State
+
+// SetCurrentState makes s the current state and returns the previously current state.
+// If s is nil, DefaultState becomes the current state.
+func SetCurrentState(s *State) *State {
+	prev := currentState
+	currentState = s
+	return prev
+}
+
+// CurrentState returns the current state.
+func CurrentState() *State {
+	if currentState == nil {
+		return DefaultState
+	}
+	return currentState
+}
+
+// Update updates the current state by reading from src. This is called by the UI.
+func Update(src Source) {
+	CurrentState().update(src)
+}
+
+// Draw updates the current state. This is called by the UI.
+func Draw() {
+	CurrentState().draw()
+}
+
+// AfterDraw updates the current state after the Ebiten Draw function has been called. This is called by the UI.
+func AfterDraw() {
+	CurrentState().afterDraw()
+}
 
-// Update updates the input system. This is called by the UI.
-func Update() {
-	LeftMouseButtonPressed = ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft)
-	MiddleMouseButtonPressed = ebiten.IsMouseButtonPressed(ebiten.MouseButtonMiddle)
-	RightMouseButtonPressed = ebiten.IsMouseButtonPressed(ebiten.MouseButtonRight)
-	CursorX, CursorY = ebiten.CursorPosition()
+func (s *State) update(src Source) {
+	s.LeftMouseButtonPressed = src.MouseButtonPressed(ebiten.MouseButtonLeft)
+	s.MiddleMouseButtonPressed = src.MouseButtonPressed(ebiten.MouseButtonMiddle)
+	s.RightMouseButtonPressed = src.MouseButtonPressed(ebiten.MouseButtonRight)
+	s.Touches = src.Touches()
+	s.Gamepads = src.Gamepads()
+	s.updateCursor(src.CursorPosition())
 
-	wx, wy := ebiten.Wheel()
-	WheelX += wx
-	WheelY += wy
+	wx, wy := src.Wheel()
+	s.WheelX += wx
+	s.WheelY += wy
 
-	InputChars = append(InputChars, ebiten.InputChars()...)
+	s.InputChars = append(s.InputChars, src.InputChars()...)
 
-	AnyKeyPressed = false
+	if s.KeyPressed == nil {
+		s.KeyPressed = map[ebiten.Key]bool{}
+	}
+
+	s.AnyKeyPressed = false
 	for k := ebiten.Key(0); k <= ebiten.KeyMax; k++ {
-		p := ebiten.IsKeyPressed(k)
-		KeyPressed[k] = p
+		p := src.KeyPressed(k)
+		s.KeyPressed[k] = p
 
 		if p {
-			AnyKeyPressed = true
+			s.AnyKeyPressed = true
 		}
 	}
 }
 
-// Draw updates the input system. This is called by the UI.
-func Draw() {
-	LeftMouseButtonJustPressed = LeftMouseButtonPressed && LeftMouseButtonPressed != LastLeftMouseButtonPressed
-	MiddleMouseButtonJustPressed = MiddleMouseButtonPressed && MiddleMouseButtonPressed != LastMiddleMouseButtonPressed
-	RightMouseButtonJustPressed = RightMouseButtonPressed && RightMouseButtonPressed != LastRightMouseButtonPressed
+func (s *State) draw() {
+	s.drawTouches()
+	s.drawGamepads()
+
+	s.LeftMouseButtonJustPressed = s.LeftMouseButtonPressed && s.LeftMouseButtonPressed != s.LastLeftMouseButtonPressed
+	s.MiddleMouseButtonJustPressed = s.MiddleMouseButtonPressed && s.MiddleMouseButtonPressed != s.LastMiddleMouseButtonPressed
+	s.RightMouseButtonJustPressed = s.RightMouseButtonPressed && s.RightMouseButtonPressed != s.LastRightMouseButtonPressed
+
+	s.LastLeftMouseButtonPressed = s.LeftMouseButtonPressed
+	s.LastMiddleMouseButtonPressed = s.MiddleMouseButtonPressed
+	s.LastRightMouseButtonPressed = s.RightMouseButtonPressed
 
-	LastLeftMouseButtonPressed = LeftMouseButtonPressed
-	LastMiddleMouseButtonPressed = MiddleMouseButtonPressed
-	LastRightMouseButtonPressed = RightMouseButtonPressed
+	if s.KeyJustPressed == nil {
+		s.KeyJustPressed = map[ebiten.Key]bool{}
+		s.LastKeyPressed = map[ebiten.Key]bool{}
+	}
+
+	for k, p := range s.KeyPressed {
+		s.KeyJustPressed[k] = p && !s.LastKeyPressed[k]
+		s.LastKeyPressed[k] = p
+	}
 }
 
-// AfterDraw updates the input system after the Ebiten Draw function has been called. This is called by the UI.
-func AfterDraw() {
-	InputChars = InputChars[:0]
-	WheelX, WheelY = 0, 0
+func (s *State) afterDraw() {
+	s.InputChars = s.InputChars[:0]
+	s.WheelX, s.WheelY = 0, 0
 }
@@ -0,0 +1,188 @@
+package input
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Touch is a single touch point on the screen.
+type Touch struct {
+	ID ebiten.TouchID
+	X  int
+	Y  int
+}
+
+type primaryTouch struct {
+	active    bool
+	cancelled bool
+	dragging  bool
+	id        ebiten.TouchID
+	startX    int
+	startY    int
+	startTime time.Time
+	lastX     int
+	lastY     int
+}
+
+type twoFingerTouch struct {
+	active bool
+	ids    [2]ebiten.TouchID
+	dist   float64
+	midX   float64
+	midY   float64
+}
+
+const (
+	// TapMaxDistance is the maximum distance a touch may move to still be considered a tap. Touches that
+	// move farther are considered drags.
+	TapMaxDistance = 10
+
+	// TapMaxDuration is the maximum duration of a touch to still be considered a tap.
+	TapMaxDuration = 300 * time.Millisecond
+
+	// SwipeMinDistance is the minimum distance a touch must move to be considered a swipe.
+	SwipeMinDistance = 50
+
+	// SwipeMaxDuration is the maximum duration of a touch to still be considered a swipe.
+	SwipeMaxDuration = 300 * time.Millisecond
+)
+
+// Now returns the current time. It is a variable so that tests can replace it.
+var Now = time.Now
+
+// updateCursor updates the cursor position, unless it is currently set by touches.
+func (s *State) updateCursor(x int, y int) {
+	if len(s.Touches) > 0 || s.lastTouchCount > 0 {
+		return
+	}
+
+	s.CursorX, s.CursorY = x, y
+}
+
+// drawTouches recognizes touch gestures. It also maps the primary touch onto the left mouse button and the
+// cursor position. It must be called before mouse button state is evaluated.
+func (s *State) drawTouches() {
+	s.TouchTapped = false
+	s.TouchSwiped = false
+	s.TouchDragX, s.TouchDragY = 0, 0
+	s.TouchPinchScale = 1
+	s.TouchScrollX, s.TouchScrollY = 0, 0
+
+	sort.Slice(s.Touches, func(a int, b int) bool {
+		return s.Touches[a].ID < s.Touches[b].ID
+	})
+
+	s.drawTwoFingerTouches()
+	s.drawPrimaryTouch()
+
+	s.lastTouchCount = len(s.Touches)
+}
+
+func (s *State) drawTwoFingerTouches() {
+	if len(s.Touches) < 2 {
+		s.twoFinger.active = false
+		s.TouchTwoFinger = false
+		return
+	}
+
+	a, b := s.Touches[0], s.Touches[1]
+	ids := [2]ebiten.TouchID{a.ID, b.ID}
+	dist := math.Hypot(float64(b.X-a.X), float64(b.Y-a.Y))
+	midX, midY := float64(a.X+b.X)/2, float64(a.Y+b.Y)/2
+
+	if s.twoFinger.active && s.twoFinger.ids == ids {
+		s.TouchTwoFinger = true
+
+		if s.twoFinger.dist > 0 {
+			s.TouchPinchScale = dist / s.twoFinger.dist
+		}
+
+		s.TouchScrollX, s.TouchScrollY = midX-s.twoFinger.midX, midY-s.twoFinger.midY
+	}
+
+	s.twoFinger = twoFingerTouch{
+		active: true,
+		ids:    ids,
+		dist:   dist,
+		midX:   midX,
+		midY:   midY,
+	}
+
+	s.TouchTwoFingerX, s.TouchTwoFingerY = int(math.Round(midX)), int(math.Round(midY))
+
+	// a second finger ends any single-finger interaction
+	s.primary.cancelled = true
+	s.TouchDragging = false
+}
+
+func (s *State) drawPrimaryTouch() {
+	if !s.primary.active && len(s.Touches) > 0 && s.lastTouchCount == 0 {
+		t := s.Touches[0]
+		s.primary = primaryTouch{
+			active:    true,
+			id:        t.ID,
+			startX:    t.X,
+			startY:    t.Y,
+			startTime: Now(),
+			lastX:     t.X,
+			lastY:     t.Y,
+		}
+	}
+
+	if !s.primary.active {
+		return
+	}
+
+	for _, t := range s.Touches {
+		if t.ID != s.primary.id {
+			continue
+		}
+
+		if !s.primary.cancelled {
+			s.LeftMouseButtonPressed = true
+			s.CursorX, s.CursorY = t.X, t.Y
+
+			if !s.primary.dragging && distance(t.X-s.primary.startX, t.Y-s.primary.startY) > TapMaxDistance {
+				s.primary.dragging = true
+				s.TouchDragStartX, s.TouchDragStartY = s.primary.startX, s.primary.startY
+			}
+
+			if s.primary.dragging {
+				s.TouchDragging = true
+				s.TouchDragX, s.TouchDragY = t.X-s.primary.lastX, t.Y-s.primary.lastY
+			}
+		}
+
+		s.primary.lastX, s.primary.lastY = t.X, t.Y
+
+		return
+	}
+
+	// primary touch has ended
+
+	if !s.primary.cancelled {
+		d := Now().Sub(s.primary.startTime)
+		dx, dy := s.primary.lastX-s.primary.startX, s.primary.lastY-s.primary.startY
+
+		if !s.primary.dragging && d <= TapMaxDuration {
+			s.TouchTapped = true
+			s.TouchTapX, s.TouchTapY = s.primary.lastX, s.primary.lastY
+		}
+
+		if distance(dx, dy) >= SwipeMinDistance && d <= SwipeMaxDuration {
+			s.TouchSwiped = true
+			s.TouchSwipeX, s.TouchSwipeY = dx, dy
+			s.TouchSwipeStartX, s.TouchSwipeStartY = s.primary.startX, s.primary.startY
+		}
+	}
+
+	s.primary = primaryTouch{}
+	s.TouchDragging = false
+}
+
+func distance(dx int, dy int) float64 {
+	return math.Hypot(float64(dx), float64(dy))
+}
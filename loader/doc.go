@@ -0,0 +1,30 @@
+// Package loader builds widget trees from declarative JSON or YAML documents, so that screens can be
+// edited without changing Go code.
+//
+// A document describes a tree of nodes. Each node names a widget type, such as "Container" or "Button",
+// and its options. Images, fonts, colors, and event handlers are referenced by name and resolved from
+// Resources, which are registered from Go. An example document:
+//
+//	{
+//	  "type": "Container",
+//	  "layout": {"type": "RowLayout", "direction": "vertical", "spacing": 10},
+//	  "children": [
+//	    {
+//	      "type": "Button",
+//	      "id": "quit",
+//	      "text": "Quit",
+//	      "image": "button",
+//	      "font": "default",
+//	      "color": "buttonText",
+//	      "layoutData": {"stretch": true},
+//	      "on": {"clicked": "quit"}
+//	    }
+//	  ]
+//	}
+//
+// Layout data is interpreted according to the layout of the parent container. Additional widget types
+// can be registered using Loader.RegisterType.
+//
+// Themes are described by theme documents, see ThemeNode. During development, Reloader reloads documents
+// and theme documents when they change.
+package loader
@@ -0,0 +1,238 @@
+package loader
+
+import (
+	"fmt"
+
+	"github.com/blizzy78/ebitenui/widget"
+)
+
+type position int
+
+const (
+	positionStart = position(iota)
+	positionCenter
+	positionEnd
+)
+
+// widgetOpts returns options that configure a widget according to c.Node's generic properties.
+func (c *Context) widgetOpts() ([]widget.WidgetOpt, error) {
+	n := c.Node
+	opts := []widget.WidgetOpt{}
+
+	if n.ID != "" {
+		opts = append(opts, widget.WidgetOpts.ID(n.ID))
+	}
+
+	if len(n.Tags) > 0 {
+		opts = append(opts, widget.WidgetOpts.Tags(n.Tags...))
+	}
+
+	if n.Disabled {
+		opts = append(opts, func(w *widget.Widget) {
+			w.Disabled = true
+		})
+	}
+
+	ld, err := c.layoutData()
+	if err != nil {
+		return nil, err
+	}
+	if ld != nil {
+		opts = append(opts, widget.WidgetOpts.LayoutData(ld))
+	}
+
+	if err := widgetHandler(c, "cursorEnter", widget.WidgetOpts.CursorEnterHandler, &opts); err != nil {
+		return nil, err
+	}
+	if err := widgetHandler(c, "cursorExit", widget.WidgetOpts.CursorExitHandler, &opts); err != nil {
+		return nil, err
+	}
+	if err := widgetHandler(c, "mouseButtonPressed", widget.WidgetOpts.MouseButtonPressedHandler, &opts); err != nil {
+		return nil, err
+	}
+	if err := widgetHandler(c, "mouseButtonReleased", widget.WidgetOpts.MouseButtonReleasedHandler, &opts); err != nil {
+		return nil, err
+	}
+	if err := widgetHandler(c, "scrolled", widget.WidgetOpts.ScrolledHandler, &opts); err != nil {
+		return nil, err
+	}
+	if err := widgetHandler(c, "keyPressed", widget.WidgetOpts.KeyPressedHandler, &opts); err != nil {
+		return nil, err
+	}
+	if err := widgetHandler(c, "keyReleased", widget.WidgetOpts.KeyReleasedHandler, &opts); err != nil {
+		return nil, err
+	}
+
+	return opts, nil
+}
+
+// widgetHandler appends an option created by opt to opts if c.Node specifies a handler for event.
+func widgetHandler[T any](c *Context, event string, opt func(f T) widget.WidgetOpt, opts *[]widget.WidgetOpt) error {
+	h, ok, err := ContextHandler[T](c, event)
+	if err != nil {
+		return err
+	}
+	if ok {
+		*opts = append(*opts, opt(h))
+	}
+	return nil
+}
+
+// layoutData returns the layout data described by c.Node.LayoutData, according to the layout of the parent container.
+func (c *Context) layoutData() (interface{}, error) {
+	d := c.Node.LayoutData
+	if d == nil {
+		return nil, nil
+	}
+
+	switch c.parentLayout {
+	case "RowLayout":
+		p, err := c.position(d.Position)
+		if err != nil {
+			return nil, err
+		}
+
+		return widget.RowLayoutData{
+			Position:  widget.RowLayoutPosition(p),
+			Stretch:   d.Stretch,
+			MaxWidth:  d.MaxWidth,
+			MaxHeight: d.MaxHeight,
+		}, nil
+
+	case "GridLayout":
+		h, err := c.position(d.HorizontalPosition)
+		if err != nil {
+			return nil, err
+		}
+		v, err := c.position(d.VerticalPosition)
+		if err != nil {
+			return nil, err
+		}
+
+		return widget.GridLayoutData{
+			MaxWidth:           d.MaxWidth,
+			MaxHeight:          d.MaxHeight,
+			HorizontalPosition: widget.GridLayoutPosition(h),
+			VerticalPosition:   widget.GridLayoutPosition(v),
+		}, nil
+
+	case "AnchorLayout":
+		h, err := c.anchorPosition(d.HorizontalPosition)
+		if err != nil {
+			return nil, err
+		}
+		v, err := c.anchorPosition(d.VerticalPosition)
+		if err != nil {
+			return nil, err
+		}
+
+		return widget.AnchorLayoutData{
+			HorizontalPosition: h,
+			VerticalPosition:   v,
+			StretchHorizontal:  d.StretchHorizontal,
+			StretchVertical:    d.StretchVertical,
+			Padding:            insets(d.Padding),
+			HorizontalPercent:  d.HorizontalPercent,
+			VerticalPercent:    d.VerticalPercent,
+			WidthPercent:       d.WidthPercent,
+			HeightPercent:      d.HeightPercent,
+			PivotX:             d.PivotX,
+			PivotY:             d.PivotY,
+		}, nil
+
+	case "":
+		return nil, c.Errorf("layoutData requires a parent container with a layout")
+
+	default:
+		return nil, c.Errorf("unknown parent layout type %q", c.parentLayout)
+	}
+}
+
+func (c *Context) position(s string) (position, error) {
+	switch s {
+	case "", "start":
+		return positionStart, nil
+	case "center":
+		return positionCenter, nil
+	case "end":
+		return positionEnd, nil
+	default:
+		return 0, c.Errorf("unknown position %q", s)
+	}
+}
+
+// anchorPosition is like position, but additionally supports "percent".
+func (c *Context) anchorPosition(s string) (widget.AnchorLayoutPosition, error) {
+	if s == "percent" {
+		return widget.AnchorLayoutPositionPercent, nil
+	}
+
+	p, err := c.position(s)
+	return widget.AnchorLayoutPosition(p), err
+}
+
+// layout returns the layout described by c.Node.Layout, or nil if it is not set.
+func (c *Context) layout() (widget.Layouter, error) {
+	l := c.Node.Layout
+	if l == nil {
+		return nil, nil
+	}
+
+	switch l.Type {
+	case "RowLayout":
+		d, err := direction(l.Direction)
+		if err != nil {
+			return nil, c.Errorf("layout: %v", err)
+		}
+
+		return widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(d),
+			widget.RowLayoutOpts.Padding(insets(l.Padding)),
+			widget.RowLayoutOpts.Spacing(l.Spacing),
+		), nil
+
+	case "GridLayout":
+		if l.Columns <= 0 {
+			return nil, c.Errorf("layout: GridLayout requires columns")
+		}
+
+		return widget.NewGridLayout(
+			widget.GridLayoutOpts.Columns(l.Columns),
+			widget.GridLayoutOpts.Padding(insets(l.Padding)),
+			widget.GridLayoutOpts.Spacing(l.ColumnSpacing, l.RowSpacing),
+			widget.GridLayoutOpts.Stretch(l.StretchColumns, l.StretchRows),
+		), nil
+
+	case "AnchorLayout":
+		return widget.NewAnchorLayout(
+			widget.AnchorLayoutOpts.Padding(insets(l.Padding)),
+		), nil
+
+	default:
+		return nil, c.Errorf("unknown layout type %q", l.Type)
+	}
+}
+
+func direction(s string) (widget.Direction, error) {
+	switch s {
+	case "", "horizontal":
+		return widget.DirectionHorizontal, nil
+	case "vertical":
+		return widget.DirectionVertical, nil
+	default:
+		return 0, fmt.Errorf("unknown direction %q", s)
+	}
+}
+
+func insets(i *Insets) widget.Insets {
+	if i == nil {
+		return widget.Insets{}
+	}
+
+	return widget.Insets{
+		Top:    i.Top,
+		Left:   i.Left,
+		Right:  i.Right,
+		Bottom: i.Bottom,
+	}
+}
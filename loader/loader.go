@@ -0,0 +1,265 @@
+package loader
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/blizzy78/ebitenui/widget"
+	"golang.org/x/image/font"
+)
+
+// Loader builds widget trees from documents.
+type Loader struct {
+	resources *Resources
+	types     map[string]TypeFunc
+}
+
+// TypeFunc is a function that builds a widget of a specific type, as described by c.Node.
+type TypeFunc func(c *Context) (widget.PreferredSizeLocateableWidget, error)
+
+// Context is passed to a TypeFunc to build a widget.
+type Context struct {
+	// Resources are the resources of the Loader.
+	Resources *Resources
+
+	// Node is the node that describes the widget.
+	Node *Node
+
+	// WidgetOpts configure the widget's ID, tags, disabled state, layout data, and handlers of the
+	// widget's generic events, such as "cursorEnter". They should be passed to the widget's constructor.
+	WidgetOpts []widget.WidgetOpt
+
+	loader       *Loader
+	path         string
+	parentLayout string
+	root         bool
+	usedEvents   map[string]bool
+}
+
+var (
+	// ErrInvalidDocument is returned if a document cannot be decoded, or if it describes an invalid widget tree.
+	ErrInvalidDocument = errors.New("invalid document")
+
+	// ErrUnknownResource is returned if a document refers to a resource that has not been registered.
+	ErrUnknownResource = errors.New("unknown resource")
+
+	// ErrResourceType is returned if a resource is not of the type required by the widget that uses it.
+	ErrResourceType = errors.New("wrong resource type")
+)
+
+// New constructs a new Loader that resolves resources from r. The built-in widget types are registered
+// automatically.
+func New(r *Resources) *Loader {
+	l := &Loader{
+		resources: r,
+		types:     map[string]TypeFunc{},
+	}
+
+	registerBuiltinTypes(l)
+
+	return l
+}
+
+// RegisterType registers widget type name, which is built by f. Built-in types may be replaced.
+func (l *Loader) RegisterType(name string, f TypeFunc) {
+	l.types[name] = f
+}
+
+// LoadJSON decodes a JSON document from r and builds its widget tree.
+func (l *Loader) LoadJSON(r io.Reader) (widget.PreferredSizeLocateableWidget, error) {
+	n, err := DecodeJSON(r)
+	if err != nil {
+		return nil, err
+	}
+	return l.Load(n)
+}
+
+// LoadYAML decodes a YAML document from r and builds its widget tree.
+func (l *Loader) LoadYAML(r io.Reader) (widget.PreferredSizeLocateableWidget, error) {
+	n, err := DecodeYAML(r)
+	if err != nil {
+		return nil, err
+	}
+	return l.Load(n)
+}
+
+// Load builds the widget tree described by n.
+func (l *Loader) Load(n *Node) (widget.PreferredSizeLocateableWidget, error) {
+	return l.build(n, n.Type, "", true)
+}
+
+func (l *Loader) build(n *Node, path string, parentLayout string, root bool) (widget.PreferredSizeLocateableWidget, error) {
+	if n == nil {
+		return nil, fmt.Errorf("%w: %s: empty node", ErrInvalidDocument, path)
+	}
+
+	f, ok := l.types[n.Type]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s: unknown type %q", ErrInvalidDocument, path, n.Type)
+	}
+
+	c := &Context{
+		Resources: l.resources,
+		Node:      n,
+
+		loader:       l,
+		path:         path,
+		parentLayout: parentLayout,
+		root:         root,
+		usedEvents:   map[string]bool{},
+	}
+
+	opts, err := c.widgetOpts()
+	if err != nil {
+		return nil, err
+	}
+	c.WidgetOpts = opts
+
+	w, err := f(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.checkEvents(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// Children builds the children of c.Node. Their layout data is interpreted according to c.Node.Layout.
+func (c *Context) Children() ([]widget.PreferredSizeLocateableWidget, error) {
+	return c.children(c.childLayout())
+}
+
+// children builds the children of c.Node. Their layout data is interpreted according to layout.
+func (c *Context) children(layout string) ([]widget.PreferredSizeLocateableWidget, error) {
+	ws := make([]widget.PreferredSizeLocateableWidget, len(c.Node.Children))
+	for i, n := range c.Node.Children {
+		t := ""
+		if n != nil {
+			t = n.Type
+		}
+
+		w, err := c.loader.build(n, fmt.Sprintf("%s.children[%d] (%s)", c.path, i, t), layout, false)
+		if err != nil {
+			return nil, err
+		}
+
+		ws[i] = w
+	}
+
+	return ws, nil
+}
+
+// Child builds the single child of c.Node, for widgets such as ScrollContainer that have exactly one child.
+// Its layout data is interpreted according to c.Node.Layout.
+func (c *Context) Child() (widget.PreferredSizeLocateableWidget, error) {
+	return c.child(c.childLayout())
+}
+
+// child builds the single child of c.Node. Its layout data is interpreted according to layout.
+func (c *Context) child(layout string) (widget.PreferredSizeLocateableWidget, error) {
+	if len(c.Node.Children) != 1 {
+		return nil, c.Errorf("exactly one child required, got %d", len(c.Node.Children))
+	}
+
+	n := c.Node.Children[0]
+	t := ""
+	if n != nil {
+		t = n.Type
+	}
+
+	return c.loader.build(n, fmt.Sprintf("%s.children[0] (%s)", c.path, t), layout, false)
+}
+
+// childLayout returns the type of the layout of c.Node, or "" if it has none.
+func (c *Context) childLayout() string {
+	if c.Node.Layout == nil {
+		return ""
+	}
+	return c.Node.Layout.Type
+}
+
+// Errorf returns an error wrapping ErrInvalidDocument, which includes the path of c.Node in the document.
+func (c *Context) Errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s: %s", ErrInvalidDocument, c.path, fmt.Sprintf(format, args...))
+}
+
+// Font returns the font face named by c.Node.Font.
+func (c *Context) Font() (font.Face, error) {
+	f, err := c.Resources.Font(c.Node.Font)
+	return f, c.wrap(err)
+}
+
+// Padding returns c.Node.Padding, or zero insets if it is not set.
+func (c *Context) Padding() widget.Insets {
+	return insets(c.Node.Padding)
+}
+
+// Direction returns c.Node.Direction, which must be "horizontal" or "vertical". The default is "horizontal".
+func (c *Context) Direction() (widget.Direction, error) {
+	d, err := direction(c.Node.Direction)
+	if err != nil {
+		return 0, c.Errorf("%v", err)
+	}
+	return d, nil
+}
+
+// ContextImage returns the image named by name, which must be of type T.
+func ContextImage[T any](c *Context, name string) (T, error) {
+	i, err := Image[T](c.Resources, name)
+	return i, c.wrap(err)
+}
+
+// ContextColor returns the color named by c.Node.Color, which must be of type T.
+func ContextColor[T any](c *Context) (T, error) {
+	col, err := Color[T](c.Resources, c.Node.Color)
+	return col, c.wrap(err)
+}
+
+// ContextHandler returns the handler that c.Node specifies for event, converted to type T. It returns false
+// if c.Node does not specify a handler for event. TypeFuncs must call ContextHandler for all events they
+// support, documents that specify handlers for other events are invalid.
+func ContextHandler[T any](c *Context, event string) (T, bool, error) {
+	var t T
+
+	name, ok := c.Node.On[event]
+	if !ok {
+		return t, false, nil
+	}
+
+	c.usedEvents[event] = true
+
+	t, err := Handler[T](c.Resources, name)
+	if err != nil {
+		return t, false, c.wrap(err)
+	}
+	return t, true, nil
+}
+
+func (c *Context) wrap(err error) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s: %w", c.path, err)
+}
+
+func (c *Context) checkEvents() error {
+	unknown := []string{}
+	for e := range c.Node.On {
+		if !c.usedEvents[e] {
+			unknown = append(unknown, e)
+		}
+	}
+
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	sort.Strings(unknown)
+	return c.Errorf("unsupported events for type %s: %s", c.Node.Type, strings.Join(unknown, ", "))
+}
@@ -0,0 +1,421 @@
+package loader
+
+import (
+	"errors"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/blizzy78/ebitenui/event"
+	"github.com/blizzy78/ebitenui/image"
+	"github.com/blizzy78/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2"
+	"golang.org/x/image/font/basicfont"
+
+	"github.com/matryer/is"
+)
+
+func TestLoader_LoadJSON(t *testing.T) {
+	is := is.New(t)
+
+	clicked := false
+
+	r := newResources(t)
+	r.AddHandler("quit", func(args *widget.ButtonClickedEventArgs) {
+		clicked = true
+	})
+
+	w, err := New(r).LoadJSON(strings.NewReader(`{
+		"type": "Container",
+		"id": "root",
+		"layout": {"type": "RowLayout", "direction": "vertical", "spacing": 10},
+		"children": [
+			{"type": "Label", "text": "Hello", "font": "default", "color": "label", "tags": ["title"]},
+			{
+				"type": "Button",
+				"id": "quit",
+				"text": "Quit",
+				"image": "button",
+				"font": "default",
+				"color": "button",
+				"layoutData": {"position": "center", "stretch": true},
+				"on": {"clicked": "quit"}
+			}
+		]
+	}`))
+	is.NoErr(err)
+
+	c, ok := w.(*widget.Container)
+	is.True(ok)
+	is.Equal(c.GetWidget().ID, "root")
+	is.Equal(len(c.Children()), 2)
+
+	is.Equal(len(c.FindByTag("title")), 1)
+
+	b, ok := c.FindByID("quit").(*widget.Button)
+	is.True(ok)
+	is.Equal(b.GetWidget().LayoutData, widget.RowLayoutData{
+		Position: widget.RowLayoutPositionCenter,
+		Stretch:  true,
+	})
+
+	event.ExecuteDeferred()
+	b.ClickedEvent.Fire(&widget.ButtonClickedEventArgs{
+		Button: b,
+	})
+	event.ExecuteDeferred()
+	is.True(clicked)
+}
+
+func TestLoader_LoadYAML(t *testing.T) {
+	is := is.New(t)
+
+	w, err := New(newResources(t)).LoadYAML(strings.NewReader(`
+type: Container
+layout:
+  type: GridLayout
+  columns: 2
+children:
+  - type: Text
+    text: Name
+    font: default
+    color: text
+    layoutData:
+      horizontalPosition: end
+  - type: Slider
+    id: volume
+    image: track
+    handleImage: button
+    min: 0
+    max: 10
+`))
+	is.NoErr(err)
+
+	c := w.(*widget.Container)
+	is.Equal(c.Children()[0].GetWidget().LayoutData, widget.GridLayoutData{
+		HorizontalPosition: widget.GridLayoutPositionEnd,
+	})
+
+	s, ok := c.FindByID("volume").(*widget.Slider)
+	is.True(ok)
+	is.Equal(s.Max, 10)
+}
+
+func TestLoader_Load_Errors(t *testing.T) {
+	tests := map[string]struct {
+		doc string
+		err error
+		msg string
+	}{
+		"unknown field": {
+			doc: `{"type": "Container", "foo": 1}`,
+			err: ErrInvalidDocument,
+			msg: "foo",
+		},
+		"unknown type": {
+			doc: `{"type": "Container", "layout": {"type": "RowLayout"}, "children": [{"type": "Foo"}]}`,
+			err: ErrInvalidDocument,
+			msg: `children[0] (Foo): unknown type "Foo"`,
+		},
+		"unknown layout": {
+			doc: `{"type": "Container", "layout": {"type": "FooLayout"}}`,
+			err: ErrInvalidDocument,
+			msg: `unknown layout type "FooLayout"`,
+		},
+		"unknown resource": {
+			doc: `{"type": "Graphic", "image": "foo"}`,
+			err: ErrUnknownResource,
+			msg: `image "foo"`,
+		},
+		"wrong resource type": {
+			doc: `{"type": "Container", "image": "button"}`,
+			err: ErrResourceType,
+			msg: `image "button"`,
+		},
+		"unsupported event": {
+			doc: `{"type": "Container", "on": {"clicked": "quit"}}`,
+			err: ErrInvalidDocument,
+			msg: "unsupported events for type Container: clicked",
+		},
+		"layout data without layout": {
+			doc: `{"type": "Container", "layoutData": {"stretch": true}}`,
+			err: ErrInvalidDocument,
+			msg: "layoutData requires a parent container with a layout",
+		},
+		"children without layout": {
+			doc: `{"type": "Container", "children": [{"type": "Container"}]}`,
+			err: ErrInvalidDocument,
+			msg: "container with children requires a layout",
+		},
+		"window not root": {
+			doc: `{"type": "Container", "layout": {"type": "RowLayout"}, "children": [{"type": "Window", "children": [{"type": "Container"}]}]}`,
+			err: ErrInvalidDocument,
+			msg: "Window must be the root of the document",
+		},
+		"split pane children": {
+			doc: `{"type": "SplitPane", "children": [{"type": "Container"}]}`,
+			err: ErrInvalidDocument,
+			msg: "exactly two children required, got 1",
+		},
+		"tab label missing": {
+			doc: `{"type": "TabBook", "children": [{"type": "Container"}]}`,
+			err: ErrInvalidDocument,
+			msg: "children[0]: tab label required",
+		},
+		"unknown position": {
+			doc: `{"type": "Container", "layout": {"type": "RowLayout"}, "children": [{"type": "Container", "layoutData": {"position": "middle"}}]}`,
+			err: ErrInvalidDocument,
+			msg: `unknown position "middle"`,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			is := is.New(t)
+
+			_, err := New(newResources(t)).LoadJSON(strings.NewReader(test.doc))
+			is.True(errors.Is(err, test.err))
+			is.True(strings.Contains(err.Error(), test.msg))
+		})
+	}
+}
+
+func TestLoader_RegisterType(t *testing.T) {
+	is := is.New(t)
+
+	l := New(newResources(t))
+	l.RegisterType("Panel", func(c *Context) (widget.PreferredSizeLocateableWidget, error) {
+		children, err := c.Children()
+		if err != nil {
+			return nil, err
+		}
+
+		p := widget.NewContainer(
+			widget.ContainerOpts.WidgetOpts(c.WidgetOpts...),
+			widget.ContainerOpts.Layout(widget.NewAnchorLayout()))
+		for _, ch := range children {
+			p.AddChild(ch)
+		}
+		return p, nil
+	})
+
+	w, err := l.LoadJSON(strings.NewReader(`{
+		"type": "Panel",
+		"id": "panel",
+		"layout": {"type": "AnchorLayout"},
+		"children": [{"type": "Container", "layoutData": {"horizontalPosition": "center", "stretchVertical": true}}]
+	}`))
+	is.NoErr(err)
+
+	c := w.(*widget.Container)
+	is.Equal(c.GetWidget().ID, "panel")
+	is.Equal(c.Children()[0].GetWidget().LayoutData, widget.AnchorLayoutData{
+		HorizontalPosition: widget.AnchorLayoutPositionCenter,
+		StretchVertical:    true,
+	})
+}
+
+func TestLoader_AnchorLayoutData(t *testing.T) {
+	is := is.New(t)
+
+	l := New(newResources(t))
+	l.RegisterType("Frame", func(c *Context) (widget.PreferredSizeLocateableWidget, error) {
+		child, err := c.Child()
+		if err != nil {
+			return nil, err
+		}
+
+		f := widget.NewContainer(
+			widget.ContainerOpts.WidgetOpts(c.WidgetOpts...),
+			widget.ContainerOpts.Layout(widget.NewAnchorLayout()))
+		f.AddChild(child)
+		return f, nil
+	})
+
+	w, err := l.LoadYAML(strings.NewReader(`
+type: Frame
+layout:
+  type: AnchorLayout
+children:
+  - type: Container
+    layoutData:
+      horizontalPosition: percent
+      verticalPosition: end
+      horizontalPercent: 25
+      widthPercent: 50
+      pivotX: 50
+      padding:
+        bottom: 10
+`))
+	is.NoErr(err)
+
+	is.Equal(w.(*widget.Container).Children()[0].GetWidget().LayoutData, widget.AnchorLayoutData{
+		HorizontalPosition: widget.AnchorLayoutPositionPercent,
+		VerticalPosition:   widget.AnchorLayoutPositionEnd,
+		HorizontalPercent:  25,
+		WidthPercent:       50,
+		PivotX:             50,
+		Padding: widget.Insets{
+			Bottom: 10,
+		},
+	})
+}
+
+func TestLoader_CompositeTypes(t *testing.T) {
+	is := is.New(t)
+
+	var selected string
+
+	r := newResources(t)
+	r.AddHandler("select", func(args *widget.ListComboButtonEntrySelectedEventArgs) {
+		selected = args.Entry.(string)
+	})
+
+	w, err := New(r).LoadYAML(strings.NewReader(`
+type: SplitPane
+id: split
+direction: vertical
+image: button
+children:
+  - type: TabBook
+    id: tabs
+    image: button
+    selectedImage: button
+    font: default
+    color: button
+    children:
+      - type: List
+        id: list
+        tab: List
+        entries: [one, two]
+        layoutData:
+          stretchHorizontal: true
+      - type: FlipBook
+        id: flip
+        tab: Flip
+        children:
+          - type: Label
+            id: page
+            text: Page
+            font: default
+            color: label
+  - type: Container
+    layout:
+      type: RowLayout
+    children:
+      - type: ComboButton
+        id: combo
+        text: Open
+        image: button
+        font: default
+        color: button
+        children:
+          - type: Container
+            id: comboContent
+      - type: ListComboButton
+        id: listCombo
+        image: button
+        font: default
+        color: button
+        entries: [a, b]
+        on:
+          entrySelected: select
+`))
+	is.NoErr(err)
+
+	s, ok := w.(*widget.SplitPane)
+	is.True(ok)
+	is.Equal(s.GetWidget().ID, "split")
+
+	tb, ok := s.First().(*widget.TabBook)
+	is.True(ok)
+	is.Equal(len(tb.Tabs()), 2)
+	is.Equal(tb.Tabs()[0].Label(), "List")
+	is.Equal(tb.Tabs()[1].Label(), "Flip")
+
+	l, ok := widget.FindWidgetByID("list", s).(*widget.List)
+	is.True(ok)
+	is.Equal(l.GetWidget().LayoutData, widget.AnchorLayoutData{
+		StretchHorizontal: true,
+	})
+
+	_, ok = widget.FindWidgetByID("flip", s).(*widget.FlipBook)
+	is.True(ok)
+	_, ok = widget.FindWidgetByID("page", s).(*widget.Label)
+	is.True(ok)
+
+	cb, ok := widget.FindWidgetByID("combo", s).(*widget.ComboButton)
+	is.True(ok)
+	is.Equal(cb.Label(), "Open")
+	is.True(widget.FindWidgetByID("comboContent", cb) != nil)
+
+	lcb, ok := widget.FindWidgetByID("listCombo", s).(*widget.ListComboButton)
+	is.True(ok)
+	is.Equal(lcb.SelectedEntry(), "a")
+
+	event.ExecuteDeferred()
+	lcb.SetSelectedEntry("b")
+	event.ExecuteDeferred()
+	is.Equal(selected, "b")
+}
+
+func TestLoader_Window(t *testing.T) {
+	is := is.New(t)
+
+	w, err := New(newResources(t)).LoadJSON(strings.NewReader(`{
+		"type": "Window",
+		"id": "dialog",
+		"modal": true,
+		"children": [{
+			"type": "Container",
+			"layout": {"type": "RowLayout"},
+			"children": [{"type": "Label", "id": "message", "text": "Hello", "font": "default", "color": "label"}]
+		}]
+	}`))
+	is.NoErr(err)
+
+	win, ok := w.(*widget.Window)
+	is.True(ok)
+	is.True(win.Modal)
+	is.Equal(win.Contents().GetWidget().ID, "dialog")
+	is.True(win.Contents().FindByID("message") != nil)
+}
+
+func newResources(t *testing.T) *Resources {
+	t.Helper()
+
+	i := ebiten.NewImage(1, 1)
+	ns := image.NewNineSliceSimple(i, 0, 0)
+
+	r := NewResources()
+	r.AddFont("default", basicfont.Face7x13)
+	r.AddImage("button", &widget.ButtonImage{
+		Idle:    ns,
+		Pressed: ns,
+	})
+	r.AddImage("track", &widget.SliderTrackImage{
+		Idle:  ns,
+		Hover: ns,
+	})
+	r.AddImage("graphic", i)
+	r.AddImage("input", &widget.TextInputImage{
+		Idle: ns,
+	})
+	r.AddImage("scroll", &widget.ScrollContainerImage{
+		Idle: ns,
+		Mask: ns,
+	})
+	r.AddColor("label", &widget.LabelColor{
+		Idle: color.White,
+	})
+	r.AddColor("button", &widget.ButtonTextColor{
+		Idle: color.White,
+	})
+	r.AddColor("text", color.White)
+	r.AddColor("input", &widget.TextInputColor{
+		Idle:  color.White,
+		Caret: color.White,
+	})
+	return r
+}
@@ -0,0 +1,140 @@
+package loader
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Node is a node of a document that describes a widget.
+type Node struct {
+	// Type is the widget type, such as "Container" or "Button".
+	Type string `json:"type" yaml:"type"`
+
+	ID       string   `json:"id" yaml:"id"`
+	Tags     []string `json:"tags" yaml:"tags"`
+	Disabled bool     `json:"disabled" yaml:"disabled"`
+
+	// Layout is the layout of a container.
+	Layout *LayoutNode `json:"layout" yaml:"layout"`
+
+	// LayoutData is the layout data of the widget, according to the layout of its parent container.
+	LayoutData *LayoutDataNode `json:"layoutData" yaml:"layoutData"`
+
+	Children []*Node `json:"children" yaml:"children"`
+
+	Text        string  `json:"text" yaml:"text"`
+	Placeholder string  `json:"placeholder" yaml:"placeholder"`
+	Padding     *Insets `json:"padding" yaml:"padding"`
+	Spacing     int     `json:"spacing" yaml:"spacing"`
+	Direction   string  `json:"direction" yaml:"direction"`
+	Min         int     `json:"min" yaml:"min"`
+	Max         int     `json:"max" yaml:"max"`
+	TriState    bool    `json:"triState" yaml:"triState"`
+	Secure      bool    `json:"secure" yaml:"secure"`
+	Modal       bool    `json:"modal" yaml:"modal"`
+
+	// Entries are the entries of a List or ListComboButton.
+	Entries []string `json:"entries" yaml:"entries"`
+
+	// Tab is the label of the widget's tab, if its parent is a TabBook.
+	Tab string `json:"tab" yaml:"tab"`
+
+	// Image, HandleImage, SelectedImage, and Graphic are names of images in Resources.
+	Image         string `json:"image" yaml:"image"`
+	HandleImage   string `json:"handleImage" yaml:"handleImage"`
+	SelectedImage string `json:"selectedImage" yaml:"selectedImage"`
+	Graphic       string `json:"graphic" yaml:"graphic"`
+
+	// Font is the name of a font in Resources.
+	Font string `json:"font" yaml:"font"`
+
+	// Color is the name of a color in Resources.
+	Color string `json:"color" yaml:"color"`
+
+	// On maps event names, such as "clicked", to names of handlers in Resources.
+	On map[string]string `json:"on" yaml:"on"`
+}
+
+// LayoutNode describes the layout of a container.
+type LayoutNode struct {
+	// Type is the layout type: "RowLayout", "GridLayout", or "AnchorLayout".
+	Type string `json:"type" yaml:"type"`
+
+	Direction string  `json:"direction" yaml:"direction"`
+	Padding   *Insets `json:"padding" yaml:"padding"`
+	Spacing   int     `json:"spacing" yaml:"spacing"`
+
+	// Columns, ColumnSpacing, RowSpacing, StretchColumns, and StretchRows are only used by GridLayout.
+	Columns        int    `json:"columns" yaml:"columns"`
+	ColumnSpacing  int    `json:"columnSpacing" yaml:"columnSpacing"`
+	RowSpacing     int    `json:"rowSpacing" yaml:"rowSpacing"`
+	StretchColumns []bool `json:"stretchColumns" yaml:"stretchColumns"`
+	StretchRows    []bool `json:"stretchRows" yaml:"stretchRows"`
+}
+
+// LayoutDataNode describes the layout data of a widget. Which fields are used depends on the layout of
+// the widget's parent container.
+type LayoutDataNode struct {
+	// Position is used by RowLayout.
+	Position string `json:"position" yaml:"position"`
+
+	// HorizontalPosition and VerticalPosition are used by GridLayout and AnchorLayout.
+	HorizontalPosition string `json:"horizontalPosition" yaml:"horizontalPosition"`
+	VerticalPosition   string `json:"verticalPosition" yaml:"verticalPosition"`
+
+	// Stretch is used by RowLayout.
+	Stretch bool `json:"stretch" yaml:"stretch"`
+
+	// StretchHorizontal and StretchVertical are used by AnchorLayout.
+	StretchHorizontal bool `json:"stretchHorizontal" yaml:"stretchHorizontal"`
+	StretchVertical   bool `json:"stretchVertical" yaml:"stretchVertical"`
+
+	// MaxWidth and MaxHeight are used by RowLayout and GridLayout.
+	MaxWidth  int `json:"maxWidth" yaml:"maxWidth"`
+	MaxHeight int `json:"maxHeight" yaml:"maxHeight"`
+
+	// Padding, the percentages, and the pivot point are used by AnchorLayout. HorizontalPercent and
+	// VerticalPercent require the respective position to be "percent".
+	Padding           *Insets `json:"padding" yaml:"padding"`
+	HorizontalPercent float64 `json:"horizontalPercent" yaml:"horizontalPercent"`
+	VerticalPercent   float64 `json:"verticalPercent" yaml:"verticalPercent"`
+	WidthPercent      float64 `json:"widthPercent" yaml:"widthPercent"`
+	HeightPercent     float64 `json:"heightPercent" yaml:"heightPercent"`
+	PivotX            float64 `json:"pivotX" yaml:"pivotX"`
+	PivotY            float64 `json:"pivotY" yaml:"pivotY"`
+}
+
+// Insets describes insets, such as padding.
+type Insets struct {
+	Top    int `json:"top" yaml:"top"`
+	Left   int `json:"left" yaml:"left"`
+	Right  int `json:"right" yaml:"right"`
+	Bottom int `json:"bottom" yaml:"bottom"`
+}
+
+// DecodeJSON decodes a JSON document from r.
+func DecodeJSON(r io.Reader) (*Node, error) {
+	d := json.NewDecoder(r)
+	d.DisallowUnknownFields()
+
+	n := Node{}
+	if err := d.Decode(&n); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDocument, err)
+	}
+	return &n, nil
+}
+
+// DecodeYAML decodes a YAML document from r.
+func DecodeYAML(r io.Reader) (*Node, error) {
+	d := yaml.NewDecoder(r)
+	d.KnownFields(true)
+
+	n := Node{}
+	if err := d.Decode(&n); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDocument, err)
+	}
+	return &n, nil
+}
@@ -0,0 +1,508 @@
+package loader
+
+import (
+	"fmt"
+	"image/color"
+	"io"
+	"io/fs"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/blizzy78/ebitenui/event"
+	"github.com/blizzy78/ebitenui/image"
+	"github.com/blizzy78/ebitenui/widget"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+)
+
+// Reloader loads a document from a file and rebuilds its widget tree whenever the file changes. It is
+// intended to be used during development, to iterate on a layout without restarting the game.
+//
+// The widget tree is placed into the container returned by Container. On reload, only the subtrees whose
+// nodes have changed are rebuilt, as long as their nodes have IDs that match between the old and new
+// document, and their parents are Containers. Otherwise, the whole tree is rebuilt. The state of rebuilt
+// widgets whose IDs match between the old and new tree is preserved, such as the current tab of a TabBook,
+// the scroll position of a ScrollContainer, or the text of a TextInput.
+//
+// Optionally, a theme document is loaded and reloaded as well, and its theme is set on the container.
+// If a document cannot be loaded, the old tree or theme is kept, and the error is shown in an overlay on
+// top of it.
+type Reloader struct {
+	// ReloadedEvent fires after a document has been loaded. Widgets of rebuilt subtrees are no longer used
+	// afterwards, so any references to them should be updated.
+	ReloadedEvent *event.Of[*ReloaderReloadedEventArgs]
+
+	loader            *Loader
+	fsys              fs.FS
+	doc               *watchedFile
+	themeDoc          *watchedFile
+	interval          time.Duration
+	overlayFace       font.Face
+	overlayColor      color.Color
+	overlayBackground *image.NineSlice
+
+	container     *widget.Container
+	node          *Node
+	root          widget.PreferredSizeLocateableWidget
+	theme         *widget.Theme
+	removeOverlay widget.RemoveChildFunc
+	docErr        error
+	themeErr      error
+	lastCheck     time.Time
+}
+
+// ReloaderOpt is a function that configures r.
+type ReloaderOpt func(r *Reloader)
+
+// ReloaderReloadedEventArgs are the arguments of ReloadedEvent.
+type ReloaderReloadedEventArgs struct {
+	Reloader *Reloader
+
+	// Root is the root of the widget tree. If Err is set, Root is the root of the old tree, or nil.
+	Root widget.PreferredSizeLocateableWidget
+
+	// Theme is the theme loaded from the theme document, or nil.
+	Theme *widget.Theme
+
+	// Err is the error that occurred while loading the documents, if any.
+	Err error
+}
+
+// ReloaderReloadedHandlerFunc is a function that handles ReloadedEvent.
+type ReloaderReloadedHandlerFunc func(args *ReloaderReloadedEventArgs)
+
+type ReloaderOptions struct {
+}
+
+// ReloaderOpts contains functions that configure a Reloader.
+var ReloaderOpts ReloaderOptions
+
+// watchedFile is a file whose changes are detected using its modification time and size.
+type watchedFile struct {
+	name    string
+	exists  bool
+	modTime time.Time
+	size    int64
+}
+
+// NewReloader constructs a new Reloader that loads the document name from fsys, using l. Documents whose
+// names end in ".yaml" or ".yml" are decoded as YAML, all others as JSON. The documents are loaded immediately.
+func NewReloader(l *Loader, fsys fs.FS, name string, opts ...ReloaderOpt) *Reloader {
+	r := &Reloader{
+		ReloadedEvent: &event.Of[*ReloaderReloadedEventArgs]{},
+
+		loader:            l,
+		fsys:              fsys,
+		doc:               &watchedFile{name: name},
+		interval:          500 * time.Millisecond,
+		overlayFace:       basicfont.Face7x13,
+		overlayColor:      color.White,
+		overlayBackground: image.NewNineSliceColor(color.NRGBA{0xa0, 0x00, 0x00, 0xe0}),
+	}
+
+	for _, o := range opts {
+		o(r)
+	}
+
+	r.container = widget.NewContainer(
+		widget.ContainerOpts.Layout(widget.NewAnchorLayout()),
+	)
+
+	r.Reload()
+
+	return r
+}
+
+// Interval configures a Reloader to check for changes of the documents at most once per interval i.
+// The default is 500ms.
+func (o ReloaderOptions) Interval(i time.Duration) ReloaderOpt {
+	return func(r *Reloader) {
+		r.interval = i
+	}
+}
+
+// Theme configures a Reloader to also load the theme document name from its file system, and to set its
+// theme on the container. Documents are decoded as YAML or JSON depending on their names.
+func (o ReloaderOptions) Theme(name string) ReloaderOpt {
+	return func(r *Reloader) {
+		r.themeDoc = &watchedFile{name: name}
+	}
+}
+
+// ErrorOverlay configures a Reloader to show errors using font face f and color c, on top of background.
+func (o ReloaderOptions) ErrorOverlay(f font.Face, c color.Color, background *image.NineSlice) ReloaderOpt {
+	return func(r *Reloader) {
+		r.overlayFace = f
+		r.overlayColor = c
+		r.overlayBackground = background
+	}
+}
+
+func (o ReloaderOptions) ReloadedHandler(f ReloaderReloadedHandlerFunc) ReloaderOpt {
+	return func(r *Reloader) {
+		r.ReloadedEvent.AddHandler(f)
+	}
+}
+
+// Container returns the container that holds the widget tree, as well as the error overlay.
+func (r *Reloader) Container() *widget.Container {
+	return r.container
+}
+
+// Root returns the root of the current widget tree, or nil if the document has never been loaded successfully.
+func (r *Reloader) Root() widget.PreferredSizeLocateableWidget {
+	return r.root
+}
+
+// Theme returns the theme loaded from the theme document, or nil if there is none, or if it has never been
+// loaded successfully.
+func (r *Reloader) Theme() *widget.Theme {
+	return r.theme
+}
+
+// Err returns the error that occurred when the documents were last loaded, or nil. Errors of the document
+// take precedence over errors of the theme document.
+func (r *Reloader) Err() error {
+	if r.docErr != nil {
+		return r.docErr
+	}
+	return r.themeErr
+}
+
+// Update checks whether the documents have changed, and reloads them if so. It should be called once per
+// frame, for example from the game's Update function.
+func (r *Reloader) Update() {
+	now := time.Now()
+	if now.Sub(r.lastCheck) < r.interval {
+		return
+	}
+	r.lastCheck = now
+
+	themeChanged := r.themeDoc != nil && r.themeDoc.changed(r.fsys)
+	docChanged := r.doc.changed(r.fsys)
+
+	if !themeChanged && !docChanged {
+		return
+	}
+
+	if themeChanged {
+		r.reloadTheme()
+	}
+	if docChanged {
+		r.reloadDocument()
+	}
+
+	r.reloaded()
+}
+
+// Reload loads the documents and updates the widget tree and theme, regardless of whether the documents
+// have changed.
+func (r *Reloader) Reload() {
+	if r.themeDoc != nil {
+		r.themeDoc.changed(r.fsys)
+		r.reloadTheme()
+	}
+
+	r.doc.changed(r.fsys)
+	r.reloadDocument()
+
+	r.reloaded()
+}
+
+func (r *Reloader) reloaded() {
+	r.updateOverlay()
+
+	r.ReloadedEvent.Fire(&ReloaderReloadedEventArgs{
+		Reloader: r,
+		Root:     r.root,
+		Theme:    r.theme,
+		Err:      r.Err(),
+	})
+}
+
+func (r *Reloader) reloadTheme() {
+	n, err := readFile(r.fsys, r.themeDoc.name, DecodeThemeJSON, DecodeThemeYAML)
+
+	var t *widget.Theme
+	if err == nil {
+		t, err = r.loader.LoadTheme(n)
+	}
+
+	if err != nil {
+		r.themeErr = fmt.Errorf("%s: %w", r.themeDoc.name, err)
+		return
+	}
+
+	r.themeErr = nil
+	r.theme = t
+	r.container.SetTheme(t)
+}
+
+func (r *Reloader) reloadDocument() {
+	n, err := readFile(r.fsys, r.doc.name, DecodeJSON, DecodeYAML)
+	if err == nil {
+		err = r.apply(n)
+	}
+
+	if err != nil {
+		r.docErr = fmt.Errorf("%s: %w", r.doc.name, err)
+		return
+	}
+
+	r.docErr = nil
+}
+
+// apply updates r's widget tree to match the document n. If possible, only the subtrees whose nodes have
+// changed are rebuilt, otherwise the whole tree is rebuilt.
+func (r *Reloader) apply(n *Node) error {
+	if r.root != nil {
+		if subtrees, ok := changedSubtrees(r.node, n, n.Type); ok {
+			if rs, ok := r.replacements(n, subtrees); ok {
+				if err := r.replace(rs); err != nil {
+					return err
+				}
+
+				r.node = n
+				return nil
+			}
+		}
+	}
+
+	root, err := r.loader.Load(n)
+	if err != nil {
+		return err
+	}
+
+	root.GetWidget().LayoutData = widget.AnchorLayoutData{
+		StretchHorizontal: true,
+		StretchVertical:   true,
+	}
+
+	if r.root != nil {
+		restoreState(root, captureState(r.root))
+		r.container.ReplaceChild(r.root, root)
+	} else {
+		r.container.AddChild(root)
+	}
+
+	r.root = root
+	r.node = n
+
+	return nil
+}
+
+// subtree is a node of a document that needs to be rebuilt.
+type subtree struct {
+	node   *Node
+	parent *Node
+	path   string
+}
+
+// replacement is a widget that needs to be replaced in its parent container by rebuilding its subtree.
+type replacement struct {
+	subtree
+
+	container *widget.Container
+	old       widget.PreferredSizeLocateableWidget
+}
+
+// changedSubtrees returns the subtrees of document new that need to be rebuilt to turn document old into
+// new, starting at path. It returns false if new's root node itself has changed, or if a changed node
+// cannot be matched to its old node by ID.
+func changedSubtrees(old *Node, new *Node, path string) ([]subtree, bool) {
+	if reflect.DeepEqual(old, new) {
+		return nil, true
+	}
+
+	if old == nil || new == nil || len(old.Children) != len(new.Children) {
+		return nil, false
+	}
+
+	o, n := *old, *new
+	o.Children, n.Children = nil, nil
+	if !reflect.DeepEqual(o, n) {
+		return nil, false
+	}
+
+	subtrees := []subtree{}
+	for i, ch := range new.Children {
+		t := ""
+		if ch != nil {
+			t = ch.Type
+		}
+		p := fmt.Sprintf("%s.children[%d] (%s)", path, i, t)
+
+		s, ok := changedSubtrees(old.Children[i], ch, p)
+		if ok {
+			subtrees = append(subtrees, s...)
+			continue
+		}
+
+		if ch == nil || ch.ID == "" || old.Children[i] == nil || old.Children[i].ID != ch.ID {
+			return nil, false
+		}
+
+		subtrees = append(subtrees, subtree{
+			node:   ch,
+			parent: new,
+			path:   p,
+		})
+	}
+
+	return subtrees, true
+}
+
+// replacements finds the widgets of r's tree that need to be replaced to rebuild subtrees of document n.
+// It returns false if a widget cannot be found, or if its ID is not unique, or if its parent is not a Container.
+func (r *Reloader) replacements(n *Node, subtrees []subtree) ([]replacement, bool) {
+	rs := make([]replacement, len(subtrees))
+	for i, s := range subtrees {
+		if countID(n, s.node.ID) != 1 || len(widget.FindWidgets(widget.WidgetByID(s.node.ID), r.root)) != 1 {
+			return nil, false
+		}
+
+		old, ok := widget.FindWidgetByID(s.node.ID, r.root).(widget.PreferredSizeLocateableWidget)
+		if !ok {
+			return nil, false
+		}
+
+		c, ok := widget.FindParent(old, r.root).(*widget.Container)
+		if !ok {
+			return nil, false
+		}
+
+		rs[i] = replacement{
+			subtree:   s,
+			container: c,
+			old:       old,
+		}
+	}
+
+	return rs, true
+}
+
+// replace rebuilds the subtrees of rs and replaces their old widgets. If a subtree cannot be built, no
+// widgets are replaced.
+func (r *Reloader) replace(rs []replacement) error {
+	ws := make([]widget.PreferredSizeLocateableWidget, len(rs))
+	for i, rp := range rs {
+		layout := ""
+		if rp.parent.Layout != nil {
+			layout = rp.parent.Layout.Type
+		}
+
+		w, err := r.loader.build(rp.node, rp.path, layout, false)
+		if err != nil {
+			return err
+		}
+
+		ws[i] = w
+	}
+
+	for i, rp := range rs {
+		restoreState(ws[i], captureState(rp.old))
+		rp.container.ReplaceChild(rp.old, ws[i])
+	}
+
+	return nil
+}
+
+// countID returns the number of nodes in the tree rooted at n that have ID id.
+func countID(n *Node, id string) int {
+	if n == nil {
+		return 0
+	}
+
+	c := 0
+	if n.ID == id {
+		c++
+	}
+	for _, ch := range n.Children {
+		c += countID(ch, id)
+	}
+	return c
+}
+
+// changed reports whether f has changed in fsys since this was last called, including whether it has been
+// created or removed.
+func (f *watchedFile) changed(fsys fs.FS) bool {
+	fi, err := fs.Stat(fsys, f.name)
+	if err != nil {
+		changed := f.exists
+		f.exists, f.modTime, f.size = false, time.Time{}, 0
+		return changed
+	}
+
+	changed := !f.exists || !fi.ModTime().Equal(f.modTime) || fi.Size() != f.size
+	f.exists, f.modTime, f.size = true, fi.ModTime(), fi.Size()
+	return changed
+}
+
+// readFile decodes the document name from fsys using decodeYAML if its name ends in ".yaml" or ".yml", or
+// using decodeJSON otherwise.
+func readFile[T any](fsys fs.FS, name string, decodeJSON func(io.Reader) (T, error), decodeYAML func(io.Reader) (T, error)) (T, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		var t T
+		return t, err
+	}
+	defer f.Close() //nolint:errcheck
+
+	switch strings.ToLower(path.Ext(name)) {
+	case ".yaml", ".yml":
+		return decodeYAML(f)
+	default:
+		return decodeJSON(f)
+	}
+}
+
+// updateOverlay shows the errors of the documents in an overlay on top of r's container, or removes the
+// overlay if there are none.
+func (r *Reloader) updateOverlay() {
+	if r.removeOverlay != nil {
+		r.removeOverlay()
+		r.removeOverlay = nil
+	}
+
+	errs := []error{}
+	for _, err := range []error{r.docErr, r.themeErr} {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) == 0 {
+		return
+	}
+
+	r.removeOverlay = r.container.AddChild(r.newOverlay(errs))
+}
+
+func (r *Reloader) newOverlay(errs []error) *widget.Container {
+	o := widget.NewContainer(
+		widget.ContainerOpts.WidgetOpts(widget.WidgetOpts.LayoutData(widget.AnchorLayoutData{
+			HorizontalPosition: widget.AnchorLayoutPositionStart,
+			VerticalPosition:   widget.AnchorLayoutPositionStart,
+			StretchHorizontal:  true,
+		})),
+		widget.ContainerOpts.BackgroundImage(r.overlayBackground),
+		widget.ContainerOpts.Layout(widget.NewRowLayout(
+			widget.RowLayoutOpts.Direction(widget.DirectionVertical),
+			widget.RowLayoutOpts.Padding(widget.Insets{
+				Top:    5,
+				Left:   5,
+				Right:  5,
+				Bottom: 5,
+			}))),
+	)
+
+	for _, err := range errs {
+		o.AddChild(widget.NewText(
+			widget.TextOpts.Text(err.Error(), r.overlayFace, r.overlayColor),
+		))
+	}
+
+	return o
+}
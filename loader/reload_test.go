@@ -0,0 +1,242 @@
+package loader
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/blizzy78/ebitenui/event"
+	"github.com/blizzy78/ebitenui/widget"
+
+	"github.com/matryer/is"
+)
+
+func TestReloader_Update(t *testing.T) {
+	is := is.New(t)
+
+	fsys := fstest.MapFS{}
+	setFile(fsys, "ui.yaml", `
+type: Container
+layout:
+  type: RowLayout
+children:
+  - type: Label
+    id: title
+    text: Volume
+    font: default
+    color: label
+  - type: Slider
+    id: volume
+    image: track
+    handleImage: button
+    min: 0
+    max: 10
+`, time.Unix(1, 0))
+
+	var reloaded *ReloaderReloadedEventArgs
+
+	r := NewReloader(New(newResources(t)), fsys, "ui.yaml",
+		ReloaderOpts.Interval(0),
+		ReloaderOpts.ReloadedHandler(func(args *ReloaderReloadedEventArgs) {
+			reloaded = args
+		}))
+	is.NoErr(r.Err())
+	is.Equal(len(r.Container().Children()), 1)
+
+	event.ExecuteDeferred()
+	is.True(reloaded != nil)
+
+	old := r.Root()
+	title := old.(*widget.Container).FindByID("title")
+	oldSlider := old.(*widget.Container).FindByID("volume").(*widget.Slider)
+	oldSlider.Current = 7
+
+	reloaded = nil
+	r.Update()
+	event.ExecuteDeferred()
+	is.True(reloaded == nil)
+	is.Equal(r.Root(), old)
+
+	setFile(fsys, "ui.yaml", `
+type: Container
+layout:
+  type: RowLayout
+children:
+  - type: Label
+    id: title
+    text: Volume
+    font: default
+    color: label
+  - type: Slider
+    id: volume
+    image: track
+    handleImage: button
+    min: 0
+    max: 20
+`, time.Unix(2, 0))
+
+	r.Update()
+	event.ExecuteDeferred()
+	is.True(reloaded != nil)
+	is.NoErr(reloaded.Err)
+	is.Equal(r.Root(), old)
+	is.Equal(reloaded.Root, r.Root())
+	is.Equal(old.(*widget.Container).FindByID("title"), title)
+
+	s := old.(*widget.Container).FindByID("volume").(*widget.Slider)
+	is.True(s != oldSlider)
+	is.Equal(old.(*widget.Container).Children()[1], s)
+	is.Equal(s.Max, 20)
+	is.Equal(s.Current, 7)
+}
+
+func TestReloader_Update_Root(t *testing.T) {
+	is := is.New(t)
+
+	doc := `
+type: Container
+layout:
+  type: RowLayout
+  direction: %s
+children:
+  - type: TabBook
+    id: tabs
+    children:
+      - type: Container
+        tab: One
+      - type: Container
+        tab: Two
+`
+
+	fsys := fstest.MapFS{}
+	setFile(fsys, "ui.yaml", fmt.Sprintf(doc, "horizontal"), time.Unix(1, 0))
+
+	r := NewReloader(New(newResources(t)), fsys, "ui.yaml", ReloaderOpts.Interval(0))
+	is.NoErr(r.Err())
+	old := r.Root()
+
+	tb := widget.FindWidgetByID("tabs", old).(*widget.TabBook)
+	tb.SetTab(tb.Tabs()[1])
+
+	setFile(fsys, "ui.yaml", fmt.Sprintf(doc, "vertical"), time.Unix(2, 0))
+	r.Update()
+	is.NoErr(r.Err())
+	is.True(r.Root() != old)
+	is.Equal(r.Container().Children(), []widget.HasWidget{r.Root()})
+
+	tb = widget.FindWidgetByID("tabs", r.Root()).(*widget.TabBook)
+	is.Equal(tb.Tab().Label(), "Two")
+}
+
+func TestReloader_Theme(t *testing.T) {
+	is := is.New(t)
+
+	fsys := fstest.MapFS{}
+	setFile(fsys, "ui.json", `{"type": "Container"}`, time.Unix(1, 0))
+	setFile(fsys, "theme.yaml", `
+button:
+  image: button
+`, time.Unix(1, 0))
+
+	res := newResources(t)
+	res.AddImage("otherButton", &widget.ButtonImage{})
+
+	r := NewReloader(New(res), fsys, "ui.json",
+		ReloaderOpts.Interval(0),
+		ReloaderOpts.Theme("theme.yaml"))
+	is.NoErr(r.Err())
+	is.True(r.Theme() != nil)
+	is.True(r.Container().GetWidget().Theme() == r.Theme())
+	old := r.Root()
+
+	setFile(fsys, "theme.yaml", `
+button:
+  image: otherButton
+`, time.Unix(2, 0))
+	r.Update()
+	is.NoErr(r.Err())
+	is.True(r.Root() == old)
+	img, _ := res.images["otherButton"].(*widget.ButtonImage)
+	is.Equal(r.Theme().Button.Image, img)
+	is.True(r.Container().GetWidget().Theme() == r.Theme())
+
+	setFile(fsys, "theme.yaml", `
+foo:
+  image: button
+`, time.Unix(3, 0))
+	r.Update()
+	is.True(errors.Is(r.Err(), ErrInvalidDocument))
+	is.True(strings.Contains(r.Err().Error(), "theme.yaml"))
+	is.Equal(r.Theme().Button.Image, img)
+	is.Equal(len(r.Container().Children()), 2)
+}
+
+func TestReloader_Update_Error(t *testing.T) {
+	is := is.New(t)
+
+	fsys := fstest.MapFS{}
+	setFile(fsys, "ui.json", `{"type": "Container"}`, time.Unix(1, 0))
+
+	r := NewReloader(New(newResources(t)), fsys, "ui.json", ReloaderOpts.Interval(0))
+	is.NoErr(r.Err())
+	old := r.Root()
+
+	setFile(fsys, "ui.json", `{"type": "Foo"}`, time.Unix(2, 0))
+	r.Update()
+	is.True(errors.Is(r.Err(), ErrInvalidDocument))
+	is.Equal(r.Root(), old)
+	is.Equal(len(r.Container().Children()), 2)
+
+	setFile(fsys, "ui.json", `{"type": "Container", "id": "fixed"}`, time.Unix(3, 0))
+	r.Update()
+	is.NoErr(r.Err())
+	is.Equal(r.Root().GetWidget().ID, "fixed")
+	is.Equal(len(r.Container().Children()), 1)
+
+	delete(fsys, "ui.json")
+	r.Update()
+	is.True(errors.Is(r.Err(), fs.ErrNotExist))
+	is.Equal(len(r.Container().Children()), 2)
+}
+
+func TestCaptureState(t *testing.T) {
+	is := is.New(t)
+
+	doc := `{
+		"type": "ScrollContainer",
+		"id": "scroll",
+		"image": "scroll",
+		"children": [
+			{"type": "TextInput", "id": "%s", "image": "input", "color": "input", "font": "default"}
+		]
+	}`
+
+	l := New(newResources(t))
+
+	oldScroll, err := l.LoadJSON(strings.NewReader(fmt.Sprintf(doc, "name")))
+	is.NoErr(err)
+	oldScroll.(*widget.ScrollContainer).ScrollTop = 0.5
+	widget.FindWidgetByID("name", oldScroll).(*widget.TextInput).InputText = "foo"
+
+	newScroll, err := l.LoadJSON(strings.NewReader(fmt.Sprintf(doc, "name")))
+	is.NoErr(err)
+	restoreState(newScroll, captureState(oldScroll))
+	is.Equal(newScroll.(*widget.ScrollContainer).ScrollTop, 0.5)
+	is.Equal(widget.FindWidgetByID("name", newScroll).(*widget.TextInput).InputText, "foo")
+
+	otherScroll, err := l.LoadJSON(strings.NewReader(fmt.Sprintf(doc, "other")))
+	is.NoErr(err)
+	restoreState(otherScroll, captureState(oldScroll))
+	is.Equal(widget.FindWidgetByID("other", otherScroll).(*widget.TextInput).InputText, "")
+}
+
+func setFile(fsys fstest.MapFS, name string, data string, modTime time.Time) {
+	fsys[name] = &fstest.MapFile{
+		Data:    []byte(data),
+		ModTime: modTime,
+	}
+}
@@ -0,0 +1,101 @@
+package loader
+
+import (
+	"fmt"
+	"reflect"
+
+	"golang.org/x/image/font"
+)
+
+// Resources is a registry of named images, fonts, colors, and event handlers that documents refer to.
+type Resources struct {
+	images   map[string]interface{}
+	fonts    map[string]font.Face
+	colors   map[string]interface{}
+	handlers map[string]interface{}
+}
+
+// NewResources constructs a new, empty Resources.
+func NewResources() *Resources {
+	return &Resources{
+		images:   map[string]interface{}{},
+		fonts:    map[string]font.Face{},
+		colors:   map[string]interface{}{},
+		handlers: map[string]interface{}{},
+	}
+}
+
+// AddImage registers image i with name. The type of i depends on the widget that uses it, for example,
+// Button requires *widget.ButtonImage, Container requires *image.NineSlice, and Graphic requires
+// *ebiten.Image or *image.NineSlice.
+func (r *Resources) AddImage(name string, i interface{}) {
+	r.images[name] = i
+}
+
+// AddFont registers font face f with name.
+func (r *Resources) AddFont(name string, f font.Face) {
+	r.fonts[name] = f
+}
+
+// AddColor registers color c with name. The type of c depends on the widget that uses it, for example,
+// Button requires *widget.ButtonTextColor, and Text requires color.Color.
+func (r *Resources) AddColor(name string, c interface{}) {
+	r.colors[name] = c
+}
+
+// AddHandler registers event handler h with name. h must be a function that can be converted to the
+// handler function type of the event, for example widget.ButtonClickedHandlerFunc for a button's
+// "clicked" event.
+func (r *Resources) AddHandler(name string, h interface{}) {
+	r.handlers[name] = h
+}
+
+// Font returns the font face registered with name.
+func (r *Resources) Font(name string) (font.Face, error) {
+	f, ok := r.fonts[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: font %q", ErrUnknownResource, name)
+	}
+	return f, nil
+}
+
+// Image returns the image registered with name, which must be of type T.
+func Image[T any](r *Resources, name string) (T, error) {
+	return resource[T](r.images, "image", name)
+}
+
+// Color returns the color registered with name, which must be of type T.
+func Color[T any](r *Resources, name string) (T, error) {
+	return resource[T](r.colors, "color", name)
+}
+
+// Handler returns the event handler registered with name, converted to type T.
+func Handler[T any](r *Resources, name string) (T, error) {
+	return resource[T](r.handlers, "handler", name)
+}
+
+// resource returns the value registered with name in m, which must be of type T, or convertible to T.
+func resource[T any](m map[string]interface{}, kind string, name string) (T, error) {
+	var t T
+
+	v, err := resourceValue(m, kind, name, reflect.TypeOf(&t).Elem())
+	if err != nil {
+		return t, err
+	}
+	return v.Interface().(T), nil
+}
+
+// resourceValue returns the value registered with name in m, converted to type t.
+func resourceValue(m map[string]interface{}, kind string, name string, t reflect.Type) (reflect.Value, error) {
+	v, ok := m[name]
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("%w: %s %q", ErrUnknownResource, kind, name)
+	}
+
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || !rv.Type().ConvertibleTo(t) {
+		return reflect.Value{}, fmt.Errorf("%w: %s %q is of type %T, expected %v", ErrResourceType, kind, name, v, t)
+	}
+
+	return rv.Convert(t), nil
+}
@@ -0,0 +1,34 @@
+package loader
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/blizzy78/ebitenui/widget"
+
+	"github.com/matryer/is"
+)
+
+func TestHandler(t *testing.T) {
+	is := is.New(t)
+
+	r := NewResources()
+	r.AddHandler("clicked", func(args *widget.ButtonClickedEventArgs) {})
+
+	h, err := Handler[widget.ButtonClickedHandlerFunc](r, "clicked")
+	is.NoErr(err)
+	is.True(h != nil)
+
+	_, err = Handler[widget.SliderChangedHandlerFunc](r, "clicked")
+	is.True(errors.Is(err, ErrResourceType))
+
+	_, err = Handler[widget.ButtonClickedHandlerFunc](r, "foo")
+	is.True(errors.Is(err, ErrUnknownResource))
+}
+
+func TestResources_Font(t *testing.T) {
+	is := is.New(t)
+
+	_, err := NewResources().Font("foo")
+	is.True(errors.Is(err, ErrUnknownResource))
+}
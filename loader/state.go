@@ -0,0 +1,114 @@
+package loader
+
+import "github.com/blizzy78/ebitenui/widget"
+
+// restoreStateFunc restores previously captured state into w.
+type restoreStateFunc func(w widget.HasWidget)
+
+// captureState captures the state of all widgets in the tree rooted at root that have an ID, such as the
+// current tab of a TabBook, or the text of a TextInput.
+func captureState(root widget.HasWidget) map[string]restoreStateFunc {
+	states := map[string]restoreStateFunc{}
+
+	widget.Walk(func(w widget.HasWidget, parents []widget.HasWidget) widget.WalkResult {
+		id := w.GetWidget().ID
+		if id == "" {
+			return widget.WalkContinue
+		}
+
+		if _, ok := states[id]; ok {
+			return widget.WalkContinue
+		}
+
+		if r := widgetState(w); r != nil {
+			states[id] = r
+		}
+
+		return widget.WalkContinue
+	}, root)
+
+	return states
+}
+
+// restoreState restores states into the widgets in the tree rooted at root whose IDs match.
+func restoreState(root widget.HasWidget, states map[string]restoreStateFunc) {
+	if len(states) == 0 {
+		return
+	}
+
+	widget.Walk(func(w widget.HasWidget, parents []widget.HasWidget) widget.WalkResult {
+		id := w.GetWidget().ID
+		if r, ok := states[id]; ok {
+			r(w)
+			delete(states, id)
+		}
+
+		return widget.WalkContinue
+	}, root)
+}
+
+func widgetState(w widget.HasWidget) restoreStateFunc {
+	switch w := w.(type) {
+	case *widget.TabBook:
+		label := w.Tab().Label()
+
+		return func(nw widget.HasWidget) {
+			if t, ok := nw.(*widget.TabBook); ok {
+				for _, tab := range t.Tabs() {
+					if tab.Label() == label {
+						t.SetTab(tab)
+						return
+					}
+				}
+			}
+		}
+
+	case *widget.ScrollContainer:
+		left, top := w.ScrollLeft, w.ScrollTop
+
+		return func(nw widget.HasWidget) {
+			if s, ok := nw.(*widget.ScrollContainer); ok {
+				s.ScrollLeft, s.ScrollTop = left, top
+			}
+		}
+
+	case *widget.TextInput:
+		text := w.InputText
+
+		return func(nw widget.HasWidget) {
+			if t, ok := nw.(*widget.TextInput); ok {
+				t.InputText = text
+			}
+		}
+
+	case *widget.Slider:
+		current := w.Current
+
+		return func(nw widget.HasWidget) {
+			if s, ok := nw.(*widget.Slider); ok {
+				s.Current = current
+			}
+		}
+
+	case *widget.Checkbox:
+		state := w.State()
+
+		return func(nw widget.HasWidget) {
+			if c, ok := nw.(*widget.Checkbox); ok {
+				c.SetState(state)
+			}
+		}
+
+	case *widget.LabeledCheckbox:
+		state := w.Checkbox().State()
+
+		return func(nw widget.HasWidget) {
+			if l, ok := nw.(*widget.LabeledCheckbox); ok {
+				l.Checkbox().SetState(state)
+			}
+		}
+
+	default:
+		return nil
+	}
+}
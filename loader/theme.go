@@ -0,0 +1,200 @@
+package loader
+
+import (
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/blizzy78/ebitenui/widget"
+	"golang.org/x/image/font"
+	"gopkg.in/yaml.v3"
+)
+
+// ThemeNode is a document that describes a widget.Theme. It maps the names of the theme's sections, such as
+// "button" or "textInput", to the sections' fields, such as "image" or "textFace". Images, font faces, and
+// colors are names of resources in Resources, paddings are insets, sections of composite widgets, such as the
+// "button" section of "comboButton", are nested objects, and all other fields are plain values. An example
+// document:
+//
+//	{
+//	  "button": {
+//	    "image": "button",
+//	    "textFace": "default",
+//	    "textColor": "buttonText",
+//	    "textPadding": {"left": 10, "right": 10}
+//	  },
+//	  "slider": {"trackImage": "track", "handleImage": "button"},
+//	  "comboButton": {"button": {"image": "combo"}}
+//	}
+type ThemeNode map[string]map[string]interface{}
+
+var (
+	faceType   = reflect.TypeOf((*font.Face)(nil)).Elem()
+	colorType  = reflect.TypeOf((*color.Color)(nil)).Elem()
+	insetsType = reflect.TypeOf(&widget.Insets{})
+)
+
+// DecodeThemeJSON decodes a JSON theme document from r.
+func DecodeThemeJSON(r io.Reader) (ThemeNode, error) {
+	n := ThemeNode{}
+	if err := json.NewDecoder(r).Decode(&n); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDocument, err)
+	}
+	return n, nil
+}
+
+// DecodeThemeYAML decodes a YAML theme document from r.
+func DecodeThemeYAML(r io.Reader) (ThemeNode, error) {
+	n := ThemeNode{}
+	if err := yaml.NewDecoder(r).Decode(&n); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDocument, err)
+	}
+	return n, nil
+}
+
+// LoadThemeJSON decodes a JSON theme document from r and builds its theme.
+func (l *Loader) LoadThemeJSON(r io.Reader) (*widget.Theme, error) {
+	n, err := DecodeThemeJSON(r)
+	if err != nil {
+		return nil, err
+	}
+	return l.LoadTheme(n)
+}
+
+// LoadThemeYAML decodes a YAML theme document from r and builds its theme.
+func (l *Loader) LoadThemeYAML(r io.Reader) (*widget.Theme, error) {
+	n, err := DecodeThemeYAML(r)
+	if err != nil {
+		return nil, err
+	}
+	return l.LoadTheme(n)
+}
+
+// LoadTheme builds the theme described by n.
+func (l *Loader) LoadTheme(n ThemeNode) (*widget.Theme, error) {
+	t := widget.Theme{}
+	tv := reflect.ValueOf(&t).Elem()
+
+	for _, name := range sortedKeys(n) {
+		sf := fieldByName(tv, name)
+		if !sf.IsValid() {
+			return nil, fmt.Errorf("%w: unknown theme section %q", ErrInvalidDocument, name)
+		}
+
+		sv, err := l.themeSection(sf.Type(), n[name], name)
+		if err != nil {
+			return nil, err
+		}
+
+		sf.Set(sv)
+	}
+
+	return &t, nil
+}
+
+// themeSection returns a new theme section of pointer type t, with its fields set as described by fields.
+// Errors are prefixed with path.
+func (l *Loader) themeSection(t reflect.Type, fields map[string]interface{}, path string) (reflect.Value, error) {
+	sv := reflect.New(t.Elem())
+
+	for _, fname := range sortedKeys(fields) {
+		f := fieldByName(sv.Elem(), fname)
+		if !f.IsValid() {
+			return reflect.Value{}, fmt.Errorf("%w: %s: unknown field %q", ErrInvalidDocument, path, fname)
+		}
+
+		fpath := path + "." + fname
+
+		if isThemeSection(f.Type()) {
+			m, ok := fields[fname].(map[string]interface{})
+			if !ok {
+				return reflect.Value{}, fmt.Errorf("%w: %s: section required, got %v", ErrInvalidDocument, fpath, fields[fname])
+			}
+
+			v, err := l.themeSection(f.Type(), m, fpath)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			f.Set(v)
+			continue
+		}
+
+		if err := l.setThemeField(f, fields[fname]); err != nil {
+			return reflect.Value{}, fmt.Errorf("%s: %w", fpath, err)
+		}
+	}
+
+	return sv, nil
+}
+
+// isThemeSection returns whether t is a pointer to a theme section, such as *widget.ButtonTheme.
+func isThemeSection(t reflect.Type) bool {
+	return t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct && strings.HasSuffix(t.Elem().Name(), "Theme")
+}
+
+// setThemeField sets theme section field f to the value described by v.
+func (l *Loader) setThemeField(f reflect.Value, v interface{}) error {
+	t := f.Type()
+
+	if t == insetsType || (t.Kind() != reflect.Ptr && t.Kind() != reflect.Interface) {
+		// round-trip through JSON to accept the numbers and maps of both JSON and YAML documents
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidDocument, err)
+		}
+
+		d := json.NewDecoder(strings.NewReader(string(b)))
+		d.DisallowUnknownFields()
+		if err := d.Decode(f.Addr().Interface()); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidDocument, err)
+		}
+		return nil
+	}
+
+	name, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("%w: resource name required, got %v", ErrInvalidDocument, v)
+	}
+
+	var rv reflect.Value
+	var err error
+	switch {
+	case t == faceType:
+		var face font.Face
+		face, err = l.resources.Font(name)
+		rv = reflect.ValueOf(&face).Elem()
+	case t == colorType || (t.Kind() == reflect.Ptr && strings.HasSuffix(t.Elem().Name(), "Color")):
+		rv, err = resourceValue(l.resources.colors, "color", name, t)
+	default:
+		rv, err = resourceValue(l.resources.images, "image", name, t)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	f.Set(rv)
+	return nil
+}
+
+// fieldByName returns the field of struct v whose name, starting with a lower case letter, is name.
+func fieldByName(v reflect.Value, name string) reflect.Value {
+	if name == "" {
+		return reflect.Value{}
+	}
+	return v.FieldByName(strings.ToUpper(name[:1]) + name[1:])
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,119 @@
+package loader
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/blizzy78/ebitenui/widget"
+	"golang.org/x/image/font/basicfont"
+
+	"github.com/matryer/is"
+)
+
+func TestLoader_LoadThemeYAML(t *testing.T) {
+	is := is.New(t)
+
+	r := newResources(t)
+
+	th, err := New(r).LoadThemeYAML(strings.NewReader(`
+button:
+  image: button
+  textFace: default
+  textColor: button
+  textPadding:
+    left: 10
+    right: 10
+textInput:
+  caretWidth: 3
+text:
+  color: text
+listComboButton:
+  button:
+    image: button
+  list:
+    scrollContainerImage: scroll
+    entryTextPadding:
+      top: 2
+graphic:
+  image: graphic
+`))
+	is.NoErr(err)
+
+	is.Equal(th.Button.Image, r.images["button"])
+	is.Equal(th.Button.TextFace, basicfont.Face7x13)
+	is.Equal(th.Button.TextColor, r.colors["button"])
+	is.Equal(th.Button.TextPadding, &widget.Insets{
+		Left:  10,
+		Right: 10,
+	})
+	is.Equal(th.TextInput.CaretWidth, 3)
+	is.Equal(th.Text.Color, r.colors["text"])
+	is.True(th.Slider == nil)
+	is.Equal(th.ListComboButton.Button.Image, r.images["button"])
+	is.Equal(th.ListComboButton.List.ScrollContainerImage, r.images["scroll"])
+	is.Equal(th.ListComboButton.List.EntryTextPadding, &widget.Insets{
+		Top: 2,
+	})
+	is.Equal(th.Graphic.Image, r.images["graphic"])
+}
+
+func TestLoader_LoadThemeJSON_Errors(t *testing.T) {
+	tests := map[string]struct {
+		doc string
+		err error
+		msg string
+	}{
+		"unknown section": {
+			doc: `{"foo": {}}`,
+			err: ErrInvalidDocument,
+			msg: `unknown theme section "foo"`,
+		},
+		"unknown field": {
+			doc: `{"button": {"foo": "button"}}`,
+			err: ErrInvalidDocument,
+			msg: `button: unknown field "foo"`,
+		},
+		"unknown resource": {
+			doc: `{"button": {"image": "foo"}}`,
+			err: ErrUnknownResource,
+			msg: `button.image: unknown resource: image "foo"`,
+		},
+		"wrong resource type": {
+			doc: `{"button": {"image": "scroll"}}`,
+			err: ErrResourceType,
+			msg: `image "scroll"`,
+		},
+		"unknown nested field": {
+			doc: `{"comboButton": {"button": {"foo": "button"}}}`,
+			err: ErrInvalidDocument,
+			msg: `comboButton.button: unknown field "foo"`,
+		},
+		"nested resource": {
+			doc: `{"comboButton": {"button": {"image": "foo"}}}`,
+			err: ErrUnknownResource,
+			msg: "comboButton.button.image",
+		},
+		"section required": {
+			doc: `{"comboButton": {"button": "button"}}`,
+			err: ErrInvalidDocument,
+			msg: "comboButton.button: section required",
+		},
+		"invalid insets": {
+			doc: `{"button": {"textPadding": {"middle": 5}}}`,
+			err: ErrInvalidDocument,
+			msg: "button.textPadding",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			is := is.New(t)
+
+			_, err := New(newResources(t)).LoadThemeJSON(strings.NewReader(test.doc))
+			is.True(errors.Is(err, test.err))
+			is.True(strings.Contains(err.Error(), test.msg))
+		})
+	}
+}
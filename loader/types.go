@@ -0,0 +1,734 @@
+package loader
+
+import (
+	"image/color"
+
+	"github.com/blizzy78/ebitenui/image"
+	"github.com/blizzy78/ebitenui/widget"
+	"github.com/hajimehoshi/ebiten/v2"
+	"golang.org/x/image/font"
+)
+
+func registerBuiltinTypes(l *Loader) {
+	l.RegisterType("Container", buildContainer)
+	l.RegisterType("Button", buildButton)
+	l.RegisterType("Label", buildLabel)
+	l.RegisterType("Text", buildText)
+	l.RegisterType("Graphic", buildGraphic)
+	l.RegisterType("Checkbox", buildCheckbox)
+	l.RegisterType("LabeledCheckbox", buildLabeledCheckbox)
+	l.RegisterType("Slider", buildSlider)
+	l.RegisterType("TextInput", buildTextInput)
+	l.RegisterType("ScrollContainer", buildScrollContainer)
+	l.RegisterType("List", buildList)
+	l.RegisterType("ComboButton", buildComboButton)
+	l.RegisterType("ListComboButton", buildListComboButton)
+	l.RegisterType("TabBook", buildTabBook)
+	l.RegisterType("FlipBook", buildFlipBook)
+	l.RegisterType("SplitPane", buildSplitPane)
+	l.RegisterType("Window", buildWindow)
+}
+
+func buildContainer(c *Context) (widget.PreferredSizeLocateableWidget, error) {
+	opts := []widget.ContainerOpt{
+		widget.ContainerOpts.WidgetOpts(c.WidgetOpts...),
+	}
+
+	layout, err := c.layout()
+	if err != nil {
+		return nil, err
+	}
+	if layout != nil {
+		opts = append(opts, widget.ContainerOpts.Layout(layout))
+	}
+
+	if c.Node.Image != "" {
+		i, err := ContextImage[*image.NineSlice](c, c.Node.Image)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.ContainerOpts.BackgroundImage(i))
+	}
+
+	children, err := c.Children()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(children) > 0 && layout == nil {
+		return nil, c.Errorf("container with children requires a layout")
+	}
+
+	co := widget.NewContainer(opts...)
+	for _, ch := range children {
+		co.AddChild(ch)
+	}
+
+	return co, nil
+}
+
+func buildButton(c *Context) (widget.PreferredSizeLocateableWidget, error) {
+	opts, err := buttonOpts(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Node.Text != "" {
+		f, err := c.Font()
+		if err != nil {
+			return nil, err
+		}
+
+		col, err := ContextColor[*widget.ButtonTextColor](c)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, widget.ButtonOpts.Text(c.Node.Text, f, col))
+
+		if c.Node.Padding != nil {
+			opts = append(opts, widget.ButtonOpts.TextPadding(c.Padding()))
+		}
+	}
+
+	pressed, ok, err := ContextHandler[widget.ButtonPressedHandlerFunc](c, "pressed")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		opts = append(opts, widget.ButtonOpts.PressedHandler(pressed))
+	}
+
+	released, ok, err := ContextHandler[widget.ButtonReleasedHandlerFunc](c, "released")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		opts = append(opts, widget.ButtonOpts.ReleasedHandler(released))
+	}
+
+	clicked, ok, err := ContextHandler[widget.ButtonClickedHandlerFunc](c, "clicked")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		opts = append(opts, widget.ButtonOpts.ClickedHandler(clicked))
+	}
+
+	return widget.NewButton(opts...), nil
+}
+
+// buttonOpts returns options that configure a button's widget and image, as used by Button and Checkbox.
+func buttonOpts(c *Context) ([]widget.ButtonOpt, error) {
+	i, err := ContextImage[*widget.ButtonImage](c, c.Node.Image)
+	if err != nil {
+		return nil, err
+	}
+
+	return []widget.ButtonOpt{
+		widget.ButtonOpts.WidgetOpts(c.WidgetOpts...),
+		widget.ButtonOpts.Image(i),
+	}, nil
+}
+
+func buildLabel(c *Context) (widget.PreferredSizeLocateableWidget, error) {
+	opts, err := labelOpts(c, c.WidgetOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return widget.NewLabel(opts...), nil
+}
+
+// labelOpts returns options that configure a label, as used by Label and LabeledCheckbox.
+func labelOpts(c *Context, widgetOpts []widget.WidgetOpt) ([]widget.LabelOpt, error) {
+	f, err := c.Font()
+	if err != nil {
+		return nil, err
+	}
+
+	col, err := ContextColor[*widget.LabelColor](c)
+	if err != nil {
+		return nil, err
+	}
+
+	return []widget.LabelOpt{
+		widget.LabelOpts.TextOpts(widget.TextOpts.WidgetOpts(widgetOpts...)),
+		widget.LabelOpts.Text(c.Node.Text, f, col),
+	}, nil
+}
+
+func buildText(c *Context) (widget.PreferredSizeLocateableWidget, error) {
+	f, err := c.Font()
+	if err != nil {
+		return nil, err
+	}
+
+	col, err := ContextColor[color.Color](c)
+	if err != nil {
+		return nil, err
+	}
+
+	return widget.NewText(
+		widget.TextOpts.WidgetOpts(c.WidgetOpts...),
+		widget.TextOpts.Text(c.Node.Text, f, col),
+	), nil
+}
+
+func buildGraphic(c *Context) (widget.PreferredSizeLocateableWidget, error) {
+	i, err := ContextImage[interface{}](c, c.Node.Image)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []widget.GraphicOpt{
+		widget.GraphicOpts.WidgetOpts(c.WidgetOpts...),
+	}
+
+	switch i := i.(type) {
+	case *ebiten.Image:
+		opts = append(opts, widget.GraphicOpts.Image(i))
+	case *image.NineSlice:
+		opts = append(opts, widget.GraphicOpts.ImageNineSlice(i))
+	default:
+		return nil, c.Errorf("%v: image %q is of type %T, expected *ebiten.Image or *image.NineSlice",
+			ErrResourceType, c.Node.Image, i)
+	}
+
+	return widget.NewGraphic(opts...), nil
+}
+
+func buildCheckbox(c *Context) (widget.PreferredSizeLocateableWidget, error) {
+	opts, err := checkboxOpts(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return widget.NewCheckbox(opts...), nil
+}
+
+// checkboxOpts returns options that configure a checkbox, as used by Checkbox and LabeledCheckbox.
+func checkboxOpts(c *Context) ([]widget.CheckboxOpt, error) {
+	bOpts, err := buttonOpts(c)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := ContextImage[*widget.CheckboxGraphicImage](c, c.Node.Graphic)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []widget.CheckboxOpt{
+		widget.CheckboxOpts.ButtonOpts(bOpts...),
+		widget.CheckboxOpts.Image(g),
+	}
+
+	if c.Node.TriState {
+		opts = append(opts, widget.CheckboxOpts.TriState())
+	}
+
+	changed, ok, err := ContextHandler[widget.CheckboxChangedHandlerFunc](c, "changed")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		opts = append(opts, widget.CheckboxOpts.ChangedHandler(changed))
+	}
+
+	return opts, nil
+}
+
+func buildLabeledCheckbox(c *Context) (widget.PreferredSizeLocateableWidget, error) {
+	// the widget options belong to the labeled checkbox's container, not to the checkbox
+	widgetOpts := c.WidgetOpts
+	c.WidgetOpts = nil
+
+	cOpts, err := checkboxOpts(c)
+	if err != nil {
+		return nil, err
+	}
+
+	lOpts, err := labelOpts(c, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []widget.LabeledCheckboxOpt{
+		widget.LabeledCheckboxOpts.CheckboxOpts(cOpts...),
+		widget.LabeledCheckboxOpts.LabelOpts(lOpts...),
+	}
+
+	if c.Node.Spacing > 0 {
+		opts = append(opts, widget.LabeledCheckboxOpts.Spacing(c.Node.Spacing))
+	}
+
+	l := widget.NewLabeledCheckbox(opts...)
+
+	w := l.GetWidget()
+	for _, o := range widgetOpts {
+		o(w)
+	}
+
+	return l, nil
+}
+
+func buildSlider(c *Context) (widget.PreferredSizeLocateableWidget, error) {
+	track, err := ContextImage[*widget.SliderTrackImage](c, c.Node.Image)
+	if err != nil {
+		return nil, err
+	}
+
+	handle, err := ContextImage[*widget.ButtonImage](c, c.Node.HandleImage)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := c.Direction()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []widget.SliderOpt{
+		widget.SliderOpts.WidgetOpts(c.WidgetOpts...),
+		widget.SliderOpts.Images(track, handle),
+		widget.SliderOpts.Direction(d),
+	}
+
+	if c.Node.Min != 0 || c.Node.Max != 0 {
+		if c.Node.Min > c.Node.Max {
+			return nil, c.Errorf("min %d is greater than max %d", c.Node.Min, c.Node.Max)
+		}
+		opts = append(opts, widget.SliderOpts.MinMax(c.Node.Min, c.Node.Max))
+	}
+
+	if c.Node.Padding != nil {
+		opts = append(opts, widget.SliderOpts.TrackPadding(c.Padding()))
+	}
+
+	changed, ok, err := ContextHandler[widget.SliderChangedHandlerFunc](c, "changed")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		opts = append(opts, widget.SliderOpts.ChangedHandler(changed))
+	}
+
+	return widget.NewSlider(opts...), nil
+}
+
+func buildTextInput(c *Context) (widget.PreferredSizeLocateableWidget, error) {
+	i, err := ContextImage[*widget.TextInputImage](c, c.Node.Image)
+	if err != nil {
+		return nil, err
+	}
+
+	col, err := ContextColor[*widget.TextInputColor](c)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := c.Font()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []widget.TextInputOpt{
+		widget.TextInputOpts.WidgetOpts(c.WidgetOpts...),
+		widget.TextInputOpts.Image(i),
+		widget.TextInputOpts.Color(col),
+		widget.TextInputOpts.Face(f),
+		widget.TextInputOpts.CaretOpts(widget.CaretOpts.Size(f, 2)),
+		widget.TextInputOpts.Padding(c.Padding()),
+		widget.TextInputOpts.Placeholder(c.Node.Placeholder),
+		widget.TextInputOpts.Secure(c.Node.Secure),
+	}
+
+	changed, ok, err := ContextHandler[widget.TextInputChangedHandlerFunc](c, "changed")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		opts = append(opts, widget.TextInputOpts.ChangedHandler(changed))
+	}
+
+	t := widget.NewTextInput(opts...)
+	t.InputText = c.Node.Text
+
+	return t, nil
+}
+
+func buildScrollContainer(c *Context) (widget.PreferredSizeLocateableWidget, error) {
+	i, err := ContextImage[*widget.ScrollContainerImage](c, c.Node.Image)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := c.Child()
+	if err != nil {
+		return nil, err
+	}
+
+	return widget.NewScrollContainer(
+		widget.ScrollContainerOpts.WidgetOpts(c.WidgetOpts...),
+		widget.ScrollContainerOpts.Image(i),
+		widget.ScrollContainerOpts.Content(content),
+		widget.ScrollContainerOpts.Padding(c.Padding()),
+	), nil
+}
+
+// buildList builds a List. Its sliders, and all images that are not named by the node, are taken from the theme.
+func buildList(c *Context) (widget.PreferredSizeLocateableWidget, error) {
+	opts, err := listOpts(c)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append(opts,
+		widget.ListOpts.ContainerOpts(widget.ContainerOpts.WidgetOpts(c.WidgetOpts...)),
+		widget.ListOpts.HideHorizontalSlider())
+
+	if c.Node.Image != "" {
+		i, err := ContextImage[*widget.ScrollContainerImage](c, c.Node.Image)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.ListOpts.ScrollContainerOpts(widget.ScrollContainerOpts.Image(i)))
+	}
+
+	col, ok, err := optionalColor[*widget.ListEntryColor](c)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		opts = append(opts, widget.ListOpts.EntryColor(col))
+	}
+
+	if c.Node.Padding != nil {
+		opts = append(opts, widget.ListOpts.EntryTextPadding(c.Padding()))
+	}
+
+	if c.Node.Spacing > 0 {
+		opts = append(opts, widget.ListOpts.ControlWidgetSpacing(c.Node.Spacing))
+	}
+
+	selected, ok, err := ContextHandler[widget.ListEntrySelectedHandlerFunc](c, "entrySelected")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		opts = append(opts, widget.ListOpts.EntrySelectedHandler(selected))
+	}
+
+	return widget.NewList(opts...), nil
+}
+
+// listOpts returns options that configure a list's entries and font face, as used by List and ListComboButton.
+// Entries are the strings of c.Node.Entries, which are also their labels.
+func listOpts(c *Context) ([]widget.ListOpt, error) {
+	entries := make([]interface{}, len(c.Node.Entries))
+	for i, e := range c.Node.Entries {
+		entries[i] = e
+	}
+
+	opts := []widget.ListOpt{
+		widget.ListOpts.Entries(entries),
+		widget.ListOpts.EntryLabelFunc(entryLabel),
+	}
+
+	f, err := c.optionalFont()
+	if err != nil {
+		return nil, err
+	}
+	if f != nil {
+		opts = append(opts, widget.ListOpts.EntryFontFace(f))
+	}
+
+	return opts, nil
+}
+
+func entryLabel(e interface{}) string {
+	return e.(string)
+}
+
+func buildComboButton(c *Context) (widget.PreferredSizeLocateableWidget, error) {
+	bOpts, err := comboButtonOpts(c)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := c.Child()
+	if err != nil {
+		return nil, err
+	}
+
+	return widget.NewComboButton(
+		widget.ComboButtonOpts.ButtonOpts(bOpts...),
+		widget.ComboButtonOpts.Content(content),
+	), nil
+}
+
+// comboButtonOpts returns options that configure the button of a combo button, as used by ComboButton and
+// ListComboButton. The button is labeled with c.Node.Text, and c.Node.Graphic is shown next to it. Images, font face, and color that are not named by the node are taken from the theme.
+func comboButtonOpts(c *Context) ([]widget.ButtonOpt, error) {
+	opts := []widget.ButtonOpt{
+		widget.ButtonOpts.WidgetOpts(c.WidgetOpts...),
+	}
+
+	if c.Node.Image != "" {
+		i, err := ContextImage[*widget.ButtonImage](c, c.Node.Image)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.ButtonOpts.Image(i))
+	}
+
+	f, err := c.optionalFont()
+	if err != nil {
+		return nil, err
+	}
+
+	col, _, err := optionalColor[*widget.ButtonTextColor](c)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Node.Graphic != "" {
+		g, err := ContextImage[*widget.ButtonImageImage](c, c.Node.Graphic)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.ButtonOpts.TextAndImage(c.Node.Text, f, g, col))
+	} else {
+		opts = append(opts, widget.ButtonOpts.Text(c.Node.Text, f, col))
+	}
+
+	if c.Node.Padding != nil {
+		opts = append(opts, widget.ButtonOpts.TextPadding(c.Padding()))
+	}
+
+	return opts, nil
+}
+
+// buildListComboButton builds a ListComboButton. Its list is configured by the theme, except for its
+// entries and font face.
+func buildListComboButton(c *Context) (widget.PreferredSizeLocateableWidget, error) {
+	bOpts, err := comboButtonOpts(c)
+	if err != nil {
+		return nil, err
+	}
+
+	lOpts, err := listOpts(c)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []widget.ListComboButtonOpt{
+		widget.ListComboButtonOpts.SelectComboButtonOpts(
+			widget.SelectComboButtonOpts.ComboButtonOpts(widget.ComboButtonOpts.ButtonOpts(bOpts...))),
+		widget.ListComboButtonOpts.ListOpts(lOpts...),
+		widget.ListComboButtonOpts.EntryLabelFunc(entryLabel, entryLabel),
+	}
+
+	selected, ok, err := ContextHandler[widget.ListComboButtonEntrySelectedHandlerFunc](c, "entrySelected")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		opts = append(opts, widget.ListComboButtonOpts.EntrySelectedHandler(selected))
+	}
+
+	return widget.NewListComboButton(opts...), nil
+}
+
+// buildTabBook builds a TabBook that has a tab for each child, labeled by the child's node's Tab. The tab
+// button images are named by Image and SelectedImage, or taken from the theme.
+func buildTabBook(c *Context) (widget.PreferredSizeLocateableWidget, error) {
+	// pages are embedded in the anchor layout of the TabBook's flip book
+	children, err := c.children("AnchorLayout")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(children) == 0 {
+		return nil, c.Errorf("at least one child required")
+	}
+
+	tabs := make([]*widget.TabBookTab, len(children))
+	for i, ch := range children {
+		if c.Node.Children[i].Tab == "" {
+			return nil, c.Errorf("children[%d]: tab label required", i)
+		}
+		tabs[i] = widget.NewTabBookTab(c.Node.Children[i].Tab, ch)
+	}
+
+	opts := []widget.TabBookOpt{
+		widget.TabBookOpts.ContainerOpts(widget.ContainerOpts.WidgetOpts(c.WidgetOpts...)),
+		widget.TabBookOpts.Tabs(tabs...),
+		widget.TabBookOpts.Spacing(c.Node.Spacing),
+	}
+
+	if c.Node.Image != "" || c.Node.SelectedImage != "" {
+		idle, err := ContextImage[*widget.ButtonImage](c, c.Node.Image)
+		if err != nil {
+			return nil, err
+		}
+
+		selected, err := ContextImage[*widget.ButtonImage](c, c.Node.SelectedImage)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, widget.TabBookOpts.TabButtonImage(idle, selected))
+	}
+
+	f, err := c.optionalFont()
+	if err != nil {
+		return nil, err
+	}
+
+	col, _, err := optionalColor[*widget.ButtonTextColor](c)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append(opts, widget.TabBookOpts.TabButtonText(f, col))
+
+	selected, ok, err := ContextHandler[widget.TabBookTabSelectedHandlerFunc](c, "tabSelected")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		opts = append(opts, widget.TabBookOpts.TabSelectedHandler(selected))
+	}
+
+	return widget.NewTabBook(opts...), nil
+}
+
+// buildFlipBook builds a FlipBook that shows its single child. Other pages can be set using FlipBook.SetPage.
+// The padding is taken from the theme if it is not set.
+func buildFlipBook(c *Context) (widget.PreferredSizeLocateableWidget, error) {
+	page, err := c.child("AnchorLayout")
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []widget.FlipBookOpt{
+		widget.FlipBookOpts.ContainerOpts(widget.ContainerOpts.WidgetOpts(c.WidgetOpts...)),
+	}
+
+	if c.Node.Padding != nil {
+		opts = append(opts, widget.FlipBookOpts.Padding(c.Padding()))
+	}
+
+	f := widget.NewFlipBook(opts...)
+	f.SetPage(page)
+
+	return f, nil
+}
+
+// buildSplitPane builds a SplitPane that shows its two children. The divider image is named by Image, or
+// taken from the theme.
+func buildSplitPane(c *Context) (widget.PreferredSizeLocateableWidget, error) {
+	children, err := c.Children()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(children) != 2 {
+		return nil, c.Errorf("exactly two children required, got %d", len(children))
+	}
+
+	d, err := c.Direction()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []widget.SplitPaneOpt{
+		widget.SplitPaneOpts.WidgetOpts(c.WidgetOpts...),
+		widget.SplitPaneOpts.Direction(d),
+		widget.SplitPaneOpts.Widgets(children[0], children[1]),
+	}
+
+	if c.Node.Image != "" {
+		i, err := ContextImage[*widget.ButtonImage](c, c.Node.Image)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, widget.SplitPaneOpts.DividerImage(i))
+	}
+
+	if c.Node.Spacing > 0 {
+		opts = append(opts, widget.SplitPaneOpts.DividerSize(c.Node.Spacing))
+	}
+
+	changed, ok, err := ContextHandler[widget.SplitPaneChangedHandlerFunc](c, "changed")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		opts = append(opts, widget.SplitPaneOpts.ChangedHandler(changed))
+	}
+
+	return widget.NewSplitPane(opts...), nil
+}
+
+// buildWindow builds a Window whose contents are its single child, which must be a Container. Windows
+// must be the root of a document, and are added to a UI using UI.AddWindow.
+func buildWindow(c *Context) (widget.PreferredSizeLocateableWidget, error) {
+	if !c.root {
+		return nil, c.Errorf("Window must be the root of the document")
+	}
+
+	// the widget options belong to the window's contents, which it has no options for
+	widgetOpts := c.WidgetOpts
+	c.WidgetOpts = nil
+
+	child, err := c.Child()
+	if err != nil {
+		return nil, err
+	}
+
+	contents, ok := child.(*widget.Container)
+	if !ok {
+		return nil, c.Errorf("contents must be a Container, got %T", child)
+	}
+
+	w := contents.GetWidget()
+	for _, o := range widgetOpts {
+		o(w)
+	}
+
+	opts := []widget.WindowOpt{
+		widget.WindowOpts.Contents(contents),
+	}
+
+	if c.Node.Modal {
+		opts = append(opts, widget.WindowOpts.Modal())
+	}
+
+	return widget.NewWindow(opts...), nil
+}
+
+// optionalFont returns the font face named by c.Node.Font, or nil if it is not set.
+func (c *Context) optionalFont() (font.Face, error) {
+	if c.Node.Font == "" {
+		return nil, nil
+	}
+	return c.Font()
+}
+
+// optionalColor returns the color named by c.Node.Color, which must be of type T. It returns false if
+// c.Node.Color is not set.
+func optionalColor[T any](c *Context) (T, bool, error) {
+	if c.Node.Color == "" {
+		var t T
+		return t, false, nil
+	}
+
+	col, err := ContextColor[T](c)
+	if err != nil {
+		return col, false, err
+	}
+	return col, true, nil
+}
@@ -0,0 +1,46 @@
+package ebitenui
+
+import "github.com/blizzy78/ebitenui/widget"
+
+// FindByID returns the first widget in u.Container or u's windows whose ID is id, or nil.
+func (u *UI) FindByID(id string) widget.HasWidget {
+	return widget.FindWidgetByID(id, u.queryRoots()...)
+}
+
+// FindByTag returns all widgets in u.Container and u's windows that have tag.
+func (u *UI) FindByTag(tag string) []widget.HasWidget {
+	return widget.FindWidgetsByTag(tag, u.queryRoots()...)
+}
+
+// Find returns the first widget in u.Container or u's windows that matches p, or nil.
+func (u *UI) Find(p widget.WidgetPredicateFunc) widget.HasWidget {
+	return widget.FindWidget(p, u.queryRoots()...)
+}
+
+// FindAll returns all widgets in u.Container and u's windows that match p.
+func (u *UI) FindAll(p widget.WidgetPredicateFunc) []widget.HasWidget {
+	return widget.FindWidgets(p, u.queryRoots()...)
+}
+
+// FindByType returns all widgets of type T in u.Container and u's windows.
+func FindByType[T widget.HasWidget](u *UI) []T {
+	return widget.FindWidgetsOfType[T](u.queryRoots()...)
+}
+
+// Walk calls f for all widgets in u.Container and u's windows, as described in widget.Walk.
+func (u *UI) Walk(f widget.WidgetVisitorFunc) {
+	widget.Walk(f, u.queryRoots()...)
+}
+
+func (u *UI) queryRoots() []widget.HasWidget {
+	roots := []widget.HasWidget{}
+	if u.Container != nil {
+		roots = append(roots, u.Container)
+	}
+	for _, w := range u.windows {
+		if c := w.Contents(); c != nil {
+			roots = append(roots, c)
+		}
+	}
+	return roots
+}
@@ -0,0 +1,42 @@
+package ebitenui
+
+import (
+	"testing"
+
+	"github.com/blizzy78/ebitenui/widget"
+
+	"github.com/matryer/is"
+)
+
+func TestUI_FindByID(t *testing.T) {
+	is := is.New(t)
+
+	c := widget.NewContainer(widget.ContainerOpts.WidgetOpts(widget.WidgetOpts.ID("container")))
+	wc := widget.NewContainer(widget.ContainerOpts.WidgetOpts(widget.WidgetOpts.ID("window")))
+
+	u := &UI{
+		Container: c,
+	}
+	remove := u.AddWindow(widget.NewWindow(widget.WindowOpts.Contents(wc)))
+
+	is.Equal(u.FindByID("container"), c)
+	is.Equal(u.FindByID("window"), wc)
+
+	remove()
+	is.Equal(u.FindByID("window"), nil)
+}
+
+func TestFindByType(t *testing.T) {
+	is := is.New(t)
+
+	c := widget.NewContainer()
+	wc := widget.NewContainer(widget.ContainerOpts.WidgetOpts(widget.WidgetOpts.Tags("popup")))
+
+	u := &UI{
+		Container: c,
+	}
+	u.AddWindow(widget.NewWindow(widget.WindowOpts.Contents(wc)))
+
+	is.Equal(FindByType[*widget.Container](u), []*widget.Container{c, wc})
+	is.Equal(u.FindByTag("popup"), []widget.HasWidget{wc})
+}
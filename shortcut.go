@@ -0,0 +1,159 @@
+package ebitenui
+
+import (
+	"github.com/blizzy78/ebitenui/event"
+	"github.com/blizzy78/ebitenui/input"
+	"github.com/blizzy78/ebitenui/widget"
+)
+
+// Shortcut is a keyboard shortcut, such as Ctrl+S, that fires an event when its key chord is pressed.
+// Shortcuts must be added to a UI using UI.AddShortcut.
+type Shortcut struct {
+	TriggeredEvent *event.Of[*ShortcutTriggeredEventArgs]
+
+	chord  input.KeyChord
+	scope  ShortcutScope
+	window *widget.Window
+	widget widget.HasWidget
+}
+
+// ShortcutOpt is a function that configures s.
+type ShortcutOpt func(s *Shortcut)
+
+type ShortcutOptions struct {
+}
+
+// ShortcutScope determines when a shortcut is eligible to be triggered.
+type ShortcutScope int
+
+// ShortcutTriggeredEventArgs are the arguments of a shortcut's triggered event.
+type ShortcutTriggeredEventArgs struct {
+	Shortcut *Shortcut
+}
+
+// ShortcutTriggeredHandlerFunc is a function that handles a shortcut's triggered event.
+type ShortcutTriggeredHandlerFunc func(args *ShortcutTriggeredEventArgs)
+
+// RemoveShortcutFunc is a function to remove a Shortcut from a UI.
+type RemoveShortcutFunc func()
+
+const (
+	// ShortcutScopeGlobal is the scope of shortcuts that are always eligible.
+	ShortcutScopeGlobal = ShortcutScope(iota)
+
+	// ShortcutScopeWindow is the scope of shortcuts that are only eligible while their window is the topmost window.
+	ShortcutScopeWindow
+
+	// ShortcutScopeFocused is the scope of shortcuts that are only eligible while their widget is focused.
+	ShortcutScopeFocused
+)
+
+// ShortcutOpts contains functions that configure a Shortcut.
+var ShortcutOpts ShortcutOptions
+
+// NewShortcut constructs a new shortcut that is triggered by key chord c. By default, the
+// shortcut has global scope.
+func NewShortcut(c input.KeyChord, opts ...ShortcutOpt) *Shortcut {
+	s := &Shortcut{
+		TriggeredEvent: &event.Of[*ShortcutTriggeredEventArgs]{},
+
+		chord: c,
+	}
+
+	for _, o := range opts {
+		o(s)
+	}
+
+	return s
+}
+
+// Window configures a shortcut to only be eligible while window w is the topmost window of the UI.
+func (o ShortcutOptions) Window(w *widget.Window) ShortcutOpt {
+	return func(s *Shortcut) {
+		s.scope = ShortcutScopeWindow
+		s.window = w
+	}
+}
+
+// FocusedWidget configures a shortcut to only be eligible while widget w is focused.
+func (o ShortcutOptions) FocusedWidget(w widget.HasWidget) ShortcutOpt {
+	return func(s *Shortcut) {
+		s.scope = ShortcutScopeFocused
+		s.widget = w
+	}
+}
+
+// TriggeredHandler configures a shortcut with triggered event handler f.
+func (o ShortcutOptions) TriggeredHandler(f ShortcutTriggeredHandlerFunc) ShortcutOpt {
+	return func(s *Shortcut) {
+		s.TriggeredEvent.AddHandler(f)
+	}
+}
+
+// Chord returns the key chord that triggers s.
+func (s *Shortcut) Chord() input.KeyChord {
+	return s.chord
+}
+
+// Scope returns the scope of s.
+func (s *Shortcut) Scope() ShortcutScope {
+	return s.scope
+}
+
+// AddShortcut adds shortcut s to u. It returns a function to remove s from u.
+//
+// When a key chord is pressed, the focused widget may consume it if it implements widget.KeyConsumer,
+// such as a focused TextInput does for typing keys. Otherwise, at most one shortcut is triggered, with
+// shortcuts of the focused widget taking precedence over shortcuts of the topmost window, which in turn
+// take precedence over global shortcuts.
+func (u *UI) AddShortcut(s *Shortcut) RemoveShortcutFunc {
+	u.shortcuts = append(u.shortcuts, s)
+
+	return func() {
+		u.removeShortcut(s)
+	}
+}
+
+func (u *UI) removeShortcut(s *Shortcut) {
+	for i, us := range u.shortcuts {
+		if us == s {
+			u.shortcuts = append(u.shortcuts[:i], u.shortcuts[i+1:]...)
+			break
+		}
+	}
+}
+
+func (u *UI) handleShortcuts() {
+	if len(u.shortcuts) == 0 || !input.AnyKeyPressed() {
+		return
+	}
+
+	for _, scope := range []ShortcutScope{ShortcutScopeFocused, ShortcutScopeWindow, ShortcutScopeGlobal} {
+		for _, s := range u.shortcuts {
+			if s.scope != scope || !u.shortcutEligible(s) || !input.KeyChordJustPressed(s.chord) {
+				continue
+			}
+
+			if c, ok := u.focusedWidget.(widget.KeyConsumer); ok && c.ConsumesKeyChord(s.chord) {
+				return
+			}
+
+			s.TriggeredEvent.Fire(&ShortcutTriggeredEventArgs{
+				Shortcut: s,
+			})
+
+			return
+		}
+	}
+}
+
+func (u *UI) shortcutEligible(s *Shortcut) bool {
+	switch s.scope {
+	case ShortcutScopeWindow:
+		return len(u.windows) > 0 && u.windows[len(u.windows)-1] == s.window
+	case ShortcutScopeFocused:
+		return u.focusedWidget != nil && u.focusedWidget == s.widget
+	default:
+		return true
+	}
+}
@@ -0,0 +1,130 @@
+package ebitenui
+
+import (
+	"testing"
+
+	"github.com/blizzy78/ebitenui/event"
+	"github.com/blizzy78/ebitenui/input"
+	internalinput "github.com/blizzy78/ebitenui/internal/input"
+	"github.com/blizzy78/ebitenui/widget"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+type keyConsumerWidget struct {
+	widget   *widget.Widget
+	consumes bool
+}
+
+func TestUI_Shortcut_Global(t *testing.T) {
+	is := is.New(t)
+
+	u := &UI{}
+
+	var eventArgs *ShortcutTriggeredEventArgs
+	s := NewShortcut(input.NewKeyChord(ebiten.KeyS, input.ModifierControl), ShortcutOpts.TriggeredHandler(func(args *ShortcutTriggeredEventArgs) {
+		eventArgs = args
+	}))
+	event.ExecuteDeferred()
+
+	remove := u.AddShortcut(s)
+
+	keyInput(t, u, ebiten.KeyControl, ebiten.KeyS)
+	is.True(eventArgs != nil)
+	is.Equal(eventArgs.Shortcut, s)
+
+	eventArgs = nil
+	keyInput(t, u, ebiten.KeyS)
+	is.True(eventArgs == nil)
+
+	remove()
+	keyInput(t, u, ebiten.KeyControl, ebiten.KeyS)
+	is.True(eventArgs == nil)
+}
+
+func TestUI_Shortcut_Window(t *testing.T) {
+	is := is.New(t)
+
+	u := &UI{}
+	w1 := widget.NewWindow()
+	w2 := widget.NewWindow()
+
+	triggered := false
+	u.AddShortcut(NewShortcut(input.NewKeyChord(ebiten.KeyEscape), ShortcutOpts.Window(w1), ShortcutOpts.TriggeredHandler(func(args *ShortcutTriggeredEventArgs) {
+		triggered = true
+	})))
+	event.ExecuteDeferred()
+
+	keyInput(t, u, ebiten.KeyEscape)
+	is.True(!triggered)
+
+	u.AddWindow(w1)
+	remove := u.AddWindow(w2)
+	keyInput(t, u, ebiten.KeyEscape)
+	is.True(!triggered)
+
+	remove()
+	keyInput(t, u, ebiten.KeyEscape)
+	is.True(triggered)
+}
+
+func TestUI_Shortcut_Precedence(t *testing.T) {
+	is := is.New(t)
+
+	u := &UI{}
+	w := &keyConsumerWidget{
+		widget: widget.NewWidget(),
+	}
+	u.focusedWidget = w
+
+	triggered := []ShortcutScope{}
+	handler := ShortcutOpts.TriggeredHandler(func(args *ShortcutTriggeredEventArgs) {
+		triggered = append(triggered, args.Shortcut.Scope())
+	})
+
+	c := input.NewKeyChord(ebiten.KeyF1)
+	u.AddShortcut(NewShortcut(c, handler))
+	u.AddShortcut(NewShortcut(c, ShortcutOpts.FocusedWidget(w), handler))
+	event.ExecuteDeferred()
+
+	keyInput(t, u, ebiten.KeyF1)
+	is.Equal(triggered, []ShortcutScope{ShortcutScopeFocused})
+
+	triggered = triggered[:0]
+	u.focusedWidget = nil
+	keyInput(t, u, ebiten.KeyF1)
+	is.Equal(triggered, []ShortcutScope{ShortcutScopeGlobal})
+
+	triggered = triggered[:0]
+	u.focusedWidget = w
+	w.consumes = true
+	keyInput(t, u, ebiten.KeyF1)
+	is.Equal(len(triggered), 0)
+}
+
+func (w *keyConsumerWidget) GetWidget() *widget.Widget {
+	return w.widget
+}
+
+func (w *keyConsumerWidget) ConsumesKeyChord(c input.KeyChord) bool {
+	return w.consumes
+}
+
+// keyInput simulates the user pressing keys ks, then releasing them, and lets u handle its shortcuts.
+func keyInput(t *testing.T, u *UI, ks ...ebiten.Key) {
+	t.Helper()
+
+	src := &input.FakeInputSource{}
+	for _, k := range ks {
+		src.SetKey(k, true)
+	}
+
+	internalinput.Update(src)
+	internalinput.Draw()
+	u.handleShortcuts()
+	event.ExecuteDeferred()
+
+	internalinput.Update(&input.FakeInputSource{})
+	internalinput.Draw()
+}
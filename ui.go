@@ -2,9 +2,11 @@ package ebitenui
 
 import (
 	"image"
+	"time"
 
 	"github.com/blizzy78/ebitenui/event"
 	"github.com/blizzy78/ebitenui/input"
+	internalevent "github.com/blizzy78/ebitenui/internal/event"
 	internalinput "github.com/blizzy78/ebitenui/internal/input"
 	"github.com/blizzy78/ebitenui/widget"
 
@@ -12,7 +14,9 @@ import (
 )
 
 // UI encapsulates a complete user interface that can be rendered onto the screen.
-// There should only be exactly one UI per application.
+//
+// Each UI owns its own event queue, input state, and input layer stack, so that multiple UIs, for example
+// one per split-screen player, do not interfere with each other.
 type UI struct {
 	// Container is the root container of the UI hierarchy.
 	Container *widget.Container
@@ -23,25 +27,86 @@ type UI struct {
 	// DragAndDrop is used to render drag widgets while dragging and dropping. It may be nil to disable rendering.
 	DragAndDrop *widget.DragAndDrop
 
-	lastRect      image.Rectangle
+	// InputSource provides user input. It may be nil to read user input from Ebiten.
+	InputSource input.InputSource
+
+	// Theme defines defaults for the widgets of Container and of all windows. It may be nil, in which case
+	// themes set on Container or on the windows' contents directly are used.
+	Theme *widget.Theme
+
+	// ActionMap maps user input to actions for the widgets of Container and of all windows, for example
+	// to move the focus using input.ActionNext and input.ActionPrevious. It may be nil to use input.Actions.
+	ActionMap *input.ActionMap
+
+	// GamepadTriggerAxes are the axes of the left and right gamepad triggers used to scroll widgets such as
+	// List. Triggers are expected to rest at -1 and to be fully pressed at 1. It may be nil to use axes
+	// 4 and 5, which are the triggers of standard gamepads such as Xbox controllers. Axes that a gamepad
+	// does not have are ignored, so that trigger scrolling can be disabled using {-1, -1}.
+	GamepadTriggerAxes *[2]int
+
 	focusedWidget widget.HasWidget
 	inputLayerers []input.Layerer
 	renderers     []widget.Renderer
 	windows       []*widget.Window
+	shortcuts     []*Shortcut
+	keyRepeater   input.KeyRepeater
+	eventQueue    *event.Queue
+	inputState    *internalinput.State
+	layerStack    *input.LayerStack
+	theme         *widget.Theme
 }
 
+var ebitenInputSource input.EbitenInputSource
+
+// timeNow returns the current time. It is a variable so that tests can replace it.
+var timeNow = time.Now
+
 // RemoveWindowFunc is a function to remove a Window from rendering.
 type RemoveWindowFunc func()
 
 // Update updates u. This method should be called in the Ebiten Update function.
+//
+// User input is read from u.InputSource exactly once per call, using input.ReadFrame. After Update
+// returns, the functions of package input report u's user input, until another UI is updated, so that
+// game code can query user input in its own Update function.
+//
+// Deferred actions that have been added outside of any UI, for example event handlers added to widgets
+// while setting them up, are executed first, without u's event queue being current.
 func (u *UI) Update() {
-	internalinput.Update()
+	event.ExecuteDeferred()
+
+	defer internalinput.SetCurrentState(internalinput.SetCurrentState(u.input()))
+
+	src := u.InputSource
+	if src == nil {
+		src = &ebitenInputSource
+	}
+
+	u.input().GamepadTriggerAxes = u.GamepadTriggerAxes
+	internalinput.Update(input.ReadFrame(src))
+
+	internalinput.DefaultState = u.input()
 }
 
 // Draw renders u onto screen. This function should be called in the Ebiten Draw function.
 //
-// If screen's size changes from one frame to the next, u.Container.RequestRelayout is called.
+// If screen's size changes from one frame to the next, u.Container is relayouted.
+//
+// Key presses and releases are delivered to the focused widget's KeyPressedEvent and KeyReleasedEvent,
+// and bubble up to its parents. Held keys repeat according to input.KeyRepeatDelay and input.KeyRepeatInterval.
 func (u *UI) Draw(screen *ebiten.Image) {
+	q := u.queue()
+	defer internalevent.SetCurrentQueue(internalevent.SetCurrentQueue(q))
+	defer internalinput.SetCurrentState(internalinput.SetCurrentState(u.input()))
+	defer input.SetCurrentLayerStack(input.SetCurrentLayerStack(u.inputLayerStack()))
+
+	u.Container.GetWidget().SetEventQueue(q)
+	u.Container.GetWidget().SetActionMap(u.ActionMap)
+	for _, w := range u.windows {
+		w.SetActionMap(u.ActionMap)
+	}
+	u.applyTheme()
+
 	event.ExecuteDeferred()
 
 	internalinput.Draw()
@@ -50,34 +115,161 @@ func (u *UI) Draw(screen *ebiten.Image) {
 	w, h := screen.Size()
 	rect := image.Rect(0, 0, w, h)
 
-	defer func() {
-		u.lastRect = rect
-	}()
-
-	if rect != u.lastRect {
-		u.Container.RequestRelayout()
-	}
-
 	u.handleFocus()
+	u.fireKeyEvents()
+	u.handleShortcuts()
 	u.setupInputLayers()
 	u.Container.SetLocation(rect)
 	u.render(screen)
 }
 
+// applyTheme sets the theme of u.Container and of all windows to u.Theme. As long as u.Theme stays nil,
+// themes set on them directly are left alone.
+func (u *UI) applyTheme() {
+	if u.Theme == nil && u.theme == nil {
+		return
+	}
+
+	u.theme = u.Theme
+
+	u.Container.SetTheme(u.Theme)
+	for _, w := range u.windows {
+		w.SetTheme(u.Theme)
+	}
+}
+
+func (u *UI) queue() *event.Queue {
+	if u.eventQueue == nil {
+		u.eventQueue = &event.Queue{}
+	}
+	return u.eventQueue
+}
+
+func (u *UI) input() *internalinput.State {
+	if u.inputState == nil {
+		u.inputState = &internalinput.State{}
+	}
+	return u.inputState
+}
+
+func (u *UI) inputLayerStack() *input.LayerStack {
+	if u.layerStack == nil {
+		u.layerStack = &input.LayerStack{}
+	}
+	return u.layerStack
+}
+
+// handleFocus moves the focus to the focusable widget that has been clicked, or to the next or previous
+// focusable widget in u.Container when input.ActionNext or input.ActionPrevious is pressed. Focusable
+// widgets nested inside other focusable widgets are skipped when moving the focus that way.
 func (u *UI) handleFocus() {
 	if input.MouseButtonJustPressed(ebiten.MouseButtonLeft) {
-		if u.focusedWidget != nil {
-			u.focusedWidget.(widget.Focuser).Focus(false)
-			u.focusedWidget = nil
+		x, y := input.CursorPosition()
+		u.setFocusedWidget(u.Container.WidgetAt(x, y))
+		return
+	}
+
+	switch {
+	case u.actionJustPressed(input.ActionNext):
+		u.focusAdjacent(1)
+	case u.actionJustPressed(input.ActionPrevious):
+		u.focusAdjacent(-1)
+	}
+}
+
+// setFocusedWidget focuses w if it is a widget.Focuser, removing the focus from the currently focused widget.
+func (u *UI) setFocusedWidget(w widget.HasWidget) {
+	if u.focusedWidget != nil {
+		u.focusedWidget.(widget.Focuser).Focus(false)
+		u.focusedWidget = nil
+	}
+
+	if f, ok := w.(widget.Focuser); ok {
+		f.Focus(true)
+		u.focusedWidget = w
+	}
+}
+
+// focusAdjacent focuses the focusable widget d widgets away from the focused widget, wrapping around.
+func (u *UI) focusAdjacent(d int) {
+	fs := u.focusableWidgets()
+	if len(fs) == 0 {
+		return
+	}
+
+	i := -1
+	for fi, f := range fs {
+		if f == u.focusedWidget {
+			i = fi
+			break
 		}
+	}
 
-		x, y := input.CursorPosition()
-		w := u.Container.WidgetAt(x, y)
-		if w != nil {
-			if f, ok := w.(widget.Focuser); ok {
-				f.Focus(true)
-				u.focusedWidget = w
-			}
+	switch {
+	case i < 0 && d < 0:
+		i = len(fs) - 1
+	case i < 0:
+		i = 0
+	default:
+		i = (i + d + len(fs)) % len(fs)
+	}
+
+	u.setFocusedWidget(fs[i])
+}
+
+// focusableWidgets returns all enabled widgets in u.Container that implement widget.Focuser, in tree order.
+func (u *UI) focusableWidgets() []widget.HasWidget {
+	fs := []widget.HasWidget{}
+	widget.Walk(func(w widget.HasWidget, parents []widget.HasWidget) widget.WalkResult {
+		if _, ok := w.(widget.Focuser); !ok {
+			return widget.WalkContinue
+		}
+
+		if !w.GetWidget().Disabled {
+			fs = append(fs, w)
+		}
+
+		return widget.WalkSkipChildren
+	}, u.Container)
+	return fs
+}
+
+// actionJustPressed returns whether action a has just been pressed, unless the key chord that has been
+// pressed is consumed by the focused widget.
+func (u *UI) actionJustPressed(a input.Action) bool {
+	m := u.Container.GetWidget().ActionMap()
+	if !m.ActionJustPressed(a) {
+		return false
+	}
+
+	c, ok := u.focusedWidget.(widget.KeyConsumer)
+	if !ok {
+		return true
+	}
+
+	for _, b := range m.Bindings(a) {
+		if b.Type == input.BindingTypeKey && b.JustPressed() && c.ConsumesKeyChord(b.KeyChord) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (u *UI) fireKeyEvents() {
+	evs := u.keyRepeater.Update(timeNow())
+	if len(evs) == 0 || u.focusedWidget == nil {
+		return
+	}
+
+	w := u.focusedWidget.GetWidget()
+	m := input.CurrentModifiers()
+
+	for _, e := range evs {
+		if e.Released {
+			widget.WidgetFireKeyReleasedEvent(w, e.Key, m)
+		} else {
+			widget.WidgetFireKeyPressedEvent(w, e.Key, m, e.Repeat)
 		}
 	}
 }
@@ -143,6 +335,7 @@ func (u *UI) render(screen *ebiten.Image) {
 // AddWindow adds window w to u for rendering. It returns a function to remove w from u.
 func (u *UI) AddWindow(w *widget.Window) RemoveWindowFunc {
 	u.windows = append(u.windows, w)
+	w.SetEventQueue(u.queue())
 
 	return func() {
 		u.removeWindow(w)
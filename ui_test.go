@@ -0,0 +1,319 @@
+package ebitenui
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"github.com/blizzy78/ebitenui/event"
+	"github.com/blizzy78/ebitenui/input"
+	internalinput "github.com/blizzy78/ebitenui/internal/input"
+	"github.com/blizzy78/ebitenui/widget"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestUI_KeyEvents(t *testing.T) {
+	is := is.New(t)
+
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time {
+		return now
+	}
+	defer func() {
+		timeNow = time.Now
+	}()
+
+	pressed := []*widget.WidgetKeyPressedEventArgs{}
+	released := []ebiten.Key{}
+
+	c := widget.NewContainer(widget.ContainerOpts.WidgetOpts(
+		widget.WidgetOpts.KeyPressedHandler(func(args *widget.WidgetKeyPressedEventArgs) {
+			pressed = append(pressed, args)
+		}),
+		widget.WidgetOpts.KeyReleasedHandler(func(args *widget.WidgetKeyReleasedEventArgs) {
+			released = append(released, args.Key)
+		})))
+	b := widget.NewButton()
+	c.AddChild(b)
+	event.ExecuteDeferred()
+
+	u := &UI{
+		Container: c,
+	}
+
+	src := &input.FakeInputSource{}
+	src.SetKey(ebiten.KeyX, true)
+	keyEventsFrame(t, u, src)
+	is.Equal(len(pressed), 0)
+
+	u.focusedWidget = b
+	now = now.Add(input.KeyRepeatDelay)
+	keyEventsFrame(t, u, src)
+	is.Equal(len(pressed), 1)
+	is.Equal(pressed[0].Target, b.GetWidget())
+	is.Equal(pressed[0].Widget, c.GetWidget())
+	is.Equal(pressed[0].Key, ebiten.KeyX)
+	is.True(pressed[0].Repeat)
+
+	src.SetKey(ebiten.KeyX, false)
+	keyEventsFrame(t, u, src)
+	is.Equal(released, []ebiten.Key{ebiten.KeyX})
+}
+
+func TestUI_EventQueues(t *testing.T) {
+	is := is.New(t)
+
+	pressed := []*widget.Widget{}
+	handler := widget.WidgetOpts.KeyPressedHandler(func(args *widget.WidgetKeyPressedEventArgs) {
+		pressed = append(pressed, args.Widget)
+	})
+
+	b1 := widget.NewContainer(widget.ContainerOpts.WidgetOpts(handler))
+	u1 := &UI{
+		Container: widget.NewContainer(),
+	}
+	u1.Container.AddChild(b1)
+
+	b2 := widget.NewContainer(widget.ContainerOpts.WidgetOpts(handler))
+	u2 := &UI{
+		Container: widget.NewContainer(),
+	}
+	u2.Container.AddChild(b2)
+
+	event.ExecuteDeferred()
+
+	screen := ebiten.NewImage(1, 1)
+	u1.Draw(screen)
+	u2.Draw(screen)
+
+	widget.WidgetFireKeyPressedEvent(b1.GetWidget(), ebiten.KeyA, 0, false)
+	widget.WidgetFireKeyPressedEvent(b2.GetWidget(), ebiten.KeyA, 0, false)
+	event.ExecuteDeferred()
+	is.Equal(len(pressed), 0)
+
+	u2.Draw(screen)
+	is.Equal(pressed, []*widget.Widget{b2.GetWidget()})
+
+	u1.Draw(screen)
+	is.Equal(pressed, []*widget.Widget{b2.GetWidget(), b1.GetWidget()})
+}
+
+func TestUI_InputStates(t *testing.T) {
+	is := is.New(t)
+
+	r1 := newInputRecorder()
+	src1 := &input.FakeInputSource{}
+	u1 := &UI{
+		Container:   widget.NewContainer(),
+		InputSource: src1,
+	}
+	u1.Container.AddChild(r1)
+
+	r2 := newInputRecorder()
+	src2 := &input.FakeInputSource{}
+	u2 := &UI{
+		Container:   widget.NewContainer(),
+		InputSource: src2,
+	}
+	u2.Container.AddChild(r2)
+
+	src1.SetMouseButton(ebiten.MouseButtonLeft, true)
+	src1.Type("a")
+	src2.SetMouseButton(ebiten.MouseButtonLeft, true)
+	src2.Type("b")
+
+	screen := ebiten.NewImage(1, 1)
+	u1.Update()
+	u2.Update()
+	u1.Draw(screen)
+	u2.Draw(screen)
+
+	is.True(r1.clicked)
+	is.Equal(r1.chars, "a")
+	is.True(r2.clicked)
+	is.Equal(r2.chars, "b")
+}
+
+func TestUI_InputOutsideUpdate(t *testing.T) {
+	is := is.New(t)
+
+	defaultState := internalinput.DefaultState
+	t.Cleanup(func() {
+		internalinput.DefaultState = defaultState
+	})
+
+	src1 := &input.FakeInputSource{}
+	u1 := &UI{
+		Container:   widget.NewContainer(),
+		InputSource: src1,
+	}
+
+	src2 := &input.FakeInputSource{}
+	u2 := &UI{
+		Container:   widget.NewContainer(),
+		InputSource: src2,
+	}
+
+	src1.SetMouseButton(ebiten.MouseButtonLeft, true)
+	src1.SetCursorPosition(12, 34)
+	src1.SetKey(ebiten.KeyA, true)
+	src2.SetCursorPosition(56, 78)
+
+	u1.Update()
+
+	is.True(input.MouseButtonPressed(ebiten.MouseButtonLeft))
+	is.True(input.KeyPressed(ebiten.KeyA))
+	x, y := input.CursorPosition()
+	is.Equal(x, 12)
+	is.Equal(y, 34)
+
+	u2.Update()
+
+	is.True(!input.MouseButtonPressed(ebiten.MouseButtonLeft))
+	x, y = input.CursorPosition()
+	is.Equal(x, 56)
+	is.Equal(y, 78)
+}
+
+func TestUI_GamepadTriggerAxes(t *testing.T) {
+	is := is.New(t)
+
+	defaultState := internalinput.DefaultState
+	t.Cleanup(func() {
+		internalinput.DefaultState = defaultState
+	})
+
+	src := &input.FakeInputSource{}
+	src.SetGamepads(input.Gamepad{ID: 0, Axes: []float64{0, 0, -1, 1, -1, 1}})
+
+	u := &UI{
+		Container:   widget.NewContainer(),
+		InputSource: src,
+	}
+
+	u.Update()
+	is.Equal(input.GamepadTriggerScroll(), 1.0)
+
+	u.GamepadTriggerAxes = &[2]int{3, 2}
+	u.Update()
+	is.Equal(input.GamepadTriggerScroll(), -1.0)
+
+	u.GamepadTriggerAxes = &[2]int{-1, -1}
+	u.Update()
+	is.Equal(input.GamepadTriggerScroll(), 0.0)
+}
+
+// inputRecorder is a widget that records the mouse clicks and typed characters it sees while rendering.
+type inputRecorder struct {
+	widget  *widget.Widget
+	clicked bool
+	chars   string
+}
+
+func newInputRecorder() *inputRecorder {
+	return &inputRecorder{
+		widget: widget.NewWidget(),
+	}
+}
+
+func (r *inputRecorder) GetWidget() *widget.Widget {
+	return r.widget
+}
+
+func (r *inputRecorder) PreferredSize() (int, int) {
+	return 10, 10
+}
+
+func (r *inputRecorder) SetLocation(rect image.Rectangle) {
+	r.widget.Rect = rect
+}
+
+func (r *inputRecorder) Render(screen *ebiten.Image, def widget.DeferredRenderFunc) {
+	r.clicked = r.clicked || input.MouseButtonJustPressed(ebiten.MouseButtonLeft)
+	r.chars += string(input.InputChars())
+}
+
+func TestUI_FocusNext(t *testing.T) {
+	is := is.New(t)
+
+	b1 := widget.NewButton()
+	b2 := widget.NewButton(widget.ButtonOpts.WidgetOpts(func(w *widget.Widget) {
+		w.Disabled = true
+	}))
+	b3 := widget.NewButton()
+
+	c := widget.NewContainer()
+	c.AddChild(b1)
+	c.AddChild(b2)
+	c.AddChild(b3)
+	event.ExecuteDeferred()
+
+	u := &UI{
+		Container: c,
+	}
+
+	focusFrame(t, u, ebiten.KeyTab)
+	is.Equal(u.focusedWidget, b1)
+
+	focusFrame(t, u, ebiten.KeyTab)
+	is.Equal(u.focusedWidget, b3)
+
+	focusFrame(t, u, ebiten.KeyTab)
+	is.Equal(u.focusedWidget, b1)
+
+	focusFrame(t, u, ebiten.KeyShift, ebiten.KeyTab)
+	is.Equal(u.focusedWidget, b3)
+}
+
+func TestUI_FocusNext_KeyConsumer(t *testing.T) {
+	is := is.New(t)
+
+	w := &keyConsumerWidget{
+		widget:   widget.NewWidget(),
+		consumes: true,
+	}
+	b := widget.NewButton()
+
+	c := widget.NewContainer()
+	c.AddChild(b)
+	event.ExecuteDeferred()
+
+	u := &UI{
+		Container: c,
+	}
+	u.focusedWidget = w
+
+	focusFrame(t, u, ebiten.KeyTab)
+	is.Equal(u.focusedWidget, w)
+}
+
+// focusFrame simulates the user pressing keys ks, then releasing them, and lets u handle its focus.
+func focusFrame(t *testing.T, u *UI, ks ...ebiten.Key) {
+	t.Helper()
+
+	src := &input.FakeInputSource{}
+	for _, k := range ks {
+		src.SetKey(k, true)
+	}
+
+	internalinput.Update(src)
+	internalinput.Draw()
+	u.handleFocus()
+	event.ExecuteDeferred()
+
+	internalinput.Update(&input.FakeInputSource{})
+	internalinput.Draw()
+}
+
+// keyEventsFrame makes the input system read the current state of src for a single frame, and lets u
+// fire key events.
+func keyEventsFrame(t *testing.T, u *UI, src *input.FakeInputSource) {
+	t.Helper()
+
+	internalinput.Update(src)
+	internalinput.Draw()
+	u.fireKeyEvents()
+	event.ExecuteDeferred()
+}
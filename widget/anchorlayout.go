@@ -1,9 +1,12 @@
 package widget
 
-import "image"
+import (
+	"image"
+	"math"
+)
 
-// AnchorLayout layouts a single widget anchored to either a corner or edge of a rectangle,
-// optionally stretching it in one or both directions.
+// AnchorLayout layouts a single widget anchored to either a corner or edge of a rectangle, or to a
+// position specified in percent of the rectangle's size, optionally stretching it in one or both directions.
 //
 // AnchorLayout will only layout the first widget in a container and ignore all other widgets.
 //
@@ -34,6 +37,34 @@ type AnchorLayoutData struct {
 
 	// StretchVertical specifies whether to stretch in the vertical direction.
 	StretchVertical bool
+
+	// Padding specifies pixel offsets from each edge of the layout's rectangle. Anchoring positions,
+	// stretching and percentages are applied to the rectangle after the offsets have been applied.
+	Padding Insets
+
+	// HorizontalPercent specifies the horizontal anchoring position in percent of the width, from left
+	// to right. It is only used if HorizontalPosition is AnchorLayoutPositionPercent.
+	HorizontalPercent float64
+
+	// VerticalPercent specifies the vertical anchoring position in percent of the height, from top
+	// to bottom. It is only used if VerticalPosition is AnchorLayoutPositionPercent.
+	VerticalPercent float64
+
+	// WidthPercent specifies the width in percent of the available width. If it is 0, the widget's
+	// preferred width is used. It is ignored if StretchHorizontal is true.
+	WidthPercent float64
+
+	// HeightPercent specifies the height in percent of the available height. If it is 0, the widget's
+	// preferred height is used. It is ignored if StretchVertical is true.
+	HeightPercent float64
+
+	// PivotX specifies the horizontal pivot point in percent of the widget's width. The pivot point is
+	// placed at the anchoring position. It is only used if HorizontalPosition is AnchorLayoutPositionPercent.
+	PivotX float64
+
+	// PivotY specifies the vertical pivot point in percent of the widget's height. The pivot point is
+	// placed at the anchoring position. It is only used if VerticalPosition is AnchorLayoutPositionPercent.
+	PivotY float64
 }
 
 const (
@@ -45,6 +76,10 @@ const (
 
 	// AnchorLayoutPositionEnd is the anchoring position for "right" (in the horizontal direction) or "bottom" (in the vertical direction.)
 	AnchorLayoutPositionEnd
+
+	// AnchorLayoutPositionPercent is the anchoring position specified by AnchorLayoutData.HorizontalPercent
+	// or AnchorLayoutData.VerticalPercent, respectively.
+	AnchorLayoutPositionPercent
 )
 
 // AnchorLayoutOpts contains functions that configure an AnchorLayout.
@@ -77,9 +112,35 @@ func (a *AnchorLayout) PreferredSize(widgets []PreferredSizeLocateableWidget) (i
 	}
 
 	w, h := widgets[0].PreferredSize()
+
+	if ald, ok := widgets[0].GetWidget().LayoutData.(AnchorLayoutData); ok {
+		px += ald.Padding.Dx()
+		py += ald.Padding.Dy()
+	}
+
 	return w + px, h + py
 }
 
+// PreferredHeightForWidth implements HeightForWidthLayouter.
+func (a *AnchorLayout) PreferredHeightForWidth(widgets []PreferredSizeLocateableWidget, width int) int {
+	py := a.padding.Dy()
+
+	if len(widgets) == 0 {
+		return py
+	}
+
+	widget := widgets[0]
+	ww, wh := widget.PreferredSize()
+	width -= a.padding.Dx()
+
+	if ald, ok := widget.GetWidget().LayoutData.(AnchorLayoutData); ok {
+		py += ald.Padding.Dy()
+		_, wh = a.applyWidth(widget, ald, ww, wh, width-ald.Padding.Dx())
+	}
+
+	return wh + py
+}
+
 // Layout implements Layouter.
 func (a *AnchorLayout) Layout(widgets []PreferredSizeLocateableWidget, rect image.Rectangle) {
 	if len(widgets) == 0 {
@@ -93,7 +154,8 @@ func (a *AnchorLayout) Layout(widgets []PreferredSizeLocateableWidget, rect imag
 	wy := 0
 
 	if ald, ok := widget.GetWidget().LayoutData.(AnchorLayoutData); ok {
-		wx, wy, ww, wh = a.applyLayoutData(ald, wx, wy, ww, wh, rect)
+		rect = ald.Padding.Apply(rect)
+		wx, wy, ww, wh = a.applyLayoutData(widget, ald, wx, wy, ww, wh, rect)
 	}
 
 	r := image.Rect(0, 0, ww, wh)
@@ -103,9 +165,11 @@ func (a *AnchorLayout) Layout(widgets []PreferredSizeLocateableWidget, rect imag
 	widget.SetLocation(r)
 }
 
-func (a *AnchorLayout) applyLayoutData(ld AnchorLayoutData, wx int, wy int, ww int, wh int, rect image.Rectangle) (int, int, int, int) {
-	if ld.StretchHorizontal {
-		ww = rect.Dx()
+func (a *AnchorLayout) applyLayoutData(w PreferredSizeLocateableWidget, ld AnchorLayoutData, wx int, wy int, ww int, wh int, rect image.Rectangle) (int, int, int, int) {
+	ww, wh = a.applyWidth(w, ld, ww, wh, rect.Dx())
+
+	if ld.HeightPercent > 0 {
+		wh = percentOf(rect.Dy(), ld.HeightPercent)
 	}
 
 	if ld.StretchVertical {
@@ -120,6 +184,8 @@ func (a *AnchorLayout) applyLayoutData(ld AnchorLayoutData, wx int, wy int, ww i
 		wx = (rect.Dx() - ww) / 2
 	case AnchorLayoutPositionEnd:
 		wx = rect.Dx() - ww
+	case AnchorLayoutPositionPercent:
+		wx = percentOf(rect.Dx(), ld.HorizontalPercent) - percentOf(ww, ld.PivotX)
 	}
 
 	switch vPos {
@@ -127,7 +193,31 @@ func (a *AnchorLayout) applyLayoutData(ld AnchorLayoutData, wx int, wy int, ww i
 		wy = (rect.Dy() - wh) / 2
 	case AnchorLayoutPositionEnd:
 		wy = rect.Dy() - wh
+	case AnchorLayoutPositionPercent:
+		wy = percentOf(rect.Dy(), ld.VerticalPercent) - percentOf(wh, ld.PivotY)
 	}
 
 	return wx, wy, ww, wh
 }
+
+func (a *AnchorLayout) applyWidth(w PreferredSizeLocateableWidget, ld AnchorLayoutData, ww int, wh int, width int) (int, int) {
+	pw := ww
+
+	if ld.WidthPercent > 0 {
+		ww = percentOf(width, ld.WidthPercent)
+	}
+
+	if ld.StretchHorizontal {
+		ww = width
+	}
+
+	if ww != pw {
+		wh = PreferredHeightForWidth(w, ww)
+	}
+
+	return ww, wh
+}
+
+func percentOf(v int, p float64) int {
+	return int(math.Round(float64(v) * p / 100))
+}
@@ -2,6 +2,7 @@ package widget
 
 import (
 	"image"
+	"math"
 	"strconv"
 	"testing"
 
@@ -28,6 +29,27 @@ func TestAnchorLayout_PreferredSize(t *testing.T) {
 	is.Equal(h, wi.preferredHeight+padding.Dy())
 }
 
+func TestAnchorLayout_PreferredSize_LayoutDataPadding(t *testing.T) {
+	is := is.New(t)
+
+	padding := NewInsetsSimple(10)
+	ldPadding := Insets{
+		Top:   5,
+		Right: 15,
+	}
+
+	l := newAnchorLayout(t, AnchorLayoutOpts.Padding(padding))
+
+	wi := newSimpleWidget(35, 45, AnchorLayoutData{
+		Padding: ldPadding,
+	})
+
+	w, h := l.PreferredSize([]PreferredSizeLocateableWidget{wi})
+
+	is.Equal(w, wi.preferredWidth+padding.Dx()+ldPadding.Dx())
+	is.Equal(h, wi.preferredHeight+padding.Dy()+ldPadding.Dy())
+}
+
 func TestAnchorLayout_Layout(t *testing.T) {
 	ww, wh := 25, 35
 	wrect := image.Rect(0, 0, ww, wh)
@@ -87,6 +109,52 @@ func TestAnchorLayout_Layout(t *testing.T) {
 			},
 			image.Rect(prect.Min.X, prect.Min.Y+(prect.Dy()-wh)/2, prect.Max.X, prect.Min.Y+(prect.Dy()-wh)/2+wh),
 		},
+		{
+			AnchorLayoutData{
+				HorizontalPosition: AnchorLayoutPositionEnd,
+				VerticalPosition:   AnchorLayoutPositionStart,
+				Padding: Insets{
+					Top:   10,
+					Right: 10,
+				},
+			},
+			wrect.Add(image.Point{prect.Max.X - 10 - ww, prect.Min.Y + 10}),
+		},
+		{
+			AnchorLayoutData{
+				StretchHorizontal: true,
+				StretchVertical:   true,
+				Padding:           NewInsetsSimple(5),
+			},
+			NewInsetsSimple(5).Apply(prect),
+		},
+		{
+			AnchorLayoutData{
+				HorizontalPosition: AnchorLayoutPositionPercent,
+				VerticalPosition:   AnchorLayoutPositionPercent,
+				HorizontalPercent:  50,
+				VerticalPercent:    25,
+			},
+			wrect.Add(prect.Min).Add(image.Point{int(math.Round(float64(prect.Dx()) * 0.5)), int(math.Round(float64(prect.Dy()) * 0.25))}),
+		},
+		{
+			AnchorLayoutData{
+				HorizontalPosition: AnchorLayoutPositionPercent,
+				VerticalPosition:   AnchorLayoutPositionPercent,
+				HorizontalPercent:  100,
+				VerticalPercent:    100,
+				PivotX:             100,
+				PivotY:             100,
+			},
+			wrect.Add(prect.Max).Sub(image.Point{ww, wh}),
+		},
+		{
+			AnchorLayoutData{
+				WidthPercent:  50,
+				HeightPercent: 25,
+			},
+			image.Rect(0, 0, int(math.Round(float64(prect.Dx())*0.5)), int(math.Round(float64(prect.Dy())*0.25))).Add(prect.Min),
+		},
 	}
 
 	for i, test := range tests {
@@ -103,6 +171,34 @@ func TestAnchorLayout_Layout(t *testing.T) {
 	}
 }
 
+func TestAnchorLayout_PreferredHeightForWidth(t *testing.T) {
+	is := is.New(t)
+
+	l := NewAnchorLayout(AnchorLayoutOpts.Padding(NewInsetsSimple(10)))
+
+	wi := newHeightForWidthWidget(20, 40, AnchorLayoutData{
+		StretchHorizontal: true,
+	})
+
+	h := l.PreferredHeightForWidth([]PreferredSizeLocateableWidget{wi}, 60)
+
+	is.Equal(h, 20+20)
+}
+
+func TestAnchorLayout_Layout_HeightForWidth(t *testing.T) {
+	is := is.New(t)
+
+	l := NewAnchorLayout()
+
+	wi := newHeightForWidthWidget(20, 40, AnchorLayoutData{
+		StretchHorizontal: true,
+	})
+
+	l.Layout([]PreferredSizeLocateableWidget{wi}, image.Rect(0, 0, 80, 200))
+
+	is.Equal(wi.GetWidget().Rect, image.Rect(0, 0, 80, 10))
+}
+
 func newAnchorLayout(t *testing.T, opts ...AnchorLayoutOpt) Layouter {
 	t.Helper()
 	l := NewAnchorLayout(opts...)
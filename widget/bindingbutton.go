@@ -0,0 +1,352 @@
+package widget
+
+import (
+	"image"
+
+	"github.com/blizzy78/ebitenui/event"
+	"github.com/blizzy78/ebitenui/input"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// BindingButton is a button that shows an input binding, such as "Ctrl+S". When clicked, it captures
+// the next key chord, mouse button, or gamepad button the user presses as its new binding. Pressing a
+// binding of input.ActionCancel, Escape by default, cancels the capture.
+//
+// The button must be configured with a text label using ButtonOpts.Text, which is then updated
+// automatically.
+type BindingButton struct {
+	ChangedEvent  *event.Of[*BindingButtonChangedEventArgs]
+	ConflictEvent *event.Of[*BindingButtonConflictEventArgs]
+
+	buttonOpts   []ButtonOpt
+	noneText     string
+	captureText  string
+	conflictFunc BindingButtonConflictFunc
+	actions      *input.ActionMap
+
+	init         *MultiOnce
+	button       *Button
+	binding      input.Binding
+	bound        bool
+	capturing    bool
+	captureEnded bool
+	captureLayer *input.Layer
+}
+
+type BindingButtonOpt func(b *BindingButton)
+
+type BindingButtonOptions struct {
+}
+
+type BindingButtonChangedEventArgs struct {
+	BindingButton *BindingButton
+	Binding       input.Binding
+}
+
+type BindingButtonConflictEventArgs struct {
+	BindingButton *BindingButton
+	Binding       input.Binding
+}
+
+type BindingButtonChangedHandlerFunc func(args *BindingButtonChangedEventArgs)
+
+type BindingButtonConflictHandlerFunc func(args *BindingButtonConflictEventArgs)
+
+// BindingButtonConflictFunc reports whether binding conflicts with other bindings. Conflicting bindings
+// are rejected while capturing, and the button keeps capturing.
+type BindingButtonConflictFunc func(b *BindingButton, binding input.Binding) bool
+
+var BindingButtonOpts BindingButtonOptions
+
+var bindingButtonMouseButtons = []ebiten.MouseButton{ebiten.MouseButtonLeft, ebiten.MouseButtonMiddle, ebiten.MouseButtonRight}
+
+func NewBindingButton(opts ...BindingButtonOpt) *BindingButton {
+	b := &BindingButton{
+		ChangedEvent:  &event.Of[*BindingButtonChangedEventArgs]{},
+		ConflictEvent: &event.Of[*BindingButtonConflictEventArgs]{},
+
+		noneText:    "None",
+		captureText: "Press any key...",
+
+		init: &MultiOnce{},
+	}
+
+	b.init.Append(b.createWidget)
+
+	for _, o := range opts {
+		o(b)
+	}
+
+	return b
+}
+
+func (o BindingButtonOptions) ButtonOpts(opts ...ButtonOpt) BindingButtonOpt {
+	return func(b *BindingButton) {
+		b.buttonOpts = append(b.buttonOpts, opts...)
+	}
+}
+
+// Binding configures a binding button to initially show binding bi.
+func (o BindingButtonOptions) Binding(bi input.Binding) BindingButtonOpt {
+	return func(b *BindingButton) {
+		b.binding = bi
+		b.bound = true
+	}
+}
+
+// NoneText configures the text shown while a binding button has no binding. The default is "None".
+func (o BindingButtonOptions) NoneText(s string) BindingButtonOpt {
+	return func(b *BindingButton) {
+		b.noneText = s
+	}
+}
+
+// CaptureText configures the text shown while a binding button is capturing. The default is "Press any key...".
+func (o BindingButtonOptions) CaptureText(s string) BindingButtonOpt {
+	return func(b *BindingButton) {
+		b.captureText = s
+	}
+}
+
+// ConflictFunc configures a binding button to reject captured bindings for which f reports a conflict.
+// When a binding is rejected, ConflictEvent is fired.
+func (o BindingButtonOptions) ConflictFunc(f BindingButtonConflictFunc) BindingButtonOpt {
+	return func(b *BindingButton) {
+		b.conflictFunc = f
+	}
+}
+
+// ActionMap configures a binding button to use action map m to determine the bindings that cancel capturing.
+// By default, the widget's action map is used, see Widget.ActionMap.
+func (o BindingButtonOptions) ActionMap(m *input.ActionMap) BindingButtonOpt {
+	return func(b *BindingButton) {
+		b.actions = m
+	}
+}
+
+func (o BindingButtonOptions) ChangedHandler(f BindingButtonChangedHandlerFunc) BindingButtonOpt {
+	return func(b *BindingButton) {
+		b.ChangedEvent.AddHandler(f)
+	}
+}
+
+func (o BindingButtonOptions) ConflictHandler(f BindingButtonConflictHandlerFunc) BindingButtonOpt {
+	return func(b *BindingButton) {
+		b.ConflictEvent.AddHandler(f)
+	}
+}
+
+// ActionMapConflictFunc returns a BindingButtonConflictFunc that reports a conflict if a binding is
+// bound to any action in m other than a.
+func ActionMapConflictFunc(m *input.ActionMap, a input.Action) BindingButtonConflictFunc {
+	return func(b *BindingButton, binding input.Binding) bool {
+		for _, ba := range m.ActionsBoundTo(binding) {
+			if ba != a {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func (b *BindingButton) GetWidget() *Widget {
+	b.init.Do()
+	return b.button.GetWidget()
+}
+
+// Children implements Composite.
+func (b *BindingButton) Children() []HasWidget {
+	b.init.Do()
+	return b.button.Children()
+}
+
+func (b *BindingButton) PreferredSize() (int, int) {
+	b.init.Do()
+	return b.button.PreferredSize()
+}
+
+func (b *BindingButton) SetLocation(rect image.Rectangle) {
+	b.init.Do()
+	b.button.SetLocation(rect)
+}
+
+func (b *BindingButton) RequestRelayout() {
+	b.init.Do()
+	b.button.RequestRelayout()
+}
+
+func (b *BindingButton) SetupInputLayer(def input.DeferredSetupInputLayerFunc) {
+	b.init.Do()
+
+	b.button.SetupInputLayer(def)
+
+	if b.capturing {
+		def(func(def input.DeferredSetupInputLayerFunc) {
+			b.captureLayer = &input.Layer{
+				DebugLabel: "binding button capture",
+				EventTypes: input.LayerEventTypeAll,
+				BlockLower: true,
+				FullScreen: true,
+			}
+			input.AddLayer(b.captureLayer)
+		})
+	}
+}
+
+func (b *BindingButton) Render(screen *ebiten.Image, def DeferredRenderFunc) {
+	b.init.Do()
+
+	b.captureEnded = false
+	if b.capturing {
+		b.capture()
+		b.captureEnded = !b.capturing
+	}
+
+	if t := b.button.Text(); t != nil {
+		t.Label = b.label()
+	}
+
+	b.button.Render(screen, def)
+}
+
+// Focus implements Focuser. While focused, b starts capturing when input.ActionConfirm is pressed.
+func (b *BindingButton) Focus(focused bool) {
+	b.init.Do()
+	b.button.Focus(focused)
+}
+
+// ConsumesKeyChord implements KeyConsumer. While capturing, b consumes all key chords.
+func (b *BindingButton) ConsumesKeyChord(c input.KeyChord) bool {
+	return b.capturing
+}
+
+func (b *BindingButton) capture() {
+	if b.button.GetWidget().Disabled || b.actionMap().ActionJustPressed(input.ActionCancel) {
+		b.capturing = false
+		return
+	}
+
+	for k := ebiten.Key(0); k <= ebiten.KeyMax; k++ {
+		if input.ModifierKey(k) || !input.KeyJustPressed(k) {
+			continue
+		}
+
+		b.captured(input.KeyBinding(k, input.CurrentModifiers()))
+		return
+	}
+
+	for _, id := range input.GamepadIDs() {
+		for gb := ebiten.GamepadButton(0); gb <= ebiten.GamepadButtonMax; gb++ {
+			if input.GamepadButtonJustPressed(id, gb) {
+				b.captured(input.GamepadButtonBinding(gb))
+				return
+			}
+		}
+	}
+
+	if b.captureLayer == nil {
+		return
+	}
+
+	for _, mb := range bindingButtonMouseButtons {
+		if input.MouseButtonJustPressedLayer(mb, b.captureLayer) {
+			b.captured(input.MouseButtonBinding(mb))
+			return
+		}
+	}
+}
+
+func (b *BindingButton) actionMap() *input.ActionMap {
+	if b.actions != nil {
+		return b.actions
+	}
+	return b.button.GetWidget().ActionMap()
+}
+
+func (b *BindingButton) captured(bi input.Binding) {
+	if b.conflictFunc != nil && b.conflictFunc(b, bi) {
+		b.ConflictEvent.Fire(&BindingButtonConflictEventArgs{
+			BindingButton: b,
+			Binding:       bi,
+		})
+
+		return
+	}
+
+	b.capturing = false
+	b.SetBinding(bi)
+}
+
+func (b *BindingButton) label() string {
+	switch {
+	case b.capturing:
+		return b.captureText
+	case b.bound:
+		return b.binding.String()
+	default:
+		return b.noneText
+	}
+}
+
+// Binding returns the current binding, and whether there is one.
+func (b *BindingButton) Binding() (input.Binding, bool) {
+	return b.binding, b.bound
+}
+
+// SetBinding sets the current binding to bi, firing ChangedEvent if it has changed.
+func (b *BindingButton) SetBinding(bi input.Binding) {
+	if b.bound && bi == b.binding {
+		return
+	}
+
+	b.binding = bi
+	b.bound = true
+
+	b.ChangedEvent.Fire(&BindingButtonChangedEventArgs{
+		BindingButton: b,
+		Binding:       bi,
+	})
+}
+
+// Capturing returns whether b is currently capturing a new binding.
+func (b *BindingButton) Capturing() bool {
+	return b.capturing
+}
+
+// StartCapture makes b capture the next key chord, mouse button, or gamepad button the user presses as its
+// new binding.
+func (b *BindingButton) StartCapture() {
+	b.capturing = true
+	b.captureLayer = nil
+}
+
+// CancelCapture stops capturing, keeping the current binding.
+func (b *BindingButton) CancelCapture() {
+	b.capturing = false
+}
+
+func (b *BindingButton) createWidget() {
+	b.button = NewButton(append(b.buttonOpts, []ButtonOpt{
+		ButtonOpts.ClickedHandler(func(args *ButtonClickedEventArgs) {
+			// the binding that has just ended capturing may also be bound to input.ActionConfirm
+			if !b.captureEnded {
+				b.StartCapture()
+			}
+		}),
+	}...)...)
+	b.buttonOpts = nil
+
+	b.button.themeSections = []func(t *Theme) *ButtonTheme{
+		func(t *Theme) *ButtonTheme {
+			if t.BindingButton == nil {
+				return nil
+			}
+			return t.BindingButton.Button
+		},
+	}
+
+	setEventQueueFunc(b.button.GetWidget(), b.ChangedEvent, b.ConflictEvent)
+
+	b.button.GetWidget().owner = b
+}
@@ -0,0 +1,206 @@
+package widget
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/blizzy78/ebitenui/event"
+	"github.com/blizzy78/ebitenui/input"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestBindingButton_CaptureKey(t *testing.T) {
+	is := is.New(t)
+
+	var eventArgs *BindingButtonChangedEventArgs
+
+	b := newBindingButton(t,
+		BindingButtonOpts.ChangedHandler(func(args *BindingButtonChangedEventArgs) {
+			eventArgs = args
+		}))
+
+	is.Equal(b.button.Text().Label, "None")
+
+	leftMouseButtonClick(b, t)
+	render(b, t)
+	is.True(b.Capturing())
+	is.Equal(b.button.Text().Label, "Press any key...")
+
+	fakeInput.SetKey(ebiten.KeyControl, true)
+	bindingButtonFrame(t, b)
+	is.True(b.Capturing())
+
+	fakeInput.SetKey(ebiten.KeyS, true)
+	bindingButtonFrame(t, b)
+	fakeInput.SetKey(ebiten.KeyS, false)
+	fakeInput.SetKey(ebiten.KeyControl, false)
+	inputFrame(t)
+
+	is.True(!b.Capturing())
+	is.Equal(eventArgs.Binding, input.KeyBinding(ebiten.KeyS, input.ModifierControl))
+	bi, ok := b.Binding()
+	is.True(ok)
+	is.Equal(bi, eventArgs.Binding)
+	is.Equal(b.button.Text().Label, "Ctrl+S")
+}
+
+func TestBindingButton_CaptureKey_ActionConfirm(t *testing.T) {
+	is := is.New(t)
+
+	b := newBindingButton(t)
+	b.Focus(true)
+
+	keyInput(b, ebiten.KeyEnter, t)
+	is.True(b.Capturing())
+
+	keyInput(b, ebiten.KeyEnter, t)
+	render(b, t)
+	is.True(!b.Capturing())
+	bi, _ := b.Binding()
+	is.Equal(bi, input.KeyBinding(ebiten.KeyEnter))
+	is.True(!b.ConsumesKeyChord(input.NewKeyChord(ebiten.KeyTab)))
+}
+
+func TestBindingButton_CaptureMouseButton(t *testing.T) {
+	is := is.New(t)
+
+	b := newBindingButton(t)
+	b.StartCapture()
+
+	bindingButtonFrame(t, b)
+
+	fakeInput.SetMouseButton(ebiten.MouseButtonRight, true)
+	bindingButtonFrame(t, b)
+	fakeInput.SetMouseButton(ebiten.MouseButtonRight, false)
+	inputFrame(t)
+
+	is.True(!b.Capturing())
+	bi, _ := b.Binding()
+	is.Equal(bi, input.MouseButtonBinding(ebiten.MouseButtonRight))
+}
+
+func TestBindingButton_CaptureGamepadButton(t *testing.T) {
+	is := is.New(t)
+
+	b := newBindingButton(t)
+	b.StartCapture()
+
+	gamepadInput(t, input.Gamepad{ID: 1})
+	render(b, t)
+	is.True(b.Capturing())
+
+	gamepadInput(t, input.Gamepad{ID: 1, Buttons: []ebiten.GamepadButton{ebiten.GamepadButton2}})
+	render(b, t)
+	gamepadInput(t)
+
+	is.True(!b.Capturing())
+	bi, _ := b.Binding()
+	is.Equal(bi, input.GamepadButtonBinding(ebiten.GamepadButton2))
+	is.Equal(b.button.Text().Label, "Gamepad2")
+}
+
+func TestBindingButton_Cancel(t *testing.T) {
+	is := is.New(t)
+
+	numEvents := 0
+
+	b := newBindingButton(t,
+		BindingButtonOpts.Binding(input.KeyBinding(ebiten.KeyA)),
+		BindingButtonOpts.ChangedHandler(func(args *BindingButtonChangedEventArgs) {
+			numEvents++
+		}))
+	b.StartCapture()
+
+	fakeInput.SetKey(ebiten.KeyEscape, true)
+	bindingButtonFrame(t, b)
+	fakeInput.SetKey(ebiten.KeyEscape, false)
+	inputFrame(t)
+
+	is.True(!b.Capturing())
+	is.Equal(numEvents, 0)
+	bi, _ := b.Binding()
+	is.Equal(bi, input.KeyBinding(ebiten.KeyA))
+}
+
+func TestBindingButton_Cancel_ActionMap(t *testing.T) {
+	is := is.New(t)
+
+	m := input.NewActionMap()
+	m.Bind(input.ActionCancel, input.KeyBinding(ebiten.KeyF2))
+
+	b := newBindingButton(t, BindingButtonOpts.ActionMap(m))
+	b.StartCapture()
+
+	fakeInput.SetKey(ebiten.KeyF2, true)
+	bindingButtonFrame(t, b)
+	fakeInput.SetKey(ebiten.KeyF2, false)
+	inputFrame(t)
+
+	is.True(!b.Capturing())
+	_, ok := b.Binding()
+	is.True(!ok)
+}
+
+func TestBindingButton_Conflict(t *testing.T) {
+	is := is.New(t)
+
+	m := input.NewActionMap()
+	m.Bind("jump", input.KeyBinding(ebiten.KeySpace))
+	m.Bind("fire", input.KeyBinding(ebiten.KeyF))
+
+	var conflictArgs *BindingButtonConflictEventArgs
+
+	b := newBindingButton(t,
+		BindingButtonOpts.ConflictFunc(ActionMapConflictFunc(m, "fire")),
+		BindingButtonOpts.ConflictHandler(func(args *BindingButtonConflictEventArgs) {
+			conflictArgs = args
+		}))
+	b.StartCapture()
+
+	fakeInput.SetKey(ebiten.KeySpace, true)
+	bindingButtonFrame(t, b)
+	fakeInput.SetKey(ebiten.KeySpace, false)
+	inputFrame(t)
+
+	is.True(b.Capturing())
+	is.Equal(conflictArgs.Binding, input.KeyBinding(ebiten.KeySpace))
+
+	fakeInput.SetKey(ebiten.KeyF, true)
+	bindingButtonFrame(t, b)
+	fakeInput.SetKey(ebiten.KeyF, false)
+	inputFrame(t)
+
+	is.True(!b.Capturing())
+	bi, _ := b.Binding()
+	is.Equal(bi, input.KeyBinding(ebiten.KeyF))
+}
+
+// bindingButtonFrame simulates a single frame of user input, the UI setting up input layers, and b rendering.
+func bindingButtonFrame(t *testing.T, b *BindingButton) {
+	t.Helper()
+
+	inputFrame(t)
+	input.SetupInputLayersWithDeferred([]input.Layerer{b})
+	t.Cleanup(func() {
+		input.SetupInputLayersWithDeferred(nil)
+	})
+	render(b, t)
+}
+
+func newBindingButton(t *testing.T, opts ...BindingButtonOpt) *BindingButton {
+	t.Helper()
+
+	b := NewBindingButton(append(opts, BindingButtonOpts.ButtonOpts(
+		ButtonOpts.Image(&ButtonImage{
+			Idle: newNineSliceEmpty(t),
+		}),
+		ButtonOpts.Text("", loadFont(t), &ButtonTextColor{
+			Idle: color.White,
+		}),
+	))...)
+	event.ExecuteDeferred()
+	render(b, t)
+	return b
+}
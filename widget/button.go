@@ -18,22 +18,27 @@ type Button struct {
 	GraphicImage      *ButtonImageImage
 	TextColor         *ButtonTextColor
 
-	PressedEvent  *event.Event
-	ReleasedEvent *event.Event
-	ClickedEvent  *event.Event
+	PressedEvent  *event.Of[*ButtonPressedEventArgs]
+	ReleasedEvent *event.Of[*ButtonReleasedEventArgs]
+	ClickedEvent  *event.Of[*ButtonClickedEventArgs]
 
 	widgetOpts               []WidgetOpt
+	mouseButtons             []ebiten.MouseButton
 	autoUpdateTextAndGraphic bool
-	textPadding              Insets
+	textFace                 font.Face
+	textPadding              *Insets
 	graphicPadding           Insets
+	themeSections            []func(t *Theme) *ButtonTheme
 
-	init      *MultiOnce
-	widget    *Widget
-	container *Container
-	graphic   *Graphic
-	text      *Text
-	hovering  bool
-	pressing  bool
+	init       *MultiOnce
+	widget     *Widget
+	container  *Container
+	graphic    *Graphic
+	text       *Text
+	textLayout *AnchorLayout
+	hovering   bool
+	pressing   bool
+	focused    bool
 }
 
 type ButtonOpt func(b *Button)
@@ -56,20 +61,26 @@ type ButtonTextColor struct {
 }
 
 type ButtonPressedEventArgs struct {
-	Button  *Button
-	OffsetX int
-	OffsetY int
+	Button      *Button
+	MouseButton ebiten.MouseButton
+	OffsetX     int
+	OffsetY     int
 }
 
 type ButtonReleasedEventArgs struct {
-	Button  *Button
-	Inside  bool
-	OffsetX int
-	OffsetY int
+	Button      *Button
+	MouseButton ebiten.MouseButton
+	Inside      bool
+	OffsetX     int
+	OffsetY     int
 }
 
 type ButtonClickedEventArgs struct {
 	Button *Button
+
+	// MouseButton is the mouse button that has clicked the button. It is ebiten.MouseButtonLeft if the button
+	// has been activated using input.ActionConfirm while focused.
+	MouseButton ebiten.MouseButton
 }
 
 type ButtonPressedHandlerFunc func(args *ButtonPressedEventArgs)
@@ -85,9 +96,11 @@ var ButtonOpts ButtonOptions
 
 func NewButton(opts ...ButtonOpt) *Button {
 	b := &Button{
-		PressedEvent:  &event.Event{},
-		ReleasedEvent: &event.Event{},
-		ClickedEvent:  &event.Event{},
+		PressedEvent:  &event.Of[*ButtonPressedEventArgs]{},
+		ReleasedEvent: &event.Of[*ButtonReleasedEventArgs]{},
+		ClickedEvent:  &event.Of[*ButtonClickedEventArgs]{},
+
+		mouseButtons: []ebiten.MouseButton{ebiten.MouseButtonLeft},
 
 		init: &MultiOnce{},
 	}
@@ -98,6 +111,12 @@ func NewButton(opts ...ButtonOpt) *Button {
 		o(b)
 	}
 
+	b.init.Append(func() {
+		if b.container != nil {
+			b.container.GetWidget().parent = b.widget
+		}
+	})
+
 	return b
 }
 
@@ -107,6 +126,14 @@ func (o ButtonOptions) WidgetOpts(opts ...WidgetOpt) ButtonOpt {
 	}
 }
 
+// MouseButtons configures a button to only react to mouse buttons bs. That is, only those buttons
+// trigger PressedEvent, ReleasedEvent, and ClickedEvent. The default is ebiten.MouseButtonLeft.
+func (o ButtonOptions) MouseButtons(bs ...ebiten.MouseButton) ButtonOpt {
+	return func(b *Button) {
+		b.mouseButtons = bs
+	}
+}
+
 func (o ButtonOptions) Image(i *ButtonImage) ButtonOpt {
 	return func(b *Button) {
 		b.Image = i
@@ -116,8 +143,9 @@ func (o ButtonOptions) Image(i *ButtonImage) ButtonOpt {
 func (o ButtonOptions) TextSimpleLeft(label string, face font.Face, color *ButtonTextColor, padding Insets) ButtonOpt {
 	return func(b *Button) {
 		b.init.Append(func() {
+			b.textLayout = NewAnchorLayout()
 			b.container = NewContainer(
-				ContainerOpts.Layout(NewAnchorLayout(AnchorLayoutOpts.Padding(padding))),
+				ContainerOpts.Layout(b.textLayout),
 				ContainerOpts.AutoDisableChildren(),
 			)
 
@@ -126,12 +154,14 @@ func (o ButtonOptions) TextSimpleLeft(label string, face font.Face, color *Butto
 					HorizontalPosition: AnchorLayoutPositionStart,
 					VerticalPosition:   AnchorLayoutPositionCenter,
 				})),
-				TextOpts.Text(label, face, color.Idle),
+				TextOpts.Text(label, nil, nil),
 				TextOpts.Position(TextPositionStart, TextPositionCenter),
 			)
 			b.container.AddChild(b.text)
 
 			b.autoUpdateTextAndGraphic = true
+			b.textFace = face
+			b.textPadding = &padding
 			b.TextColor = color
 		})
 	}
@@ -140,8 +170,9 @@ func (o ButtonOptions) TextSimpleLeft(label string, face font.Face, color *Butto
 func (o ButtonOptions) Text(label string, face font.Face, color *ButtonTextColor) ButtonOpt {
 	return func(b *Button) {
 		b.init.Append(func() {
+			b.textLayout = NewAnchorLayout()
 			b.container = NewContainer(
-				ContainerOpts.Layout(NewAnchorLayout(AnchorLayoutOpts.Padding(b.textPadding))),
+				ContainerOpts.Layout(b.textLayout),
 				ContainerOpts.AutoDisableChildren(),
 			)
 
@@ -150,12 +181,13 @@ func (o ButtonOptions) Text(label string, face font.Face, color *ButtonTextColor
 					HorizontalPosition: AnchorLayoutPositionCenter,
 					VerticalPosition:   AnchorLayoutPositionCenter,
 				})),
-				TextOpts.Text(label, face, color.Idle),
+				TextOpts.Text(label, nil, nil),
 				TextOpts.Position(TextPositionCenter, TextPositionCenter),
 			)
 			b.container.AddChild(b.text)
 
 			b.autoUpdateTextAndGraphic = true
+			b.textFace = face
 			b.TextColor = color
 		})
 	}
@@ -165,8 +197,9 @@ func (o ButtonOptions) Text(label string, face font.Face, color *ButtonTextColor
 func (o ButtonOptions) TextAndImage(label string, face font.Face, image *ButtonImageImage, color *ButtonTextColor) ButtonOpt {
 	return func(b *Button) {
 		b.init.Append(func() {
+			b.textLayout = NewAnchorLayout()
 			b.container = NewContainer(
-				ContainerOpts.Layout(NewAnchorLayout(AnchorLayoutOpts.Padding(b.textPadding))),
+				ContainerOpts.Layout(b.textLayout),
 				ContainerOpts.AutoDisableChildren(),
 			)
 
@@ -184,18 +217,19 @@ func (o ButtonOptions) TextAndImage(label string, face font.Face, image *ButtonI
 				TextOpts.WidgetOpts(WidgetOpts.LayoutData(RowLayoutData{
 					Stretch: true,
 				})),
-				TextOpts.Text(label, face, color.Idle))
+				TextOpts.Text(label, nil, nil))
 			c.AddChild(b.text)
 
 			b.graphic = NewGraphic(
 				GraphicOpts.WidgetOpts(WidgetOpts.LayoutData(RowLayoutData{
 					Stretch: true,
-				})),
-				GraphicOpts.Image(image.Idle))
+				})))
+			b.graphic.buttonGraphic = true
 			c.AddChild(b.graphic)
 
 			b.autoUpdateTextAndGraphic = true
 			b.GraphicImage = image
+			b.textFace = face
 			b.TextColor = color
 		})
 	}
@@ -203,7 +237,7 @@ func (o ButtonOptions) TextAndImage(label string, face font.Face, image *ButtonI
 
 func (o ButtonOptions) TextPadding(p Insets) ButtonOpt {
 	return func(b *Button) {
-		b.textPadding = p
+		b.textPadding = &p
 	}
 }
 
@@ -229,6 +263,7 @@ func (o ButtonOptions) withGraphic(opt GraphicOpt) ButtonOpt {
 					VerticalPosition:   AnchorLayoutPositionCenter,
 				})),
 			)
+			b.graphic.buttonGraphic = true
 			b.container.AddChild(b.graphic)
 
 			b.autoUpdateTextAndGraphic = true
@@ -250,25 +285,19 @@ func (o ButtonOptions) KeepPressedOnExit() ButtonOpt {
 
 func (o ButtonOptions) PressedHandler(f ButtonPressedHandlerFunc) ButtonOpt {
 	return func(b *Button) {
-		b.PressedEvent.AddHandler(func(args interface{}) {
-			f(args.(*ButtonPressedEventArgs))
-		})
+		b.PressedEvent.AddHandler(f)
 	}
 }
 
 func (o ButtonOptions) ReleasedHandler(f ButtonReleasedHandlerFunc) ButtonOpt {
 	return func(b *Button) {
-		b.ReleasedEvent.AddHandler(func(args interface{}) {
-			f(args.(*ButtonReleasedEventArgs))
-		})
+		b.ReleasedEvent.AddHandler(f)
 	}
 }
 
 func (o ButtonOptions) ClickedHandler(f ButtonClickedHandlerFunc) ButtonOpt {
 	return func(b *Button) {
-		b.ClickedEvent.AddHandler(func(args interface{}) {
-			f(args.(*ButtonClickedEventArgs))
-		})
+		b.ClickedEvent.AddHandler(f)
 	}
 }
 
@@ -277,21 +306,34 @@ func (b *Button) GetWidget() *Widget {
 	return b.widget
 }
 
+// Children implements Composite.
+func (b *Button) Children() []HasWidget {
+	b.init.Do()
+	if b.container == nil {
+		return nil
+	}
+	return []HasWidget{b.container}
+}
+
 func (b *Button) PreferredSize() (int, int) {
 	b.init.Do()
 
+	b.applyTheme()
+
 	w, h := 50, 50
 
 	if b.container != nil && len(b.container.children) > 0 {
 		w, h = b.container.PreferredSize()
 	}
 
-	iw, ih := b.Image.Idle.MinSize()
-	if w < iw {
-		w = iw
-	}
-	if h < ih {
-		h = ih
+	if bi := b.image(); bi != nil {
+		iw, ih := bi.Idle.MinSize()
+		if w < iw {
+			w = iw
+		}
+		if h < ih {
+			h = ih
+		}
 	}
 
 	return w, h
@@ -340,45 +382,141 @@ func (b *Button) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 
 	b.widget.Render(screen, def)
 
+	b.handleActions()
+
 	b.draw(screen)
 
-	if b.autoUpdateTextAndGraphic {
-		if b.graphic != nil {
-			if b.widget.Disabled {
-				b.graphic.Image = b.GraphicImage.Disabled
-			} else {
-				b.graphic.Image = b.GraphicImage.Idle
-			}
+	b.applyTheme()
+
+	if b.container != nil {
+		b.container.Render(screen, def)
+	}
+}
+
+// Focus implements Focuser. While focused, b is activated by input.ActionConfirm.
+func (b *Button) Focus(focused bool) {
+	b.init.Do()
+	WidgetFireFocusEvent(b.widget, focused)
+	b.focused = focused
+}
+
+func (b *Button) handleActions() {
+	if !b.focused || b.widget.Disabled || !b.widget.ActionMap().ActionJustPressed(input.ActionConfirm) {
+		return
+	}
+
+	b.ClickedEvent.Fire(&ButtonClickedEventArgs{
+		Button:      b,
+		MouseButton: ebiten.MouseButtonLeft,
+	})
+}
+
+// applyTheme updates b's text and graphic according to b's state, its configuration, and its theme.
+func (b *Button) applyTheme() {
+	if b.textLayout != nil {
+		b.textLayout.padding = b.resolvedTextPadding()
+	}
+
+	if !b.autoUpdateTextAndGraphic {
+		return
+	}
+
+	if gi := b.resolvedGraphicImage(); b.graphic != nil && gi != nil {
+		if b.widget.Disabled {
+			b.graphic.Image = gi.Disabled
+		} else {
+			b.graphic.Image = gi.Idle
 		}
+	}
+
+	if b.text != nil {
+		b.text.Face = b.resolvedTextFace()
 
-		if b.text != nil {
+		if c := b.resolvedTextColor(); c != nil {
 			if b.widget.Disabled {
-				b.text.Color = b.TextColor.Disabled
+				b.text.Color = c.Disabled
 			} else {
-				b.text.Color = b.TextColor.Idle
+				b.text.Color = c.Idle
 			}
 		}
 	}
+}
 
-	if b.container != nil {
-		b.container.Render(screen, def)
+// buttonThemeValue returns the first non-zero value returned by v for the theme sections of b's composite widgets,
+// such as ComboButton, and then for the Button section.
+func buttonThemeValue[V any](b *Button, v func(t *ButtonTheme) V) V {
+	sections := append(b.themeSections[:len(b.themeSections):len(b.themeSections)], func(t *Theme) *ButtonTheme {
+		return t.Button
+	})
+	return themeValue(b.widget, sections, v)
+}
+
+// image returns b.Image, or the image of b's theme if it is nil.
+func (b *Button) image() *ButtonImage {
+	if b.Image != nil {
+		return b.Image
+	}
+	return buttonThemeValue(b, func(t *ButtonTheme) *ButtonImage {
+		return t.Image
+	})
+}
+
+func (b *Button) resolvedTextFace() font.Face {
+	if b.textFace != nil {
+		return b.textFace
+	}
+	return buttonThemeValue(b, func(t *ButtonTheme) font.Face {
+		return t.TextFace
+	})
+}
+
+func (b *Button) resolvedTextColor() *ButtonTextColor {
+	if b.TextColor != nil {
+		return b.TextColor
 	}
+	return buttonThemeValue(b, func(t *ButtonTheme) *ButtonTextColor {
+		return t.TextColor
+	})
+}
+
+func (b *Button) resolvedTextPadding() Insets {
+	if b.textPadding != nil {
+		return *b.textPadding
+	}
+	if p := buttonThemeValue(b, func(t *ButtonTheme) *Insets { return t.TextPadding }); p != nil {
+		return *p
+	}
+	return Insets{}
+}
+
+func (b *Button) resolvedGraphicImage() *ButtonImageImage {
+	if b.GraphicImage != nil {
+		return b.GraphicImage
+	}
+	return buttonThemeValue(b, func(t *ButtonTheme) *ButtonImageImage {
+		return t.GraphicImage
+	})
 }
 
 func (b *Button) draw(screen *ebiten.Image) {
-	i := b.Image.Idle
+	bi := b.image()
+	if bi == nil {
+		return
+	}
+
+	i := bi.Idle
 	switch {
 	case b.widget.Disabled:
-		if b.Image.Disabled != nil {
-			i = b.Image.Disabled
+		if bi.Disabled != nil {
+			i = bi.Disabled
 		}
 	case b.pressing && (b.hovering || b.KeepPressedOnExit):
-		if b.Image.Pressed != nil {
-			i = b.Image.Pressed
+		if bi.Pressed != nil {
+			i = bi.Pressed
 		}
-	case b.hovering:
-		if b.Image.Hover != nil {
-			i = b.Image.Hover
+	case b.hovering || b.focused:
+		if bi.Hover != nil {
+			i = bi.Hover
 		}
 	}
 
@@ -391,7 +529,7 @@ func (b *Button) draw(screen *ebiten.Image) {
 }
 
 func (b *Button) drawImageOptions(opts *ebiten.DrawImageOptions) {
-	if b.widget.Disabled && b.Image.Disabled == nil {
+	if b.widget.Disabled && b.image().Disabled == nil {
 		opts.ColorM.Scale(1, 1, 1, 0.35)
 	}
 }
@@ -414,35 +552,55 @@ func (b *Button) createWidget() {
 		}),
 
 		WidgetOpts.MouseButtonPressedHandler(func(args *WidgetMouseButtonPressedEventArgs) {
-			if !b.widget.Disabled {
+			if !b.widget.Disabled && b.acceptsMouseButton(args.Button) {
 				b.pressing = true
 
 				b.PressedEvent.Fire(&ButtonPressedEventArgs{
-					Button:  b,
-					OffsetX: args.OffsetX,
-					OffsetY: args.OffsetY,
+					Button:      b,
+					MouseButton: args.Button,
+					OffsetX:     args.OffsetX,
+					OffsetY:     args.OffsetY,
 				})
 			}
 		}),
 
 		WidgetOpts.MouseButtonReleasedHandler(func(args *WidgetMouseButtonReleasedEventArgs) {
+			if !b.acceptsMouseButton(args.Button) {
+				return
+			}
+
 			b.pressing = false
 
 			if !b.widget.Disabled {
 				b.ReleasedEvent.Fire(&ButtonReleasedEventArgs{
-					Button:  b,
-					Inside:  args.Inside,
-					OffsetX: args.OffsetX,
-					OffsetY: args.OffsetY,
+					Button:      b,
+					MouseButton: args.Button,
+					Inside:      args.Inside,
+					OffsetX:     args.OffsetX,
+					OffsetY:     args.OffsetY,
 				})
 
 				if args.Inside {
 					b.ClickedEvent.Fire(&ButtonClickedEventArgs{
-						Button: b,
+						Button:      b,
+						MouseButton: args.Button,
 					})
 				}
 			}
 		}),
 	}...)...)
 	b.widgetOpts = nil
+
+	setEventQueueFunc(b.widget, b.PressedEvent, b.ReleasedEvent, b.ClickedEvent)
+
+	b.widget.owner = b
+}
+
+func (b *Button) acceptsMouseButton(mb ebiten.MouseButton) bool {
+	for _, a := range b.mouseButtons {
+		if a == mb {
+			return true
+		}
+	}
+	return false
 }
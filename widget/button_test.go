@@ -1,9 +1,12 @@
 package widget
 
 import (
+	"image"
 	"testing"
 
 	"github.com/blizzy78/ebitenui/event"
+	"github.com/blizzy78/ebitenui/input"
+	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/matryer/is"
 )
 
@@ -52,6 +55,89 @@ func TestButton_ClickedEvent_User(t *testing.T) {
 	is.True(eventArgs != nil)
 }
 
+func TestButton_ClickedEvent_MouseButtons(t *testing.T) {
+	is := is.New(t)
+
+	var eventArgs *ButtonClickedEventArgs
+
+	b := newButton(t,
+		ButtonOpts.MouseButtons(ebiten.MouseButtonRight),
+		ButtonOpts.ClickedHandler(func(args *ButtonClickedEventArgs) {
+			eventArgs = args
+		}))
+
+	b.GetWidget().Rect = image.Rect(0, 0, 10, 10)
+
+	mouseButtonInput(b.GetWidget(), ebiten.MouseButtonLeft, true, 5, 5, t)
+	mouseButtonInput(b.GetWidget(), ebiten.MouseButtonLeft, false, 5, 5, t)
+	is.True(eventArgs == nil)
+
+	mouseButtonInput(b.GetWidget(), ebiten.MouseButtonRight, true, 5, 5, t)
+	mouseButtonInput(b.GetWidget(), ebiten.MouseButtonRight, false, 5, 5, t)
+	is.True(eventArgs != nil)
+	is.Equal(eventArgs.MouseButton, ebiten.MouseButtonRight)
+}
+
+func TestButton_ClickedEvent_IgnoresOtherMouseButtons(t *testing.T) {
+	is := is.New(t)
+
+	var eventArgs *ButtonClickedEventArgs
+
+	b := newButton(t,
+		ButtonOpts.ClickedHandler(func(args *ButtonClickedEventArgs) {
+			eventArgs = args
+		}))
+
+	b.GetWidget().Rect = image.Rect(0, 0, 10, 10)
+
+	mouseButtonInput(b.GetWidget(), ebiten.MouseButtonMiddle, true, 5, 5, t)
+	mouseButtonInput(b.GetWidget(), ebiten.MouseButtonMiddle, false, 5, 5, t)
+	is.True(eventArgs == nil)
+}
+
+func TestButton_ClickedEvent_ActionConfirm(t *testing.T) {
+	is := is.New(t)
+
+	numEvents := 0
+
+	b := newButton(t,
+		ButtonOpts.ClickedHandler(func(args *ButtonClickedEventArgs) {
+			numEvents++
+		}))
+
+	keyInput(b, ebiten.KeyEnter, t)
+	is.Equal(numEvents, 0) // not focused
+
+	b.Focus(true)
+	keyInput(b, ebiten.KeyEnter, t)
+	is.Equal(numEvents, 1)
+}
+
+func TestButton_ClickedEvent_ActionConfirm_ActionMap(t *testing.T) {
+	is := is.New(t)
+
+	numEvents := 0
+
+	b := newButton(t,
+		ButtonOpts.ClickedHandler(func(args *ButtonClickedEventArgs) {
+			numEvents++
+		}))
+	b.Focus(true)
+
+	m := input.NewActionMap()
+	m.Bind(input.ActionConfirm, input.KeyBinding(ebiten.KeySpace))
+
+	c := NewContainer()
+	c.AddChild(b)
+	c.GetWidget().SetActionMap(m)
+
+	keyInput(b, ebiten.KeyEnter, t)
+	is.Equal(numEvents, 0)
+
+	keyInput(b, ebiten.KeySpace, t)
+	is.Equal(numEvents, 1)
+}
+
 func newButton(t *testing.T, opts ...ButtonOpt) *Button {
 	t.Helper()
 
@@ -22,7 +22,6 @@ type Caret struct {
 	init    *MultiOnce
 	widget  *Widget
 	image   *image.NineSlice
-	height  int
 	state   caretBlinkState
 	visible bool
 }
@@ -78,7 +77,7 @@ func (c *Caret) SetLocation(rect img.Rectangle) {
 
 func (c *Caret) PreferredSize() (int, int) {
 	c.init.Do()
-	return c.Width, c.height
+	return c.Width, c.height()
 }
 
 func (c *Caret) Render(screen *ebiten.Image, def DeferredRenderFunc) {
@@ -94,7 +93,7 @@ func (c *Caret) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 
 	c.image = image.NewNineSliceColor(c.Color)
 
-	c.image.Draw(screen, c.Width, c.height, func(opts *ebiten.DrawImageOptions) {
+	c.image.Draw(screen, c.Width, c.height(), func(opts *ebiten.DrawImageOptions) {
 		p := c.widget.Rect.Min
 		opts.GeoM.Translate(float64(p.X), float64(p.Y))
 	})
@@ -130,10 +129,16 @@ func (c *Caret) blinkState(visible bool, timer *time.Timer, expired *atomic.Valu
 	}
 }
 
-func (c *Caret) createWidget() {
-	c.widget = NewWidget()
+// height returns the height of c, according to its font face.
+func (c *Caret) height() int {
+	if c.face == nil {
+		return 0
+	}
 
 	m := c.face.Metrics()
-	c.height = int(math.Round(fixedInt26_6ToFloat64(m.Ascent + m.Descent)))
-	c.face = nil
+	return int(math.Round(fixedInt26_6ToFloat64(m.Ascent + m.Descent)))
+}
+
+func (c *Caret) createWidget() {
+	c.widget = NewWidget()
 }
@@ -9,15 +9,16 @@ import (
 )
 
 type Checkbox struct {
-	ChangedEvent *event.Event
+	ChangedEvent *event.Of[*CheckboxChangedEventArgs]
 
 	buttonOpts []ButtonOpt
 	image      *CheckboxGraphicImage
 	triState   bool
 
-	init   *MultiOnce
-	button *Button
-	state  CheckboxState
+	init        *MultiOnce
+	button      *Button
+	buttonImage *ButtonImage
+	state       CheckboxState
 }
 
 type CheckboxOpt func(c *Checkbox)
@@ -50,7 +51,7 @@ var CheckboxOpts CheckboxOptions
 
 func NewCheckbox(opts ...CheckboxOpt) *Checkbox {
 	c := &Checkbox{
-		ChangedEvent: &event.Event{},
+		ChangedEvent: &event.Of[*CheckboxChangedEventArgs]{},
 
 		init: &MultiOnce{},
 	}
@@ -84,9 +85,7 @@ func (o CheckboxOptions) TriState() CheckboxOpt {
 
 func (o CheckboxOptions) ChangedHandler(f CheckboxChangedHandlerFunc) CheckboxOpt {
 	return func(c *Checkbox) {
-		c.ChangedEvent.AddHandler(func(args interface{}) {
-			f(args.(*CheckboxChangedEventArgs))
-		})
+		c.ChangedEvent.AddHandler(f)
 	}
 }
 
@@ -95,8 +94,15 @@ func (c *Checkbox) GetWidget() *Widget {
 	return c.button.GetWidget()
 }
 
+// Children implements Composite.
+func (c *Checkbox) Children() []HasWidget {
+	c.init.Do()
+	return c.button.Children()
+}
+
 func (c *Checkbox) PreferredSize() (int, int) {
 	c.init.Do()
+	c.applyTheme()
 	return c.button.PreferredSize()
 }
 
@@ -113,20 +119,56 @@ func (c *Checkbox) SetupInputLayer(def input.DeferredSetupInputLayerFunc) {
 func (c *Checkbox) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 	c.init.Do()
 
-	c.button.GraphicImage = c.state.graphicImage(c.image)
+	c.applyTheme()
 
 	c.button.Render(screen, def)
 }
 
+// Focus implements Focuser. While focused, c is advanced to its next state by input.ActionConfirm.
+func (c *Checkbox) Focus(focused bool) {
+	c.init.Do()
+	c.button.Focus(focused)
+}
+
+// applyTheme updates c's button according to c's state, its configuration, and its theme.
+func (c *Checkbox) applyTheme() {
+	bi, i := c.buttonImage, c.image
+	if t := c.theme(); t != nil {
+		if bi == nil {
+			bi = t.ButtonImage
+		}
+		if i == nil {
+			i = t.Image
+		}
+	}
+
+	c.button.Image = bi
+
+	if i != nil {
+		c.button.GraphicImage = c.state.graphicImage(i)
+	}
+}
+
+func (c *Checkbox) theme() *CheckboxTheme {
+	return themeSection(c.button.GetWidget(), func(t *Theme) *CheckboxTheme {
+		return t.Checkbox
+	})
+}
+
 func (c *Checkbox) createWidget() {
 	c.button = NewButton(append(c.buttonOpts, []ButtonOpt{
-		ButtonOpts.Graphic(c.image.Unchecked.Idle),
+		ButtonOpts.Graphic(nil),
 
 		ButtonOpts.ClickedHandler(func(args *ButtonClickedEventArgs) {
 			c.SetState(c.state.Advance(c.triState))
 		}),
 	}...)...)
 	c.buttonOpts = nil
+	c.buttonImage = c.button.Image
+
+	setEventQueueFunc(c.button.GetWidget(), c.ChangedEvent)
+
+	c.button.GetWidget().owner = c
 }
 
 func (c *Checkbox) State() CheckboxState {
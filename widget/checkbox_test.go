@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/blizzy78/ebitenui/event"
+	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/matryer/is"
 )
 
@@ -80,6 +81,16 @@ func TestCheckbox_State_Cycle_TriState(t *testing.T) {
 	is.Equal(c.State(), CheckboxUnchecked)
 }
 
+func TestCheckbox_State_ActionConfirm(t *testing.T) {
+	is := is.New(t)
+
+	c := newCheckbox(t)
+	c.Focus(true)
+
+	keyInput(c, ebiten.KeyEnter, t)
+	is.Equal(c.State(), CheckboxChecked)
+}
+
 func newCheckbox(t *testing.T, opts ...CheckboxOpt) *Checkbox {
 	t.Helper()
 
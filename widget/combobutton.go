@@ -13,10 +13,12 @@ type ComboButton struct {
 
 	buttonOpts       []ButtonOpt
 	maxContentHeight int
+	themeSections    []func(t *Theme) *ButtonTheme
 
 	init    *MultiOnce
 	button  *Button
 	content HasWidget
+	focused bool
 }
 
 type ComboButtonOpt func(c *ComboButton)
@@ -63,6 +65,16 @@ func (c *ComboButton) GetWidget() *Widget {
 	return c.button.GetWidget()
 }
 
+// Children implements Composite. The content is included even if it is not visible.
+func (c *ComboButton) Children() []HasWidget {
+	c.init.Do()
+	ws := c.button.Children()
+	if c.content != nil {
+		ws = append(ws, c.content)
+	}
+	return ws
+}
+
 func (c *ComboButton) SetLocation(rect image.Rectangle) {
 	c.init.Do()
 	c.button.GetWidget().Rect = rect
@@ -112,6 +124,7 @@ func (c *ComboButton) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 	c.init.Do()
 
 	c.handleClick()
+	c.handleActions()
 
 	c.button.Render(screen, def)
 
@@ -130,6 +143,20 @@ func (c *ComboButton) handleClick() {
 	}
 }
 
+// Focus implements Focuser. While focused, input.ActionConfirm toggles c's content, and input.ActionCancel
+// hides it.
+func (c *ComboButton) Focus(focused bool) {
+	c.init.Do()
+	c.button.Focus(focused)
+	c.focused = focused
+}
+
+func (c *ComboButton) handleActions() {
+	if c.focused && c.ContentVisible && c.button.GetWidget().ActionMap().ActionJustPressed(input.ActionCancel) {
+		c.ContentVisible = false
+	}
+}
+
 func (c *ComboButton) renderContent(screen *ebiten.Image, def DeferredRenderFunc) {
 	c.relayoutContent()
 
@@ -184,4 +211,17 @@ func (c *ComboButton) createWidget() {
 		c.ContentVisible = !c.ContentVisible
 	}))...)
 	c.buttonOpts = nil
+
+	c.button.themeSections = append(c.themeSections, func(t *Theme) *ButtonTheme {
+		if t.ComboButton == nil {
+			return nil
+		}
+		return t.ComboButton.Button
+	})
+
+	c.button.GetWidget().owner = c
+
+	if c.content != nil {
+		c.content.GetWidget().themeOwner = c.button.GetWidget()
+	}
 }
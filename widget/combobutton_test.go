@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/blizzy78/ebitenui/event"
+	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/matryer/is"
 )
 
@@ -19,6 +20,19 @@ func TestComboButton_ContentVisible_Click(t *testing.T) {
 	is.True(!b.ContentVisible)
 }
 
+func TestComboButton_ContentVisible_Actions(t *testing.T) {
+	is := is.New(t)
+
+	b := newComboButton(t)
+	b.Focus(true)
+
+	keyInput(b, ebiten.KeyEnter, t)
+	is.True(b.ContentVisible)
+
+	keyInput(b, ebiten.KeyEscape, t)
+	is.True(!b.ContentVisible)
+}
+
 func newComboButton(t *testing.T, opts ...ComboButtonOpt) *ComboButton {
 	t.Helper()
 
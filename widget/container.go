@@ -17,9 +17,14 @@ type Container struct {
 	layout      Layouter
 	layoutDirty bool
 
-	init     *MultiOnce
-	widget   *Widget
-	children []PreferredSizeLocateableWidget
+	init               *MultiOnce
+	widget             *Widget
+	children           []PreferredSizeLocateableWidget
+	preferredWidth     int
+	preferredHeight    int
+	preferredSizeValid bool
+	relayoutChildren   bool
+	lastLayoutRect     img.Rectangle
 }
 
 type ContainerOpt func(c *Container)
@@ -86,13 +91,48 @@ func (c *Container) AddChild(child PreferredSizeLocateableWidget) RemoveChildFun
 
 	child.GetWidget().parent = c.widget
 
-	c.RequestRelayout()
+	c.layoutDirty = true
+	c.invalidatePreferredSize()
 
 	return func() {
 		c.removeChild(child)
 	}
 }
 
+// ReplaceChild replaces child old of c with child new, at the same position. It returns a function to remove
+// new from c. Functions returned for old do not remove new. It panics if old is not a child of c.
+func (c *Container) ReplaceChild(old PreferredSizeLocateableWidget, new PreferredSizeLocateableWidget) RemoveChildFunc {
+	c.init.Do()
+
+	if new == nil {
+		panic("cannot add nil child")
+	}
+
+	index := -1
+	for i, ch := range c.children {
+		if ch == old {
+			index = i
+			break
+		}
+	}
+
+	if index < 0 {
+		panic("cannot replace widget that is not a child")
+	}
+
+	c.children[index] = new
+
+	old.GetWidget().parent = nil
+	new.GetWidget().parent = c.widget
+
+	c.layoutDirty = true
+	c.invalidatePreferredSize()
+
+	return func() {
+		c.removeChild(new)
+	}
+}
+
 func (c *Container) removeChild(child PreferredSizeLocateableWidget) {
 	index := -1
 	for i, ch := range c.children {
@@ -110,13 +150,39 @@ func (c *Container) removeChild(child PreferredSizeLocateableWidget) {
 
 	child.GetWidget().parent = nil
 
-	c.RequestRelayout()
+	c.layoutDirty = true
+	c.invalidatePreferredSize()
 }
 
+// RequestRelayout implements Relayoutable. It marks c's layout as dirty and drops its cached preferred size.
+// All descendants of c are requested to relayout as well, but only when c's preferred size or layout is needed
+// the next time.
+//
+// Containers above c are only relayouted if c's preferred size has actually changed.
 func (c *Container) RequestRelayout() {
 	c.init.Do()
 
 	c.layoutDirty = true
+	c.relayoutChildren = true
+	c.invalidatePreferredSize()
+}
+
+// invalidatePreferredSize drops the cached preferred sizes of c and of all containers above it, stopping at
+// the first container whose cached preferred size has already been dropped. Preferred sizes are recomputed
+// when they are needed the next time, and only then is it decided which containers need to be relayouted.
+func (c *Container) invalidatePreferredSize() {
+	for ; c != nil && c.preferredSizeValid; c = c.widget.parentContainer() {
+		c.preferredSizeValid = false
+	}
+}
+
+// requestChildrenRelayout requests a relayout of all children of c if RequestRelayout has been called on c.
+func (c *Container) requestChildrenRelayout() {
+	if !c.relayoutChildren {
+		return
+	}
+
+	c.relayoutChildren = false
 
 	for _, ch := range c.children {
 		if r, ok := ch.(Relayoutable); ok {
@@ -130,6 +196,17 @@ func (c *Container) GetWidget() *Widget {
 	return c.widget
 }
 
+// Children implements Composite.
+func (c *Container) Children() []HasWidget {
+	c.init.Do()
+
+	ws := make([]HasWidget, len(c.children))
+	for i, ch := range c.children {
+		ws[i] = ch
+	}
+	return ws
+}
+
 func (c *Container) PreferredSize() (int, int) {
 	c.init.Do()
 
@@ -137,7 +214,33 @@ func (c *Container) PreferredSize() (int, int) {
 		return 50, 50
 	}
 
-	return c.layout.PreferredSize(c.children)
+	if !c.preferredSizeValid {
+		c.requestChildrenRelayout()
+
+		w, h := c.layout.PreferredSize(c.children)
+		c.preferredSizeValid = true
+
+		// the parent container needs to locate c again, but containers above it do not, unless
+		// the parent's preferred size changes as well
+		if w != c.preferredWidth || h != c.preferredHeight {
+			c.preferredWidth, c.preferredHeight = w, h
+			c.widget.parentLayoutDirty()
+		}
+	}
+
+	return c.preferredWidth, c.preferredHeight
+}
+
+// PreferredHeightForWidth implements PreferredHeightForWidther.
+func (c *Container) PreferredHeightForWidth(width int) int {
+	c.init.Do()
+
+	if l, ok := c.layout.(HeightForWidthLayouter); ok {
+		return l.PreferredHeightForWidth(c.children, width)
+	}
+
+	_, h := c.PreferredSize()
+	return h
 }
 
 func (c *Container) SetLocation(rect img.Rectangle) {
@@ -168,9 +271,26 @@ func (c *Container) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 }
 
 func (c *Container) doLayout() {
-	if c.layout != nil && c.layoutDirty {
-		c.layout.Layout(c.children, c.widget.Rect)
-		c.layoutDirty = false
+	c.requestChildrenRelayout()
+
+	if c.layout == nil {
+		return
+	}
+
+	// recomputing the preferred size marks the layout as dirty if any child's preferred size has changed
+	c.PreferredSize()
+
+	if !c.layoutDirty && c.widget.Rect == c.lastLayoutRect {
+		return
+	}
+
+	c.layout.Layout(c.children, c.widget.Rect)
+	c.layoutDirty = false
+	c.lastLayoutRect = c.widget.Rect
+
+	// some layouts' preferred sizes depend on the most recent layout
+	if w, h := c.layout.PreferredSize(c.children); w != c.preferredWidth || h != c.preferredHeight {
+		c.invalidatePreferredSize()
 	}
 }
 
@@ -193,6 +313,10 @@ func (c *Container) draw(screen *ebiten.Image) {
 func (c *Container) createWidget() {
 	c.widget = NewWidget(c.widgetOpts...)
 	c.widgetOpts = nil
+
+	c.widget.container = c
+
+	c.widget.owner = c
 }
 
 // WidgetAt implements WidgetLocator.
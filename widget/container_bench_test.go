@@ -0,0 +1,145 @@
+package widget
+
+import (
+	"image"
+	"testing"
+)
+
+// uncachedContainer is a Container that does not cache its preferred size, like containers did before
+// preferred sizes were cached.
+type uncachedContainer struct {
+	*Container
+}
+
+func BenchmarkContainer_RequestRelayout_Leaf(b *testing.B) {
+	root, leaves, widgets := newBenchmarkTree(4, 8, false)
+	root.SetLocation(image.Rect(0, 0, 1920, 1080))
+	layoutTree(root)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		j := i % len(leaves)
+		widgets[j].preferredWidth = 10 + i%2
+		leaves[j].RequestRelayout()
+		layoutTree(root)
+	}
+}
+
+// BenchmarkContainer_RequestRelayout_Root relayouts the whole tree for every change, which is what
+// happens when RequestRelayout is called on the root container instead of where the change happened.
+func BenchmarkContainer_RequestRelayout_Root(b *testing.B) {
+	root, _, widgets := newBenchmarkTree(4, 8, false)
+	root.SetLocation(image.Rect(0, 0, 1920, 1080))
+	layoutTree(root)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		j := i % len(widgets)
+		widgets[j].preferredWidth = 10 + i%2
+		root.RequestRelayout()
+		layoutTree(root)
+	}
+}
+
+// BenchmarkContainer_RequestRelayout_Full relayouts the whole tree for every change without using cached
+// preferred sizes, which is what containers did before preferred sizes were cached.
+func BenchmarkContainer_RequestRelayout_Full(b *testing.B) {
+	root, _, widgets := newBenchmarkTree(4, 8, true)
+	root.SetLocation(image.Rect(0, 0, 1920, 1080))
+	layoutTreeFull(root)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		j := i % len(widgets)
+		widgets[j].preferredWidth = 10 + i%2
+		layoutTreeFull(root)
+	}
+}
+
+func BenchmarkContainer_AddChild(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		c := NewContainer(ContainerOpts.Layout(NewRowLayout()))
+		c.PreferredSize()
+
+		for j := 0; j < 1000; j++ {
+			c.AddChild(newSimpleWidget(10, 10, nil))
+		}
+	}
+}
+
+func BenchmarkContainer_PreferredSize(b *testing.B) {
+	root, _, _ := newBenchmarkTree(4, 8, false)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		root.PreferredSize()
+	}
+}
+
+func BenchmarkContainer_SetLocation(b *testing.B) {
+	root, _, _ := newBenchmarkTree(4, 8, false)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		root.SetLocation(image.Rect(0, 0, 1920, 1080+i%2))
+		layoutTree(root)
+	}
+}
+
+func (c uncachedContainer) PreferredSize() (int, int) {
+	c.preferredSizeValid = false
+	return c.Container.PreferredSize()
+}
+
+// newBenchmarkTree returns a tree of containers with the given depth, each container having breadth children.
+// It also returns the innermost containers and the widgets they contain. If uncached is true, containers
+// below the root do not cache their preferred sizes.
+func newBenchmarkTree(depth int, breadth int, uncached bool) (*Container, []*Container, []*simpleWidget) {
+	c := NewContainer(ContainerOpts.Layout(NewRowLayout(
+		RowLayoutOpts.Direction(Direction(depth % 2)),
+	)))
+
+	if depth == 0 {
+		w := newSimpleWidget(10, 10, nil)
+		c.AddChild(w)
+		return c, []*Container{c}, []*simpleWidget{w}
+	}
+
+	leaves := []*Container{}
+	widgets := []*simpleWidget{}
+
+	for i := 0; i < breadth; i++ {
+		ch, l, w := newBenchmarkTree(depth-1, breadth, uncached)
+		if uncached {
+			c.AddChild(uncachedContainer{ch})
+		} else {
+			c.AddChild(ch)
+		}
+		leaves = append(leaves, l...)
+		widgets = append(widgets, w...)
+	}
+
+	return c, leaves, widgets
+}
+
+// layoutTree does what rendering c would do in terms of layout.
+func layoutTree(c *Container) {
+	c.doLayout()
+
+	for _, ch := range c.children {
+		if cc, ok := ch.(*Container); ok {
+			layoutTree(cc)
+		}
+	}
+}
+
+// layoutTreeFull layouts c and all containers below it unconditionally.
+func layoutTreeFull(c *Container) {
+	c.layout.Layout(c.children, c.widget.Rect)
+
+	for _, ch := range c.children {
+		if cc, ok := ch.(uncachedContainer); ok {
+			layoutTreeFull(cc.Container)
+		}
+	}
+}
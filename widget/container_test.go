@@ -2,6 +2,7 @@ package widget
 
 import (
 	"image"
+	"image/color"
 	"testing"
 
 	"github.com/blizzy78/ebitenui/input"
@@ -72,6 +73,230 @@ func TestContainer_SetupInputLayer(t *testing.T) {
 	m.AssertExpectations(t)
 }
 
+func TestContainer_PreferredSize_Cached(t *testing.T) {
+	is := is.New(t)
+
+	s := newSimpleWidget(10, 20, nil)
+
+	c := newContainer(t,
+		ContainerOpts.Layout(newRowLayout(t)))
+	c.AddChild(s)
+
+	w, h := c.PreferredSize()
+	is.Equal(w, 10)
+	is.Equal(h, 20)
+
+	s.preferredWidth = 30
+
+	w, _ = c.PreferredSize()
+	is.Equal(w, 10)
+
+	c.RequestRelayout()
+
+	w, _ = c.PreferredSize()
+	is.Equal(w, 30)
+}
+
+func TestContainer_RequestRelayout_PropagatesToParent(t *testing.T) {
+	is := is.New(t)
+
+	s := newSimpleWidget(10, 20, nil)
+
+	child := newContainer(t,
+		ContainerOpts.Layout(newRowLayout(t)))
+	child.AddChild(s)
+
+	parent := newContainer(t,
+		ContainerOpts.Layout(newRowLayout(t)))
+	parent.AddChild(child)
+
+	parent.SetLocation(image.Rect(0, 0, 100, 100))
+	render(parent, t)
+	is.Equal(parent.layoutDirty, false)
+
+	s.preferredWidth = 30
+	child.RequestRelayout()
+
+	w, _ := parent.PreferredSize()
+	is.Equal(w, 30)
+	is.True(parent.layoutDirty)
+
+	render(parent, t)
+	is.Equal(child.GetWidget().Rect.Dx(), 30)
+}
+
+func TestContainer_RequestRelayout_SizeUnchanged(t *testing.T) {
+	is := is.New(t)
+
+	s := newSimpleWidget(10, 10, nil)
+
+	child := newContainer(t,
+		ContainerOpts.Layout(NewAnchorLayout()))
+	child.AddChild(newSimpleWidget(50, 50, nil))
+	child.AddChild(s)
+
+	grandchild := newContainer(t,
+		ContainerOpts.Layout(newRowLayout(t)))
+	grandchild.AddChild(child)
+
+	parent := newContainer(t,
+		ContainerOpts.Layout(newRowLayout(t)))
+	parent.AddChild(grandchild)
+
+	parent.SetLocation(image.Rect(0, 0, 100, 100))
+	render(parent, t)
+
+	s.preferredWidth = 20
+	child.RequestRelayout()
+
+	parent.PreferredSize()
+
+	is.True(child.layoutDirty)
+	is.Equal(grandchild.layoutDirty, false)
+	is.Equal(parent.layoutDirty, false)
+}
+
+func TestContainer_RequestRelayout_Children(t *testing.T) {
+	is := is.New(t)
+
+	s := newSimpleWidget(10, 20, nil)
+
+	child := newContainer(t,
+		ContainerOpts.Layout(newRowLayout(t)))
+	child.AddChild(s)
+
+	parent := newContainer(t,
+		ContainerOpts.Layout(newRowLayout(t)))
+	parent.AddChild(child)
+
+	parent.SetLocation(image.Rect(0, 0, 100, 100))
+	render(parent, t)
+	is.Equal(child.layoutDirty, false)
+
+	s.preferredWidth = 30
+	parent.RequestRelayout()
+
+	w, _ := parent.PreferredSize()
+	is.Equal(w, 30)
+	is.True(child.layoutDirty)
+}
+
+func TestContainer_AddChild_Lazy(t *testing.T) {
+	is := is.New(t)
+
+	c := newContainer(t,
+		ContainerOpts.Layout(newRowLayout(t)))
+	c.PreferredSize()
+
+	c.AddChild(newSimpleWidget(10, 20, nil))
+	c.AddChild(newSimpleWidget(10, 20, nil))
+	is.Equal(c.preferredSizeValid, false)
+
+	w, _ := c.PreferredSize()
+	is.Equal(w, 20)
+}
+
+func TestContainer_ReplaceChild(t *testing.T) {
+	is := is.New(t)
+
+	c := newContainer(t,
+		ContainerOpts.Layout(newRowLayout(t)))
+
+	first := newSimpleWidget(10, 20, nil)
+	old := newSimpleWidget(10, 20, nil)
+	c.AddChild(first)
+	removeOld := c.AddChild(old)
+	c.PreferredSize()
+
+	new := newSimpleWidget(30, 20, nil)
+	remove := c.ReplaceChild(old, new)
+	is.Equal(c.Children(), []HasWidget{first, new})
+	is.True(old.GetWidget().parent == nil)
+	is.Equal(new.GetWidget().parent, c.GetWidget())
+
+	w, _ := c.PreferredSize()
+	is.Equal(w, 40)
+
+	removeOld()
+	is.Equal(len(c.Children()), 2)
+
+	remove()
+	is.Equal(c.Children(), []HasWidget{first})
+}
+
+func TestContainer_Render_TextLabelChanged(t *testing.T) {
+	is := is.New(t)
+
+	text := NewText(TextOpts.Text("a", loadFont(t), color.White))
+
+	child := newContainer(t,
+		ContainerOpts.Layout(newRowLayout(t)))
+	child.AddChild(text)
+
+	parent := newContainer(t,
+		ContainerOpts.Layout(newRowLayout(t)))
+	parent.AddChild(child)
+
+	parent.SetLocation(image.Rect(0, 0, 100, 100))
+	render(parent, t)
+	w := child.GetWidget().Rect.Dx()
+
+	text.Label = "abcdef"
+	render(parent, t)
+
+	is.True(child.layoutDirty)
+	is.Equal(parent.preferredSizeValid, false)
+
+	render(parent, t)
+	is.True(child.GetWidget().Rect.Dx() > w)
+	is.Equal(text.GetWidget().Rect.Dx(), child.GetWidget().Rect.Dx())
+}
+
+func TestContainer_Render_GraphicImageChanged(t *testing.T) {
+	is := is.New(t)
+
+	g := newGraphic(t,
+		GraphicOpts.Image(newImageEmptySize(10, 10, t)))
+
+	child := newContainer(t,
+		ContainerOpts.Layout(newRowLayout(t)))
+	child.AddChild(g)
+
+	parent := newContainer(t,
+		ContainerOpts.Layout(newRowLayout(t)))
+	parent.AddChild(child)
+
+	parent.SetLocation(image.Rect(0, 0, 100, 100))
+	render(parent, t)
+
+	g.Image = newImageEmptySize(20, 20, t)
+	render(parent, t)
+	render(parent, t)
+
+	is.Equal(child.GetWidget().Rect.Size(), image.Point{20, 20})
+	is.Equal(g.GetWidget().Rect.Size(), image.Point{20, 20})
+}
+
+func TestContainer_Render_RelayoutsOnLocationChange(t *testing.T) {
+	is := is.New(t)
+
+	s := newSimpleWidget(10, 20, RowLayoutData{
+		Stretch: true,
+	})
+
+	c := newContainer(t,
+		ContainerOpts.Layout(newRowLayout(t)))
+	c.AddChild(s)
+
+	c.SetLocation(image.Rect(0, 0, 100, 100))
+	render(c, t)
+	is.Equal(s.widget.Rect, image.Rect(0, 0, 10, 100))
+
+	c.SetLocation(image.Rect(0, 0, 100, 200))
+	render(c, t)
+	is.Equal(s.widget.Rect, image.Rect(0, 0, 10, 200))
+}
+
 func (c *controlMock) GetWidget() *Widget {
 	args := c.Called()
 	return args.Get(0).(*Widget)
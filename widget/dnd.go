@@ -10,7 +10,7 @@ import (
 )
 
 type DragAndDrop struct {
-	DroppedEvent *event.Event
+	DroppedEvent *event.Of[*DragAndDropDroppedEventArgs]
 
 	container            Locater
 	contentsCreater      DragContentsCreater
@@ -58,7 +58,7 @@ type dragAndDropState func(*ebiten.Image, DeferredRenderFunc) (dragAndDropState,
 
 func NewDragAndDrop(opts ...DragAndDropOpt) *DragAndDrop {
 	d := &DragAndDrop{
-		DroppedEvent: &event.Event{},
+		DroppedEvent: &event.Of[*DragAndDropDroppedEventArgs]{},
 
 		minDragStartDistance: 15,
 	}
@@ -91,9 +91,7 @@ func (o DragAndDropOptions) MinDragStartDistance(d int) DragAndDropOpt {
 
 func (o DragAndDropOptions) DroppedHandler(f DragAndDropDroppedHandlerFunc) DragAndDropOpt {
 	return func(d *DragAndDrop) {
-		d.DroppedEvent.AddHandler(func(args interface{}) {
-			f(args.(*DragAndDropDroppedEventArgs))
-		})
+		d.DroppedEvent.AddHandler(f)
 	}
 }
 
@@ -10,11 +10,12 @@ import (
 // A FlipBook is a container that always renders exactly one child widget: the current page.
 // The current page will be embedded in a AnchorLayout.
 type FlipBook struct {
-	containerOpts    []ContainerOpt
-	anchorLayoutOpts []AnchorLayoutOpt
+	containerOpts []ContainerOpt
+	padding       *Insets
 
 	init          *MultiOnce
 	container     *Container
+	layout        *AnchorLayout
 	removeCurrent RemoveChildFunc
 }
 
@@ -52,7 +53,7 @@ func (o FlipBookOptions) ContainerOpts(opts ...ContainerOpt) FlipBookOpt {
 // WithPadding configures a FlipBook with padding i.
 func (o FlipBookOptions) Padding(i Insets) FlipBookOpt {
 	return func(f *FlipBook) {
-		f.anchorLayoutOpts = append(f.anchorLayoutOpts, AnchorLayoutOpts.Padding(i))
+		f.padding = &i
 	}
 }
 
@@ -62,12 +63,26 @@ func (f *FlipBook) GetWidget() *Widget {
 	return f.container.GetWidget()
 }
 
+// Children implements Composite. It returns the current page only.
+func (f *FlipBook) Children() []HasWidget {
+	f.init.Do()
+	return f.container.Children()
+}
+
 // PreferredSize implements PreferredSizer.
 func (f *FlipBook) PreferredSize() (int, int) {
 	f.init.Do()
+	f.applyTheme()
 	return f.container.PreferredSize()
 }
 
+// PreferredHeightForWidth implements PreferredHeightForWidther.
+func (f *FlipBook) PreferredHeightForWidth(width int) int {
+	f.init.Do()
+	f.applyTheme()
+	return f.container.PreferredHeightForWidth(width)
+}
+
 // SetLocation implements Locateable.
 func (f *FlipBook) SetLocation(rect img.Rectangle) {
 	f.init.Do()
@@ -89,9 +104,28 @@ func (f *FlipBook) SetupInputLayer(def input.DeferredSetupInputLayerFunc) {
 // Render implements Renderer.
 func (f *FlipBook) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 	f.init.Do()
+	f.applyTheme()
 	f.container.Render(screen, def)
 }
 
+// applyTheme updates f's padding according to f's configuration and its theme.
+func (f *FlipBook) applyTheme() {
+	p := f.padding
+	if p == nil {
+		if t := themeSection(f.container.GetWidget(), func(t *Theme) *FlipBookTheme { return t.FlipBook }); t != nil {
+			p = t.Padding
+		}
+	}
+	if p == nil {
+		p = &Insets{}
+	}
+
+	if *p != f.layout.padding {
+		f.layout.padding = *p
+		f.container.RequestRelayout()
+	}
+}
+
 // WidgetAt implements WidgetLocator.
 func (f *FlipBook) WidgetAt(x int, y int) HasWidget {
 	f.init.Do()
@@ -111,9 +145,11 @@ func (f *FlipBook) WidgetAt(x int, y int) HasWidget {
 }
 
 func (f *FlipBook) createWidget() {
-	f.container = NewContainer(append(f.containerOpts, ContainerOpts.Layout(NewAnchorLayout(f.anchorLayoutOpts...)))...)
+	f.layout = NewAnchorLayout()
+	f.container = NewContainer(append(f.containerOpts, ContainerOpts.Layout(f.layout))...)
 	f.containerOpts = nil
-	f.anchorLayoutOpts = nil
+
+	f.container.GetWidget().owner = f
 }
 
 // SetPage sets the current page to be rendered to page. The previous page will no longer be rendered.
@@ -0,0 +1,252 @@
+package widget
+
+import "image"
+
+// FlowLayout layouts widgets in lines, either in rows or in columns. When a line is full, widgets
+// wrap to the next line.
+//
+// Since the number of lines depends on the available space, FlowLayout remembers the line length
+// of the most recent layout and uses it to determine its preferred size. If no layout has happened
+// yet, the line length configured using FlowLayoutOpts.PreferredLineLength is used instead. When used
+// inside a ScrollContainer that stretches its content's width, PreferredLineLength should not exceed the
+// ScrollContainer's width.
+//
+// Widget.LayoutData of widgets being layouted by FlowLayout need to be of type FlowLayoutData.
+type FlowLayout struct {
+	direction           Direction
+	padding             Insets
+	mainSpacing         int
+	crossSpacing        int
+	lineAlignment       FlowLayoutPosition
+	preferredLineLength int
+
+	lineLength int
+}
+
+// FlowLayoutOpt is a function that configures f.
+type FlowLayoutOpt func(f *FlowLayout)
+
+type FlowLayoutOptions struct {
+}
+
+// FlowLayoutData specifies layout settings for a widget.
+type FlowLayoutData struct {
+	// Position specifies the anchoring position inside the line, in the direction that is not the
+	// primary direction of the layout.
+	Position FlowLayoutPosition
+
+	// Stretch specifies whether to stretch to the line's size in the direction that is not the primary
+	// direction of the layout.
+	Stretch bool
+}
+
+// FlowLayoutPosition is the type used to specify an anchoring position.
+type FlowLayoutPosition int
+
+const (
+	// FlowLayoutPositionStart is the anchoring position for "left" (in the horizontal direction) or "top" (in the vertical direction.)
+	FlowLayoutPositionStart = FlowLayoutPosition(iota)
+
+	// FlowLayoutPositionCenter is the center anchoring position.
+	FlowLayoutPositionCenter
+
+	// FlowLayoutPositionEnd is the anchoring position for "right" (in the horizontal direction) or "bottom" (in the vertical direction.)
+	FlowLayoutPositionEnd
+)
+
+type flowLayoutLine struct {
+	widgets []PreferredSizeLocateableWidget
+	sizes   []image.Point
+	main    int
+	cross   int
+}
+
+// FlowLayoutOpts contains functions that configure a FlowLayout.
+var FlowLayoutOpts FlowLayoutOptions
+
+// NewFlowLayout constructs a new FlowLayout, configured by opts.
+func NewFlowLayout(opts ...FlowLayoutOpt) *FlowLayout {
+	f := &FlowLayout{}
+
+	for _, o := range opts {
+		o(f)
+	}
+
+	return f
+}
+
+// Direction configures a flow layout to fill lines in the primary direction d. DirectionHorizontal
+// fills rows from left to right, DirectionVertical fills columns from top to bottom.
+func (o FlowLayoutOptions) Direction(d Direction) FlowLayoutOpt {
+	return func(f *FlowLayout) {
+		f.direction = d
+	}
+}
+
+// Padding configures a flow layout to use padding i.
+func (o FlowLayoutOptions) Padding(i Insets) FlowLayoutOpt {
+	return func(f *FlowLayout) {
+		f.padding = i
+	}
+}
+
+// Spacing configures a flow layout to separate widgets inside a line by spacing main, and lines by spacing cross.
+func (o FlowLayoutOptions) Spacing(main int, cross int) FlowLayoutOpt {
+	return func(f *FlowLayout) {
+		f.mainSpacing = main
+		f.crossSpacing = cross
+	}
+}
+
+// LineAlignment configures a flow layout to align each line in the primary direction according to p.
+func (o FlowLayoutOptions) LineAlignment(p FlowLayoutPosition) FlowLayoutOpt {
+	return func(f *FlowLayout) {
+		f.lineAlignment = p
+	}
+}
+
+// PreferredLineLength configures a flow layout to wrap lines at length l when determining its preferred
+// size before any layout has happened. l does not include padding.
+func (o FlowLayoutOptions) PreferredLineLength(l int) FlowLayoutOpt {
+	return func(f *FlowLayout) {
+		f.preferredLineLength = l
+	}
+}
+
+// PreferredSize implements Layouter.
+func (f *FlowLayout) PreferredSize(widgets []PreferredSizeLocateableWidget) (int, int) {
+	l := f.lineLength
+	if l <= 0 {
+		l = f.preferredLineLength
+	}
+
+	return f.PreferredSizeForLineLength(widgets, l)
+}
+
+// PreferredSizeForLineLength returns the preferred size of widgets when lines are wrapped at length l,
+// with l not including padding. If l is 0, lines are never wrapped.
+func (f *FlowLayout) PreferredSizeForLineLength(widgets []PreferredSizeLocateableWidget, l int) (int, int) {
+	main, cross := 0, 0
+
+	lines := f.lines(widgets, l)
+	for i, line := range lines {
+		if line.main > main {
+			main = line.main
+		}
+
+		cross += line.cross
+		if i > 0 {
+			cross += f.crossSpacing
+		}
+	}
+
+	w, h := f.fromMainCross(main, cross)
+	return w + f.padding.Dx(), h + f.padding.Dy()
+}
+
+// PreferredHeightForWidth implements HeightForWidthLayouter.
+func (f *FlowLayout) PreferredHeightForWidth(widgets []PreferredSizeLocateableWidget, width int) int {
+	if f.direction != DirectionHorizontal {
+		_, h := f.PreferredSize(widgets)
+		return h
+	}
+
+	l := width - f.padding.Dx()
+	if l < 1 {
+		l = 1
+	}
+
+	_, h := f.PreferredSizeForLineLength(widgets, l)
+	return h
+}
+
+// Layout implements Layouter.
+func (f *FlowLayout) Layout(widgets []PreferredSizeLocateableWidget, rect image.Rectangle) {
+	rect = f.padding.Apply(rect)
+
+	lineLength, _ := f.toMainCross(rect.Dx(), rect.Dy())
+	if lineLength < 1 {
+		lineLength = 1
+	}
+	f.lineLength = lineLength
+
+	cross := 0
+	for _, line := range f.lines(widgets, lineLength) {
+		main := 0
+		switch f.lineAlignment {
+		case FlowLayoutPositionCenter:
+			main = (lineLength - line.main) / 2
+		case FlowLayoutPositionEnd:
+			main = lineLength - line.main
+		}
+
+		for i, w := range line.widgets {
+			wm, wc := line.sizes[i].X, line.sizes[i].Y
+			off := 0
+
+			if fld, ok := w.GetWidget().LayoutData.(FlowLayoutData); ok {
+				if fld.Stretch {
+					wc = line.cross
+				}
+
+				switch fld.Position {
+				case FlowLayoutPositionCenter:
+					off = (line.cross - wc) / 2
+				case FlowLayoutPositionEnd:
+					off = line.cross - wc
+				}
+			}
+
+			x, y := f.fromMainCross(main, cross+off)
+			ww, wh := f.fromMainCross(wm, wc)
+			w.SetLocation(image.Rect(0, 0, ww, wh).Add(rect.Min).Add(image.Point{x, y}))
+
+			main += wm + f.mainSpacing
+		}
+
+		cross += line.cross + f.crossSpacing
+	}
+}
+
+func (f *FlowLayout) lines(widgets []PreferredSizeLocateableWidget, lineLength int) []flowLayoutLine {
+	lines := []flowLayoutLine{}
+	line := flowLayoutLine{}
+
+	for _, w := range widgets {
+		wm, wc := f.toMainCross(w.PreferredSize())
+
+		if len(line.widgets) > 0 && lineLength > 0 && line.main+f.mainSpacing+wm > lineLength {
+			lines = append(lines, line)
+			line = flowLayoutLine{}
+		}
+
+		if len(line.widgets) > 0 {
+			line.main += f.mainSpacing
+		}
+
+		line.widgets = append(line.widgets, w)
+		line.sizes = append(line.sizes, image.Point{wm, wc})
+		line.main += wm
+
+		if wc > line.cross {
+			line.cross = wc
+		}
+	}
+
+	if len(line.widgets) > 0 {
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+func (f *FlowLayout) toMainCross(w int, h int) (int, int) {
+	if f.direction == DirectionHorizontal {
+		return w, h
+	}
+	return h, w
+}
+
+func (f *FlowLayout) fromMainCross(main int, cross int) (int, int) {
+	return f.toMainCross(main, cross)
+}
@@ -0,0 +1,181 @@
+package widget
+
+import (
+	"image"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestFlowLayout_PreferredSize(t *testing.T) {
+	is := is.New(t)
+
+	padding := Insets{
+		Top:    10,
+		Left:   20,
+		Right:  30,
+		Bottom: 40,
+	}
+
+	l := newFlowLayout(t,
+		FlowLayoutOpts.Padding(padding),
+		FlowLayoutOpts.Spacing(5, 7))
+
+	widgets := []PreferredSizeLocateableWidget{
+		newSimpleWidget(10, 10, nil),
+		newSimpleWidget(20, 30, nil),
+		newSimpleWidget(30, 20, nil),
+	}
+
+	w, h := l.PreferredSize(widgets)
+
+	is.Equal(w, 10+5+20+5+30+padding.Dx())
+	is.Equal(h, 30+padding.Dy())
+}
+
+func TestFlowLayout_PreferredSizeForLineLength(t *testing.T) {
+	is := is.New(t)
+
+	l := NewFlowLayout(FlowLayoutOpts.Spacing(5, 7))
+
+	widgets := []PreferredSizeLocateableWidget{
+		newSimpleWidget(10, 10, nil),
+		newSimpleWidget(20, 30, nil),
+		newSimpleWidget(30, 20, nil),
+	}
+
+	w, h := l.PreferredSizeForLineLength(widgets, 40)
+
+	is.Equal(w, 10+5+20)
+	is.Equal(h, 30+7+20)
+}
+
+func TestFlowLayout_PreferredSize_AfterLayout(t *testing.T) {
+	is := is.New(t)
+
+	l := NewFlowLayout(FlowLayoutOpts.Spacing(5, 7))
+
+	widgets := []PreferredSizeLocateableWidget{
+		newSimpleWidget(10, 10, nil),
+		newSimpleWidget(20, 30, nil),
+		newSimpleWidget(30, 20, nil),
+	}
+
+	l.Layout(widgets, image.Rect(0, 0, 40, 100))
+	w, h := l.PreferredSize(widgets)
+
+	is.Equal(w, 10+5+20)
+	is.Equal(h, 30+7+20)
+}
+
+func TestFlowLayout_Layout(t *testing.T) {
+	is := is.New(t)
+
+	l := NewFlowLayout(
+		FlowLayoutOpts.Padding(NewInsetsSimple(10)),
+		FlowLayoutOpts.Spacing(5, 7))
+
+	widgets := []PreferredSizeLocateableWidget{
+		newSimpleWidget(10, 10, nil),
+		newSimpleWidget(20, 30, nil),
+		newSimpleWidget(30, 20, FlowLayoutData{
+			Position: FlowLayoutPositionCenter,
+		}),
+		newSimpleWidget(10, 10, FlowLayoutData{
+			Position: FlowLayoutPositionEnd,
+		}),
+		newSimpleWidget(10, 10, FlowLayoutData{
+			Stretch: true,
+		}),
+	}
+
+	l.Layout(widgets, image.Rect(0, 0, 80, 200))
+
+	expected := []image.Rectangle{
+		image.Rect(10, 10, 20, 20),
+		image.Rect(25, 10, 45, 40),
+		image.Rect(10, 47, 40, 67),
+		image.Rect(45, 57, 55, 67),
+		image.Rect(60, 47, 70, 67),
+	}
+
+	for i, r := range expected {
+		is.Equal(widgets[i].GetWidget().Rect, r)
+	}
+}
+
+func TestFlowLayout_Layout_LineAlignment(t *testing.T) {
+	is := is.New(t)
+
+	l := NewFlowLayout(
+		FlowLayoutOpts.Spacing(5, 7),
+		FlowLayoutOpts.LineAlignment(FlowLayoutPositionCenter))
+
+	widgets := []PreferredSizeLocateableWidget{
+		newSimpleWidget(20, 10, nil),
+		newSimpleWidget(20, 10, nil),
+		newSimpleWidget(30, 10, nil),
+	}
+
+	l.Layout(widgets, image.Rect(0, 0, 55, 100))
+
+	expected := []image.Rectangle{
+		image.Rect(5, 0, 25, 10),
+		image.Rect(30, 0, 50, 10),
+		image.Rect(12, 17, 42, 27),
+	}
+
+	for i, r := range expected {
+		is.Equal(widgets[i].GetWidget().Rect, r)
+	}
+}
+
+func TestFlowLayout_Layout_Vertical(t *testing.T) {
+	is := is.New(t)
+
+	l := NewFlowLayout(
+		FlowLayoutOpts.Direction(DirectionVertical),
+		FlowLayoutOpts.Spacing(5, 7))
+
+	widgets := []PreferredSizeLocateableWidget{
+		newSimpleWidget(10, 20, nil),
+		newSimpleWidget(30, 20, nil),
+		newSimpleWidget(10, 20, nil),
+	}
+
+	l.Layout(widgets, image.Rect(0, 0, 100, 50))
+
+	expected := []image.Rectangle{
+		image.Rect(0, 0, 10, 20),
+		image.Rect(0, 25, 30, 45),
+		image.Rect(37, 0, 47, 20),
+	}
+
+	for i, r := range expected {
+		is.Equal(widgets[i].GetWidget().Rect, r)
+	}
+}
+
+func TestFlowLayout_PreferredHeightForWidth(t *testing.T) {
+	is := is.New(t)
+
+	l := NewFlowLayout(
+		FlowLayoutOpts.Padding(NewInsetsSimple(10)),
+		FlowLayoutOpts.Spacing(5, 7))
+
+	widgets := []PreferredSizeLocateableWidget{
+		newSimpleWidget(10, 10, nil),
+		newSimpleWidget(20, 30, nil),
+		newSimpleWidget(30, 20, nil),
+	}
+
+	h := l.PreferredHeightForWidth(widgets, 40+20)
+
+	is.Equal(h, 30+7+20+20)
+}
+
+func newFlowLayout(t *testing.T, opts ...FlowLayoutOpt) Layouter {
+	t.Helper()
+	l := NewFlowLayout(opts...)
+	return l
+}
@@ -0,0 +1,254 @@
+package widget
+
+import (
+	"image"
+	"time"
+
+	"github.com/blizzy78/ebitenui/input"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// WidgetClickedEventArgs are the arguments for click events.
+type WidgetClickedEventArgs struct { //nolint:golint
+	Widget *Widget
+	EventPropagation
+
+	Button ebiten.MouseButton
+
+	// Count is the number of consecutive clicks, for example 2 for a double click.
+	Count int
+
+	// OffsetX is the x offset relative to the widget's Rect.
+	OffsetX int
+
+	// OffsetY is the y offset relative to the widget's Rect.
+	OffsetY int
+}
+
+// WidgetLongPressedEventArgs are the arguments for long-press events.
+type WidgetLongPressedEventArgs struct { //nolint:golint
+	Widget *Widget
+	Button ebiten.MouseButton
+
+	// OffsetX is the x offset relative to the widget's Rect.
+	OffsetX int
+
+	// OffsetY is the y offset relative to the widget's Rect.
+	OffsetY int
+}
+
+// WidgetHoverDwellEventArgs are the arguments for hover dwell events.
+type WidgetHoverDwellEventArgs struct { //nolint:golint
+	Widget *Widget
+
+	// OffsetX is the x offset relative to the widget's Rect.
+	OffsetX int
+
+	// OffsetY is the y offset relative to the widget's Rect.
+	OffsetY int
+}
+
+// WidgetClickedHandlerFunc is a function that handles click events.
+type WidgetClickedHandlerFunc func(args *WidgetClickedEventArgs) //nolint:golint
+
+// WidgetLongPressedHandlerFunc is a function that handles long-press events.
+type WidgetLongPressedHandlerFunc func(args *WidgetLongPressedEventArgs) //nolint:golint
+
+// WidgetHoverDwellHandlerFunc is a function that handles hover dwell events.
+type WidgetHoverDwellHandlerFunc func(args *WidgetHoverDwellEventArgs) //nolint:golint
+
+type gestureConfig struct {
+	clickInterval      time.Duration
+	distance           int
+	longPressDuration  time.Duration
+	hoverDwellDuration time.Duration
+}
+
+type mouseButtonState struct {
+	pressed      bool
+	pressedAt    time.Time
+	pressedPos   image.Point
+	moved        bool
+	longPressed  bool
+	clickCount   int
+	lastClickAt  time.Time
+	lastClickPos image.Point
+}
+
+type hoverDwellState struct {
+	active bool
+	since  time.Time
+	pos    image.Point
+	fired  bool
+}
+
+var defaultGestureConfig = gestureConfig{
+	clickInterval:      500 * time.Millisecond,
+	distance:           4,
+	longPressDuration:  500 * time.Millisecond,
+	hoverDwellDuration: time.Second,
+}
+
+// timeNow returns the current time. It is a variable so that tests can replace it.
+var timeNow = time.Now
+
+// ClickedHandler configures a Widget with click event handler f.
+func (o WidgetOptions) ClickedHandler(f WidgetClickedHandlerFunc) WidgetOpt {
+	return func(w *Widget) {
+		w.ClickedEvent.AddHandler(f)
+	}
+}
+
+// LongPressedHandler configures a Widget with long-press event handler f.
+func (o WidgetOptions) LongPressedHandler(f WidgetLongPressedHandlerFunc) WidgetOpt {
+	return func(w *Widget) {
+		w.LongPressedEvent.AddHandler(f)
+	}
+}
+
+// HoverDwellHandler configures a Widget with hover dwell event handler f.
+func (o WidgetOptions) HoverDwellHandler(f WidgetHoverDwellHandlerFunc) WidgetOpt {
+	return func(w *Widget) {
+		w.HoverDwellEvent.AddHandler(f)
+	}
+}
+
+// ClickInterval configures a Widget to count clicks as consecutive if they happen within d of each other.
+// The default is 500 milliseconds.
+func (o WidgetOptions) ClickInterval(d time.Duration) WidgetOpt {
+	return func(w *Widget) {
+		w.gestureConfig.clickInterval = d
+	}
+}
+
+// GestureDistance configures a Widget to tolerate cursor movement of up to d pixels when counting consecutive
+// clicks, detecting long presses, and detecting hover dwelling. The default is 4 pixels.
+func (o WidgetOptions) GestureDistance(d int) WidgetOpt {
+	return func(w *Widget) {
+		w.gestureConfig.distance = d
+	}
+}
+
+// LongPressDuration configures a Widget to fire LongPressedEvent after a mouse button has been held for d.
+// If d is 0, long presses are not detected. The default is 500 milliseconds.
+func (o WidgetOptions) LongPressDuration(d time.Duration) WidgetOpt {
+	return func(w *Widget) {
+		w.gestureConfig.longPressDuration = d
+	}
+}
+
+// HoverDwellDuration configures a Widget to fire HoverDwellEvent after the cursor has rested inside the
+// widget for d. If d is 0, hover dwelling is not detected. The default is 1 second.
+func (o WidgetOptions) HoverDwellDuration(d time.Duration) WidgetOpt {
+	return func(w *Widget) {
+		w.gestureConfig.hoverDwellDuration = d
+	}
+}
+
+func (w *Widget) fireMouseButtonEvents(b ebiten.MouseButton, p image.Point, inside bool, layer *input.Layer) {
+	s, ok := w.mouseButtonStates[b]
+	if !ok {
+		s = &mouseButtonState{}
+		w.mouseButtonStates[b] = s
+	}
+
+	now := timeNow()
+	off := p.Sub(w.Rect.Min)
+
+	if inside && input.MouseButtonJustPressedLayer(b, layer) {
+		s.pressed = true
+		s.pressedAt = now
+		s.pressedPos = p
+		s.moved = false
+		s.longPressed = false
+
+		w.fireMouseButtonPressedEvent(b, p)
+	}
+
+	if !s.pressed {
+		return
+	}
+
+	if !input.MouseButtonPressedLayer(b, layer) {
+		s.pressed = false
+
+		w.fireMouseButtonReleasedEvent(b, p)
+
+		if inside && !s.longPressed {
+			w.fireClickedEvent(b, s, p, now)
+		}
+
+		return
+	}
+
+	if !withinDistance(p, s.pressedPos, w.gestureConfig.distance) {
+		s.moved = true
+	}
+
+	if inside && !s.moved && !s.longPressed &&
+		w.gestureConfig.longPressDuration > 0 && now.Sub(s.pressedAt) >= w.gestureConfig.longPressDuration {
+
+		s.longPressed = true
+		s.clickCount = 0
+
+		w.LongPressedEvent.Fire(&WidgetLongPressedEventArgs{
+			Widget:  w,
+			Button:  b,
+			OffsetX: off.X,
+			OffsetY: off.Y,
+		})
+	}
+}
+
+func (w *Widget) fireClickedEvent(b ebiten.MouseButton, s *mouseButtonState, p image.Point, now time.Time) {
+	if s.clickCount > 0 && now.Sub(s.lastClickAt) <= w.gestureConfig.clickInterval &&
+		withinDistance(p, s.lastClickPos, w.gestureConfig.distance) {
+
+		s.clickCount++
+	} else {
+		s.clickCount = 1
+	}
+
+	s.lastClickAt = now
+	s.lastClickPos = p
+
+	w.fireClickedEventWithCount(b, p, s.clickCount)
+}
+
+func (w *Widget) fireHoverDwellEvent(p image.Point, entered bool) {
+	h := &w.hoverDwell
+
+	if !entered || w.gestureConfig.hoverDwellDuration <= 0 {
+		h.active = false
+		return
+	}
+
+	now := timeNow()
+
+	if !h.active || !withinDistance(p, h.pos, w.gestureConfig.distance) {
+		h.active = true
+		h.since = now
+		h.pos = p
+		h.fired = false
+		return
+	}
+
+	if h.fired || now.Sub(h.since) < w.gestureConfig.hoverDwellDuration {
+		return
+	}
+
+	h.fired = true
+
+	off := p.Sub(w.Rect.Min)
+	w.HoverDwellEvent.Fire(&WidgetHoverDwellEventArgs{
+		Widget:  w,
+		OffsetX: off.X,
+		OffsetY: off.Y,
+	})
+}
+
+func withinDistance(p1 image.Point, p2 image.Point, d int) bool {
+	dp := p1.Sub(p2)
+	return dp.X*dp.X+dp.Y*dp.Y <= d*d
+}
@@ -0,0 +1,166 @@
+package widget
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestWidget_ClickedEvent_Count(t *testing.T) {
+	is := is.New(t)
+
+	now := fakeTime(t)
+
+	counts := []int{}
+
+	w := newWidget(t,
+		WidgetOpts.ClickInterval(300*time.Millisecond),
+		WidgetOpts.ClickedHandler(func(args *WidgetClickedEventArgs) {
+			counts = append(counts, args.Count)
+		}))
+	w.Rect = image.Rect(0, 0, 100, 100)
+
+	for i := 0; i < 3; i++ {
+		mouseButtonInput(w, ebiten.MouseButtonLeft, true, 10, 10, t)
+		mouseButtonInput(w, ebiten.MouseButtonLeft, false, 10, 10, t)
+		*now = now.Add(200 * time.Millisecond)
+	}
+
+	*now = now.Add(time.Second)
+	mouseButtonInput(w, ebiten.MouseButtonLeft, true, 10, 10, t)
+	mouseButtonInput(w, ebiten.MouseButtonLeft, false, 10, 10, t)
+
+	is.Equal(counts, []int{1, 2, 3, 1})
+}
+
+func TestWidget_ClickedEvent_Count_Distance(t *testing.T) {
+	is := is.New(t)
+
+	fakeTime(t)
+
+	counts := []int{}
+
+	w := newWidget(t,
+		WidgetOpts.GestureDistance(5),
+		WidgetOpts.ClickedHandler(func(args *WidgetClickedEventArgs) {
+			counts = append(counts, args.Count)
+		}))
+	w.Rect = image.Rect(0, 0, 100, 100)
+
+	mouseButtonInput(w, ebiten.MouseButtonRight, true, 10, 10, t)
+	mouseButtonInput(w, ebiten.MouseButtonRight, false, 10, 10, t)
+	mouseButtonInput(w, ebiten.MouseButtonRight, true, 30, 10, t)
+	mouseButtonInput(w, ebiten.MouseButtonRight, false, 30, 10, t)
+
+	is.Equal(counts, []int{1, 1})
+}
+
+func TestWidget_LongPressedEvent(t *testing.T) {
+	is := is.New(t)
+
+	now := fakeTime(t)
+
+	var longPressedArgs *WidgetLongPressedEventArgs
+	var clickedArgs *WidgetClickedEventArgs
+
+	w := newWidget(t,
+		WidgetOpts.LongPressDuration(time.Second),
+		WidgetOpts.LongPressedHandler(func(args *WidgetLongPressedEventArgs) {
+			longPressedArgs = args
+		}),
+		WidgetOpts.ClickedHandler(func(args *WidgetClickedEventArgs) {
+			clickedArgs = args
+		}))
+	w.Rect = image.Rect(0, 0, 100, 100)
+
+	mouseButtonInput(w, ebiten.MouseButtonLeft, true, 10, 10, t)
+
+	*now = now.Add(500 * time.Millisecond)
+	cursorInput(w, 10, 10, t)
+	is.True(longPressedArgs == nil)
+
+	*now = now.Add(500 * time.Millisecond)
+	cursorInput(w, 10, 10, t)
+	is.True(longPressedArgs != nil)
+	is.Equal(longPressedArgs.Button, ebiten.MouseButtonLeft)
+
+	mouseButtonInput(w, ebiten.MouseButtonLeft, false, 10, 10, t)
+	is.True(clickedArgs == nil)
+}
+
+func TestWidget_LongPressedEvent_Moved(t *testing.T) {
+	is := is.New(t)
+
+	now := fakeTime(t)
+
+	var eventArgs *WidgetLongPressedEventArgs
+
+	w := newWidget(t,
+		WidgetOpts.LongPressDuration(time.Second),
+		WidgetOpts.LongPressedHandler(func(args *WidgetLongPressedEventArgs) {
+			eventArgs = args
+		}))
+	w.Rect = image.Rect(0, 0, 100, 100)
+
+	mouseButtonInput(w, ebiten.MouseButtonLeft, true, 10, 10, t)
+	cursorInput(w, 50, 50, t)
+
+	*now = now.Add(2 * time.Second)
+	cursorInput(w, 10, 10, t)
+	mouseButtonInput(w, ebiten.MouseButtonLeft, false, 10, 10, t)
+
+	is.True(eventArgs == nil)
+}
+
+func TestWidget_HoverDwellEvent(t *testing.T) {
+	is := is.New(t)
+
+	now := fakeTime(t)
+
+	count := 0
+
+	w := newWidget(t,
+		WidgetOpts.HoverDwellDuration(time.Second),
+		WidgetOpts.HoverDwellHandler(func(args *WidgetHoverDwellEventArgs) {
+			count++
+		}))
+	w.Rect = image.Rect(0, 0, 100, 100)
+
+	cursorInput(w, 10, 10, t)
+
+	*now = now.Add(500 * time.Millisecond)
+	cursorInput(w, 30, 30, t)
+
+	*now = now.Add(500 * time.Millisecond)
+	cursorInput(w, 30, 30, t)
+	is.Equal(count, 0)
+
+	*now = now.Add(500 * time.Millisecond)
+	cursorInput(w, 31, 30, t)
+	is.Equal(count, 1)
+
+	*now = now.Add(time.Second)
+	cursorInput(w, 31, 30, t)
+	is.Equal(count, 1)
+
+	cursorInput(w, 200, 200, t)
+}
+
+// fakeTime replaces the current time used by widgets with the returned value for the duration of the test.
+func fakeTime(t *testing.T) *time.Time {
+	t.Helper()
+
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time {
+		return now
+	}
+
+	t.Cleanup(func() {
+		timeNow = time.Now
+	})
+
+	return &now
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/blizzy78/ebitenui/image"
 
 	img "image"
+	"math"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
@@ -12,10 +13,15 @@ type Graphic struct {
 	Image          *ebiten.Image
 	ImageNineSlice *image.NineSlice
 
-	widgetOpts []WidgetOpt
+	widgetOpts      []WidgetOpt
+	keepAspectRatio bool
 
-	init   *MultiOnce
-	widget *Widget
+	// buttonGraphic is set for the graphics of buttons, whose images are updated by the buttons themselves.
+	buttonGraphic bool
+
+	init          *MultiOnce
+	widget        *Widget
+	preferredSize *img.Point
 }
 
 type GraphicOpt func(g *Graphic)
@@ -57,6 +63,14 @@ func (o GraphicOptions) ImageNineSlice(i *image.NineSlice) GraphicOpt {
 	}
 }
 
+// KeepAspectRatio configures a Graphic to scale Image to the size of the widget, while keeping its
+// aspect ratio. The preferred height for a given width is then determined by the aspect ratio as well.
+func (o GraphicOptions) KeepAspectRatio() GraphicOpt {
+	return func(g *Graphic) {
+		g.keepAspectRatio = true
+	}
+}
+
 func (g *Graphic) GetWidget() *Widget {
 	g.init.Do()
 	return g.widget
@@ -69,28 +83,76 @@ func (g *Graphic) SetLocation(rect img.Rectangle) {
 
 func (g *Graphic) PreferredSize() (int, int) {
 	g.init.Do()
-	if g.Image != nil {
-		return g.Image.Size()
+
+	s := g.size()
+	g.preferredSize = &s
+	return s.X, s.Y
+}
+
+// PreferredHeightForWidth implements PreferredHeightForWidther.
+func (g *Graphic) PreferredHeightForWidth(width int) int {
+	g.init.Do()
+
+	s := g.size()
+	if i, _ := g.images(); !g.keepAspectRatio || i == nil || s.X == 0 {
+		return s.Y
 	}
-	return 50, 50
+
+	return int(math.Round(float64(width) * float64(s.Y) / float64(s.X)))
+}
+
+func (g *Graphic) size() img.Point {
+	if i, _ := g.images(); i != nil {
+		w, h := i.Size()
+		return img.Point{w, h}
+	}
+	return img.Point{50, 50}
 }
 
 func (g *Graphic) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 	g.init.Do()
+
+	// Image may have been replaced since the preferred size was last used for layout
+	if g.preferredSize != nil && g.size() != *g.preferredSize {
+		g.preferredSize = nil
+		g.widget.requestParentRelayout()
+	}
+
 	g.widget.Render(screen, def)
 	g.draw(screen)
 }
 
 func (g *Graphic) draw(screen *ebiten.Image) {
-	if g.Image != nil {
+	i, ns := g.images()
+
+	if i != nil {
 		opts := ebiten.DrawImageOptions{}
-		w, h := g.Image.Size()
+		w, h := i.Size()
+
+		if g.keepAspectRatio && w > 0 && h > 0 {
+			scale := math.Min(float64(g.widget.Rect.Dx())/float64(w), float64(g.widget.Rect.Dy())/float64(h))
+			opts.GeoM.Scale(scale, scale)
+			w, h = int(math.Round(float64(w)*scale)), int(math.Round(float64(h)*scale))
+		}
+
 		opts.GeoM.Translate(float64((g.widget.Rect.Dx()-w)/2), float64((g.widget.Rect.Dy()-h)/2))
 		g.widget.drawImageOptions(&opts)
-		screen.DrawImage(g.Image, &opts)
-	} else if g.ImageNineSlice != nil {
-		g.ImageNineSlice.Draw(screen, g.widget.Rect.Dx(), g.widget.Rect.Dy(), g.widget.drawImageOptions)
+		screen.DrawImage(i, &opts)
+	} else if ns != nil {
+		ns.Draw(screen, g.widget.Rect.Dx(), g.widget.Rect.Dy(), g.widget.drawImageOptions)
+	}
+}
+
+// images returns g.Image and g.ImageNineSlice, or the images of g's theme if both are nil.
+func (g *Graphic) images() (*ebiten.Image, *image.NineSlice) {
+	if g.Image != nil || g.ImageNineSlice != nil || g.buttonGraphic {
+		return g.Image, g.ImageNineSlice
+	}
+
+	if t := themeSection(g.widget, func(t *Theme) *GraphicTheme { return t.Graphic }); t != nil {
+		return t.Image, t.ImageNineSlice
 	}
+	return nil, nil
 }
 
 func (g *Graphic) createWidget() {
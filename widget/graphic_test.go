@@ -17,6 +17,23 @@ func TestGraphic_PreferredSize(t *testing.T) {
 	is.Equal(h, i.Bounds().Dy())
 }
 
+func TestGraphic_PreferredHeightForWidth(t *testing.T) {
+	is := is.New(t)
+
+	g := newGraphic(t, GraphicOpts.Image(newImageEmptySize(40, 20, t)))
+	is.Equal(g.PreferredHeightForWidth(80), 20)
+}
+
+func TestGraphic_PreferredHeightForWidth_KeepAspectRatio(t *testing.T) {
+	is := is.New(t)
+
+	g := newGraphic(t,
+		GraphicOpts.Image(newImageEmptySize(40, 20, t)),
+		GraphicOpts.KeepAspectRatio())
+	is.Equal(g.PreferredHeightForWidth(80), 40)
+	is.Equal(g.PreferredHeightForWidth(20), 10)
+}
+
 func newGraphic(t *testing.T, opts ...GraphicOpt) *Graphic {
 	t.Helper()
 
@@ -105,25 +105,26 @@ func (g *GridLayout) PreferredSize(widgets []PreferredSizeLocateableWidget) (int
 		g.padding.Dy() + g.rowSpacing*(len(rowHeights)-1) + sumInts(rowHeights)
 }
 
+// PreferredHeightForWidth implements HeightForWidthLayouter.
+func (g *GridLayout) PreferredHeightForWidth(widgets []PreferredSizeLocateableWidget, width int) int {
+	colWidths := g.columnWidths(widgets, width-g.padding.Dx())
+	rowHeights := g.rowHeightsForColumnWidths(widgets, colWidths)
+	return g.padding.Dy() + g.rowSpacing*(len(rowHeights)-1) + sumInts(rowHeights)
+}
+
 // Layout implements Layouter.
 func (g *GridLayout) Layout(widgets []PreferredSizeLocateableWidget, rect image.Rectangle) {
 	rect = g.padding.Apply(rect)
 
-	colWidths, rowHeights := g.preferredColumnWidthsAndRowHeights(widgets)
-	stretchedColWidth, stretchedRowHeight, firstStretchedColWidth, firstStretchedRowHeight := g.stretchedCellSizes(colWidths, rowHeights, rect)
+	colWidths := g.columnWidths(widgets, rect.Dx())
+	rowHeights := g.rowHeightsForColumnWidths(widgets, colWidths)
+	_, stretchedRowHeight, _, firstStretchedRowHeight := g.stretchedCellSizes(colWidths, rowHeights, rect)
 
 	c, r := 0, 0
 	x, y := 0, 0
-	firstStretchedCol, firstStretchedRow := true, true
+	firstStretchedRow := true
 	for _, w := range widgets {
 		cw := colWidths[c]
-		if g.columnStretched(c) {
-			cw = stretchedColWidth
-			if firstStretchedCol {
-				cw = firstStretchedColWidth
-				firstStretchedCol = false
-			}
-		}
 
 		ch := rowHeights[r]
 		if g.rowStretched(r) {
@@ -152,11 +153,62 @@ func (g *GridLayout) Layout(widgets []PreferredSizeLocateableWidget, rect image.
 			r++
 			x = 0
 			y += ch + g.rowSpacing
-			firstStretchedCol = true
 		}
 	}
 }
 
+// columnWidths returns the widths of all columns when layouting widgets using width, with stretched
+// columns taking up the remaining width.
+func (g *GridLayout) columnWidths(widgets []PreferredSizeLocateableWidget, width int) []int {
+	colWidths, rowHeights := g.preferredColumnWidthsAndRowHeights(widgets)
+	stretchedColWidth, _, firstStretchedColWidth, _ := g.stretchedCellSizes(colWidths, rowHeights, image.Rect(0, 0, width, 0))
+
+	firstStretchedCol := true
+	for c := range colWidths {
+		if !g.columnStretched(c) {
+			continue
+		}
+
+		colWidths[c] = stretchedColWidth
+		if firstStretchedCol {
+			colWidths[c] = firstStretchedColWidth
+			firstStretchedCol = false
+		}
+	}
+
+	return colWidths
+}
+
+// rowHeightsForColumnWidths returns the preferred heights of all rows when columns are colWidths wide.
+func (g *GridLayout) rowHeightsForColumnWidths(widgets []PreferredSizeLocateableWidget, colWidths []int) []int {
+	rowHeights := make([]int, int(math.Ceil(float64(len(widgets))/float64(g.columns))))
+
+	c := 0
+	r := 0
+	for _, w := range widgets {
+		ww := colWidths[c]
+
+		ld, _ := w.GetWidget().LayoutData.(GridLayoutData)
+		if ld.MaxWidth > 0 && ww > ld.MaxWidth {
+			ww = ld.MaxWidth
+		}
+
+		_, wh := g.applyMaxSize(ld, ww, PreferredHeightForWidth(w, ww))
+
+		if wh > rowHeights[r] {
+			rowHeights[r] = wh
+		}
+
+		c++
+		if c >= g.columns {
+			c = 0
+			r++
+		}
+	}
+
+	return rowHeights
+}
+
 func (g *GridLayout) stretchedCellSizes(colWidths []int, rowHeights []int, rect image.Rectangle) (int, int, int, int) {
 	stretchedColWidth, stretchedRowHeight := 0, 0
 
@@ -0,0 +1,95 @@
+package widget
+
+import (
+	"image"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestGridLayout_PreferredSize(t *testing.T) {
+	is := is.New(t)
+
+	l := NewGridLayout(
+		GridLayoutOpts.Columns(2),
+		GridLayoutOpts.Padding(NewInsetsSimple(10)),
+		GridLayoutOpts.Spacing(5, 7))
+
+	widgets := []PreferredSizeLocateableWidget{
+		newSimpleWidget(10, 20, nil),
+		newSimpleWidget(30, 10, nil),
+		newSimpleWidget(20, 40, GridLayoutData{
+			MaxHeight: 30,
+		}),
+	}
+
+	w, h := l.PreferredSize(widgets)
+
+	is.Equal(w, 20+5+30+20)
+	is.Equal(h, 20+7+30+20)
+}
+
+func TestGridLayout_Layout(t *testing.T) {
+	is := is.New(t)
+
+	l := NewGridLayout(
+		GridLayoutOpts.Columns(2),
+		GridLayoutOpts.Spacing(5, 7),
+		GridLayoutOpts.Stretch([]bool{false, true}, nil))
+
+	widgets := []PreferredSizeLocateableWidget{
+		newSimpleWidget(10, 20, nil),
+		newSimpleWidget(30, 10, nil),
+		newSimpleWidget(20, 40, nil),
+	}
+
+	l.Layout(widgets, image.Rect(0, 0, 100, 100))
+
+	expected := []image.Rectangle{
+		image.Rect(0, 0, 20, 20),
+		image.Rect(25, 0, 100, 20),
+		image.Rect(0, 27, 20, 67),
+	}
+
+	for i, r := range expected {
+		is.Equal(widgets[i].GetWidget().Rect, r)
+	}
+}
+
+func TestGridLayout_PreferredHeightForWidth(t *testing.T) {
+	is := is.New(t)
+
+	l := NewGridLayout(
+		GridLayoutOpts.Columns(2),
+		GridLayoutOpts.Padding(NewInsetsSimple(10)),
+		GridLayoutOpts.Spacing(5, 7),
+		GridLayoutOpts.Stretch([]bool{false, true}, nil))
+
+	widgets := []PreferredSizeLocateableWidget{
+		newSimpleWidget(10, 20, nil),
+		newHeightForWidthWidget(20, 40, nil),
+	}
+
+	h := l.PreferredHeightForWidth(widgets, 20+10+5+80)
+
+	is.Equal(h, 20+20)
+}
+
+func TestGridLayout_Layout_HeightForWidth(t *testing.T) {
+	is := is.New(t)
+
+	l := NewGridLayout(
+		GridLayoutOpts.Columns(2),
+		GridLayoutOpts.Spacing(5, 7),
+		GridLayoutOpts.Stretch([]bool{false, true}, nil))
+
+	widgets := []PreferredSizeLocateableWidget{
+		newSimpleWidget(10, 5, nil),
+		newHeightForWidthWidget(20, 40, nil),
+	}
+
+	l.Layout(widgets, image.Rect(0, 0, 95, 100))
+
+	is.Equal(widgets[0].GetWidget().Rect, image.Rect(0, 0, 10, 10))
+	is.Equal(widgets[1].GetWidget().Rect, image.Rect(15, 0, 95, 10))
+}
@@ -64,6 +64,12 @@ func (l *Label) GetWidget() *Widget {
 	return l.text.GetWidget()
 }
 
+// Children implements Composite. It returns l's Text, which shares l's Widget.
+func (l *Label) Children() []HasWidget {
+	l.init.Do()
+	return []HasWidget{l.text}
+}
+
 func (l *Label) SetLocation(rect image.Rectangle) {
 	l.init.Do()
 	l.text.SetLocation(rect)
@@ -71,25 +77,57 @@ func (l *Label) SetLocation(rect image.Rectangle) {
 
 func (l *Label) PreferredSize() (int, int) {
 	l.init.Do()
+	l.applyTheme()
 	return l.text.PreferredSize()
 }
 
+// PreferredHeightForWidth implements PreferredHeightForWidther.
+func (l *Label) PreferredHeightForWidth(width int) int {
+	l.init.Do()
+	l.applyTheme()
+	return l.text.PreferredHeightForWidth(width)
+}
+
 func (l *Label) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 	l.init.Do()
+	l.applyTheme()
+	l.text.Render(screen, def)
+}
 
+// applyTheme updates l's text according to l's state, its configuration, and its theme.
+func (l *Label) applyTheme() {
 	l.text.Label = l.Label
 
-	if l.text.GetWidget().Disabled {
-		l.text.Color = l.color.Disabled
-	} else {
-		l.text.Color = l.color.Idle
+	face, col := l.face, l.color
+	if t := l.theme(); t != nil {
+		if face == nil {
+			face = t.Face
+		}
+		if col == nil {
+			col = t.Color
+		}
 	}
 
-	l.text.Render(screen, def)
+	l.text.Face = face
+
+	if col != nil {
+		if l.text.GetWidget().Disabled {
+			l.text.Color = col.Disabled
+		} else {
+			l.text.Color = col.Idle
+		}
+	}
+}
+
+func (l *Label) theme() *LabelTheme {
+	return themeSection(l.text.GetWidget(), func(t *Theme) *LabelTheme {
+		return t.Label
+	})
 }
 
 func (l *Label) createWidget() {
-	l.text = NewText(append(l.textOpts, TextOpts.Text(l.Label, l.face, l.color.Idle))...)
+	l.text = NewText(append(l.textOpts, TextOpts.Text(l.Label, nil, nil))...)
 	l.textOpts = nil
-	l.face = nil
+
+	l.text.GetWidget().owner = l
 }
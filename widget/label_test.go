@@ -30,6 +30,16 @@ func TestLabel_SetDisabled_Color(t *testing.T) {
 	is.Equal(labelText(l).Color, color.Black)
 }
 
+func TestLabel_PreferredHeightForWidth_Wrap(t *testing.T) {
+	is := is.New(t)
+
+	l := newLabel(t, LabelOpts.TextOpts(TextOpts.Wrap()))
+	l.Label = "foo bar baz"
+
+	w, h := l.PreferredSize()
+	is.True(l.PreferredHeightForWidth(w/2) > h)
+}
+
 func newLabel(t *testing.T, opts ...LabelOpt) *Label {
 	t.Helper()
 
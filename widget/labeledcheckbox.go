@@ -64,6 +64,12 @@ func (l *LabeledCheckbox) GetWidget() *Widget {
 	return l.container.GetWidget()
 }
 
+// Children implements Composite.
+func (l *LabeledCheckbox) Children() []HasWidget {
+	l.init.Do()
+	return l.container.Children()
+}
+
 func (l *LabeledCheckbox) PreferredSize() (int, int) {
 	l.init.Do()
 	return l.container.PreferredSize()
@@ -84,6 +90,12 @@ func (l *LabeledCheckbox) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 	l.container.Render(screen, def)
 }
 
+// Focus implements Focuser by focusing l's checkbox.
+func (l *LabeledCheckbox) Focus(focused bool) {
+	l.init.Do()
+	l.checkbox.Focus(focused)
+}
+
 func (l *LabeledCheckbox) Checkbox() *Checkbox {
 	return l.checkbox
 }
@@ -118,4 +130,6 @@ func (l *LabeledCheckbox) createWidget() {
 	)))...)
 	l.container.AddChild(l.label)
 	l.labelOpts = nil
+
+	l.container.GetWidget().owner = l
 }
@@ -9,6 +9,19 @@ type Layouter interface {
 	Layout(widgets []PreferredSizeLocateableWidget, rect image.Rectangle)
 }
 
+// HeightForWidthLayouter may be implemented by Layouters whose preferred height depends on the available width.
+type HeightForWidthLayouter interface {
+	// PreferredHeightForWidth returns the preferred height of widgets when layouted using width.
+	PreferredHeightForWidth(widgets []PreferredSizeLocateableWidget, width int) int
+}
+
+// PreferredHeightForWidther may be implemented by concrete widget types whose preferred height depends on
+// the available width, for example widgets containing wrapped text.
+type PreferredHeightForWidther interface {
+	// PreferredHeightForWidth returns the preferred height when the widget is width pixels wide.
+	PreferredHeightForWidth(width int) int
+}
+
 type Relayoutable interface {
 	RequestRelayout()
 }
@@ -35,6 +48,17 @@ const (
 	DirectionVertical
 )
 
+// PreferredHeightForWidth returns the preferred height of w when it is width pixels wide. If w does not
+// implement PreferredHeightForWidther, the height returned by w.PreferredSize is used.
+func PreferredHeightForWidth(w PreferredSizer, width int) int {
+	if h, ok := w.(PreferredHeightForWidther); ok {
+		return h.PreferredHeightForWidth(width)
+	}
+
+	_, h := w.PreferredSize()
+	return h
+}
+
 func NewInsetsSimple(widthHeight int) Insets {
 	return Insets{
 		Top:    widthHeight,
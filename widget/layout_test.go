@@ -53,3 +53,10 @@ func TestInsets_Dy(t *testing.T) {
 
 	is.Equal(i.Dy(), 70)
 }
+
+func TestPreferredHeightForWidth(t *testing.T) {
+	is := is.New(t)
+
+	is.Equal(PreferredHeightForWidth(newSimpleWidget(20, 30, nil), 10), 30)
+	is.Equal(PreferredHeightForWidth(newHeightForWidthWidget(20, 30, nil), 10), 60)
+}
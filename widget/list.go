@@ -14,31 +14,34 @@ import (
 )
 
 type List struct {
-	EntrySelectedEvent *event.Event
-
-	containerOpts            []ContainerOpt
-	scrollContainerOpts      []ScrollContainerOpt
-	sliderOpts               []SliderOpt
-	entries                  []interface{}
-	entryLabelFunc           ListEntryLabelFunc
-	entryFace                font.Face
-	entryUnselectedColor     *ButtonImage
-	entrySelectedColor       *ButtonImage
-	entryUnselectedTextColor *ButtonTextColor
-	entryTextColor           *ButtonTextColor
-	entryTextPadding         Insets
-	controlWidgetSpacing     int
-	hideHorizontalSlider     bool
-	hideVerticalSlider       bool
-	allowReselect            bool
-
-	init            *MultiOnce
-	container       *Container
-	scrollContainer *ScrollContainer
-	vSlider         *Slider
-	hSlider         *Slider
-	buttons         []*Button
-	selectedEntry   interface{}
+	EntrySelectedEvent *event.Of[*ListEntrySelectedEventArgs]
+
+	containerOpts        []ContainerOpt
+	scrollContainerOpts  []ScrollContainerOpt
+	sliderOpts           []SliderOpt
+	entries              []interface{}
+	entryLabelFunc       ListEntryLabelFunc
+	entryFace            font.Face
+	entryColor           *ListEntryColor
+	entryTextPadding     *Insets
+	controlWidgetSpacing int
+	hideHorizontalSlider bool
+	hideVerticalSlider   bool
+	allowReselect        bool
+	themeSections        []func(t *Theme) *ListTheme
+
+	init                 *MultiOnce
+	container            *Container
+	scrollContainer      *ScrollContainer
+	scrollContainerImage *ScrollContainerImage
+	vSlider              *Slider
+	hSlider              *Slider
+	sliderTrackImage     *SliderTrackImage
+	sliderHandleImage    *ButtonImage
+	buttons              []*Button
+	selectedEntry        interface{}
+	entryStyle           *listEntryStyle
+	focused              bool
 }
 
 type ListOpt func(l *List)
@@ -54,6 +57,15 @@ type ListEntryColor struct {
 	DisabledSelectedBackground color.Color
 }
 
+// listEntryStyle contains the button images and text colors of a list's entries, as derived from a ListEntryColor.
+type listEntryStyle struct {
+	color               *ListEntryColor
+	unselectedImage     *ButtonImage
+	selectedImage       *ButtonImage
+	unselectedTextColor *ButtonTextColor
+	selectedTextColor   *ButtonTextColor
+}
+
 type ListEntrySelectedEventArgs struct {
 	List          *List
 	Entry         interface{}
@@ -69,7 +81,7 @@ var ListOpts ListOptions
 
 func NewList(opts ...ListOpt) *List {
 	l := &List{
-		EntrySelectedEvent: &event.Event{},
+		EntrySelectedEvent: &event.Of[*ListEntrySelectedEventArgs]{},
 
 		init: &MultiOnce{},
 	}
@@ -139,39 +151,19 @@ func (o ListOptions) EntryFontFace(f font.Face) ListOpt {
 
 func (o ListOptions) EntryColor(c *ListEntryColor) ListOpt {
 	return func(l *List) {
-		l.entryUnselectedColor = &ButtonImage{
-			Idle:     image.NewNineSliceColor(color.Transparent),
-			Disabled: image.NewNineSliceColor(color.Transparent),
-		}
-
-		l.entrySelectedColor = &ButtonImage{
-			Idle:     image.NewNineSliceColor(c.SelectedBackground),
-			Disabled: image.NewNineSliceColor(c.DisabledSelectedBackground),
-		}
-
-		l.entryUnselectedTextColor = &ButtonTextColor{
-			Idle:     c.Unselected,
-			Disabled: c.DisabledUnselected,
-		}
-
-		l.entryTextColor = &ButtonTextColor{
-			Idle:     c.Selected,
-			Disabled: c.DisabledSelected,
-		}
+		l.entryColor = c
 	}
 }
 
 func (o ListOptions) EntryTextPadding(i Insets) ListOpt {
 	return func(l *List) {
-		l.entryTextPadding = i
+		l.entryTextPadding = &i
 	}
 }
 
 func (o ListOptions) EntrySelectedHandler(f ListEntrySelectedHandlerFunc) ListOpt {
 	return func(l *List) {
-		l.EntrySelectedEvent.AddHandler(func(args interface{}) {
-			f(args.(*ListEntrySelectedEventArgs))
-		})
+		l.EntrySelectedEvent.AddHandler(f)
 	}
 }
 
@@ -186,8 +178,15 @@ func (l *List) GetWidget() *Widget {
 	return l.container.GetWidget()
 }
 
+// Children implements Composite.
+func (l *List) Children() []HasWidget {
+	l.init.Do()
+	return l.container.Children()
+}
+
 func (l *List) PreferredSize() (int, int) {
 	l.init.Do()
+	l.applyTheme()
 	return l.container.PreferredSize()
 }
 
@@ -220,9 +219,169 @@ func (l *List) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 
 	l.scrollContainer.GetWidget().Disabled = d
 
+	l.handleActions()
+
+	l.applyTheme()
+
 	l.container.Render(screen, def)
 }
 
+// Focus implements Focuser. While focused, input.ActionUp and input.ActionDown select the previous or
+// next entry.
+func (l *List) Focus(focused bool) {
+	l.init.Do()
+	WidgetFireFocusEvent(l.container.GetWidget(), focused)
+	l.focused = focused
+}
+
+func (l *List) handleActions() {
+	if !l.focused || l.container.GetWidget().Disabled {
+		return
+	}
+
+	m := l.container.GetWidget().ActionMap()
+
+	var e interface{}
+	var ok bool
+	switch {
+	case m.ActionJustPressed(input.ActionUp):
+		e, ok = l.adjacentEntry(-1)
+	case m.ActionJustPressed(input.ActionDown):
+		e, ok = l.adjacentEntry(1)
+	}
+
+	if ok {
+		l.setSelectedEntry(e, true)
+	}
+}
+
+// adjacentEntry returns the entry d entries away from the selected entry. If no entry is selected, it
+// returns the first entry for d > 0, or the last entry otherwise. It returns false if there is no such entry.
+func (l *List) adjacentEntry(d int) (interface{}, bool) {
+	if len(l.entries) == 0 {
+		return nil, false
+	}
+
+	i := -1
+	for ei, e := range l.entries {
+		if e == l.selectedEntry {
+			i = ei
+			break
+		}
+	}
+
+	switch {
+	case i < 0 && d > 0:
+		i = 0
+	case i < 0:
+		i = len(l.entries) - 1
+	default:
+		i += d
+	}
+
+	if i < 0 || i >= len(l.entries) {
+		return nil, false
+	}
+
+	return l.entries[i], true
+}
+
+// applyTheme updates l's entry buttons, scroll container, and sliders according to l's configuration and its theme.
+func (l *List) applyTheme() {
+	if l.scrollContainerImage == nil {
+		l.scrollContainer.image = listThemeValue(l, func(t *ListTheme) *ScrollContainerImage { return t.ScrollContainerImage })
+	}
+
+	for _, s := range []*Slider{l.vSlider, l.hSlider} {
+		if s == nil {
+			continue
+		}
+		if l.sliderTrackImage == nil {
+			s.trackImage = listThemeValue(l, func(t *ListTheme) *SliderTrackImage { return t.SliderTrackImage })
+		}
+		if l.sliderHandleImage == nil {
+			s.handleImage = listThemeValue(l, func(t *ListTheme) *ButtonImage { return t.SliderHandleImage })
+		}
+	}
+
+	face := l.entryFace
+	if face == nil {
+		face = listThemeValue(l, func(t *ListTheme) font.Face { return t.EntryFace })
+	}
+
+	padding := l.entryTextPadding
+	if padding == nil {
+		padding = listThemeValue(l, func(t *ListTheme) *Insets { return t.EntryTextPadding })
+	}
+	if padding == nil {
+		padding = &Insets{}
+	}
+
+	col := l.entryColor
+	if col == nil {
+		col = listThemeValue(l, func(t *ListTheme) *ListEntryColor { return t.EntryColor })
+	}
+
+	st := l.style(col)
+
+	for i, b := range l.buttons {
+		if l.entries[i] == l.selectedEntry {
+			b.Image = st.selectedImage
+			b.TextColor = st.selectedTextColor
+		} else {
+			b.Image = st.unselectedImage
+			b.TextColor = st.unselectedTextColor
+		}
+
+		b.textFace = face
+		b.textPadding = padding
+	}
+}
+
+// listThemeValue returns the first non-zero value returned by v for the theme sections of l's composite widget,
+// such as ListComboButton, and then for the List section.
+func listThemeValue[V any](l *List, v func(t *ListTheme) V) V {
+	sections := append(l.themeSections[:len(l.themeSections):len(l.themeSections)], func(t *Theme) *ListTheme {
+		return t.List
+	})
+	return themeValue(l.container.GetWidget(), sections, v)
+}
+
+// style returns the style of l's entries for entry color c. The style is reused as long as c does not change.
+func (l *List) style(c *ListEntryColor) *listEntryStyle {
+	if l.entryStyle != nil && l.entryStyle.color == c {
+		return l.entryStyle
+	}
+
+	l.entryStyle = &listEntryStyle{
+		color: c,
+	}
+
+	if c != nil {
+		l.entryStyle.unselectedImage = &ButtonImage{
+			Idle:     image.NewNineSliceColor(color.Transparent),
+			Disabled: image.NewNineSliceColor(color.Transparent),
+		}
+
+		l.entryStyle.selectedImage = &ButtonImage{
+			Idle:     image.NewNineSliceColor(c.SelectedBackground),
+			Disabled: image.NewNineSliceColor(c.DisabledSelectedBackground),
+		}
+
+		l.entryStyle.unselectedTextColor = &ButtonTextColor{
+			Idle:     c.Unselected,
+			Disabled: c.DisabledUnselected,
+		}
+
+		l.entryStyle.selectedTextColor = &ButtonTextColor{
+			Idle:     c.Selected,
+			Disabled: c.DisabledSelected,
+		}
+	}
+
+	return l.entryStyle
+}
+
 func (l *List) createWidget() {
 	var cols int
 	if l.hideVerticalSlider {
@@ -251,8 +410,7 @@ func (l *List) createWidget() {
 			ButtonOpts.WidgetOpts(WidgetOpts.LayoutData(RowLayoutData{
 				Stretch: true,
 			})),
-			ButtonOpts.Image(l.entryUnselectedColor),
-			ButtonOpts.TextSimpleLeft(l.entryLabelFunc(e), l.entryFace, l.entryUnselectedTextColor, l.entryTextPadding),
+			ButtonOpts.TextSimpleLeft(l.entryLabelFunc(e), nil, nil, Insets{}),
 			ButtonOpts.ClickedHandler(func(args *ButtonClickedEventArgs) {
 				l.setSelectedEntry(e, true)
 			}))
@@ -267,6 +425,7 @@ func (l *List) createWidget() {
 		ScrollContainerOpts.StretchContentWidth(),
 	}...)...)
 	l.scrollContainerOpts = nil
+	l.scrollContainerImage = l.scrollContainer.image
 	l.container.AddChild(l.scrollContainer)
 
 	if !l.hideVerticalSlider {
@@ -283,9 +442,13 @@ func (l *List) createWidget() {
 			}),
 		}...)...)
 		l.container.AddChild(l.vSlider)
+		l.sliderTrackImage, l.sliderHandleImage = l.vSlider.trackImage, l.vSlider.handleImage
+
+		l.scrollContainer.TouchScrolledEvent.AddHandler(func(a *ScrollContainerTouchScrolledEventArgs) {
+			l.vSlider.Current = int(math.Round(a.ScrollTop * 1000))
+		})
 
-		l.scrollContainer.widget.ScrolledEvent.AddHandler(func(args interface{}) {
-			a := args.(*WidgetScrolledEventArgs)
+		l.scrollContainer.widget.ScrolledEvent.AddHandler(func(a *WidgetScrolledEventArgs) {
 			p := pageSizeFunc() / 3
 			if p < 1 {
 				p = 1
@@ -306,9 +469,18 @@ func (l *List) createWidget() {
 			}),
 		}...)...)
 		l.container.AddChild(l.hSlider)
+		l.sliderTrackImage, l.sliderHandleImage = l.hSlider.trackImage, l.hSlider.handleImage
+
+		l.scrollContainer.TouchScrolledEvent.AddHandler(func(a *ScrollContainerTouchScrolledEventArgs) {
+			l.hSlider.Current = int(math.Round(a.ScrollLeft * 1000))
+		})
 	}
 
 	l.sliderOpts = nil
+
+	setEventQueueFunc(l.container.GetWidget(), l.EntrySelectedEvent)
+
+	l.container.GetWidget().owner = l
 }
 
 func (l *List) SetSelectedEntry(e interface{}) {
@@ -322,15 +494,7 @@ func (l *List) setSelectedEntry(e interface{}, user bool) {
 		prev := l.selectedEntry
 		l.selectedEntry = e
 
-		for i, b := range l.buttons {
-			if l.entries[i] == e {
-				b.Image = l.entrySelectedColor
-				b.TextColor = l.entryTextColor
-			} else {
-				b.Image = l.entryUnselectedColor
-				b.TextColor = l.entryUnselectedTextColor
-			}
-		}
+		l.applyTheme()
 
 		l.EntrySelectedEvent.Fire(&ListEntrySelectedEventArgs{
 			Entry:         e,
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/blizzy78/ebitenui/event"
+	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/matryer/is"
 )
 
@@ -125,6 +126,37 @@ func TestList_EntrySelectedEvent_User_AllowReselect(t *testing.T) {
 	is.Equal(numEvents, 2)
 }
 
+func TestList_EntrySelectedEvent_Actions(t *testing.T) {
+	is := is.New(t)
+
+	entries := []interface{}{"first", "second", "third"}
+
+	var eventArgs *ListEntrySelectedEventArgs
+
+	list := newList(t,
+		ListOpts.Entries(entries),
+
+		ListOpts.EntryLabelFunc(func(e interface{}) string {
+			return e.(string)
+		}),
+
+		ListOpts.EntrySelectedHandler(func(args *ListEntrySelectedEventArgs) {
+			eventArgs = args
+		}))
+	list.Focus(true)
+
+	keyInput(list, ebiten.KeyArrowDown, t)
+	is.Equal(list.SelectedEntry(), entries[0])
+
+	keyInput(list, ebiten.KeyArrowDown, t)
+	is.Equal(list.SelectedEntry(), entries[1])
+	is.Equal(eventArgs.PreviousEntry, entries[0])
+
+	keyInput(list, ebiten.KeyArrowUp, t)
+	keyInput(list, ebiten.KeyArrowUp, t)
+	is.Equal(list.SelectedEntry(), entries[0])
+}
+
 func newList(t *testing.T, opts ...ListOpt) *List {
 	t.Helper()
 
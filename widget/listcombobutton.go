@@ -11,7 +11,7 @@ import (
 )
 
 type ListComboButton struct {
-	EntrySelectedEvent *event.Event
+	EntrySelectedEvent *event.Of[*ListComboButtonEntrySelectedEventArgs]
 
 	buttonOpts []SelectComboButtonOpt
 	listOpts   []ListOpt
@@ -20,6 +20,7 @@ type ListComboButton struct {
 	button             *SelectComboButton
 	list               *List
 	lastContentVisible bool
+	focused            bool
 }
 
 type ListComboButtonOpt func(l *ListComboButton)
@@ -39,7 +40,7 @@ var ListComboButtonOpts ListComboButtonOptions
 
 func NewListComboButton(opts ...ListComboButtonOpt) *ListComboButton {
 	l := &ListComboButton{
-		EntrySelectedEvent: &event.Event{},
+		EntrySelectedEvent: &event.Of[*ListComboButtonEntrySelectedEventArgs]{},
 
 		init: &MultiOnce{},
 	}
@@ -78,9 +79,7 @@ func (o ListComboButtonOptions) EntryLabelFunc(button SelectComboButtonEntryLabe
 
 func (o ListComboButtonOptions) EntrySelectedHandler(f ListComboButtonEntrySelectedHandlerFunc) ListComboButtonOpt {
 	return func(l *ListComboButton) {
-		l.EntrySelectedEvent.AddHandler(func(args interface{}) {
-			f(args.(*ListComboButtonEntrySelectedEventArgs))
-		})
+		l.EntrySelectedEvent.AddHandler(f)
 	}
 }
 
@@ -89,6 +88,12 @@ func (l *ListComboButton) GetWidget() *Widget {
 	return l.button.GetWidget()
 }
 
+// Children implements Composite.
+func (l *ListComboButton) Children() []HasWidget {
+	l.init.Do()
+	return l.button.Children()
+}
+
 func (l *ListComboButton) PreferredSize() (int, int) {
 	l.init.Do()
 	return l.button.PreferredSize()
@@ -113,11 +118,42 @@ func (l *ListComboButton) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 		l.list.SetScrollTop(0)
 	}
 
+	l.handleActions()
+
 	l.button.Render(screen, def)
 
 	l.lastContentVisible = v
 }
 
+// Focus implements Focuser. While focused, input.ActionUp and input.ActionDown select the previous or
+// next entry, and l's button handles input.ActionConfirm and input.ActionCancel like a ComboButton.
+func (l *ListComboButton) Focus(focused bool) {
+	l.init.Do()
+	l.button.Focus(focused)
+	l.focused = focused
+}
+
+func (l *ListComboButton) handleActions() {
+	if !l.focused || l.button.GetWidget().Disabled {
+		return
+	}
+
+	m := l.button.GetWidget().ActionMap()
+
+	var e interface{}
+	var ok bool
+	switch {
+	case m.ActionJustPressed(input.ActionUp):
+		e, ok = l.list.adjacentEntry(-1)
+	case m.ActionJustPressed(input.ActionDown):
+		e, ok = l.list.adjacentEntry(1)
+	}
+
+	if ok {
+		l.list.SetSelectedEntry(e)
+	}
+}
+
 func (l *ListComboButton) createWidget() {
 	l.list = NewList(append(l.listOpts, []ListOpt{
 		ListOpts.HideHorizontalSlider(),
@@ -125,19 +161,36 @@ func (l *ListComboButton) createWidget() {
 	}...)...)
 	l.listOpts = nil
 
+	l.list.themeSections = []func(t *Theme) *ListTheme{
+		func(t *Theme) *ListTheme {
+			if t.ListComboButton == nil {
+				return nil
+			}
+			return t.ListComboButton.List
+		},
+	}
+
 	l.button = NewSelectComboButton(append(l.buttonOpts,
 		SelectComboButtonOpts.ComboButtonOpts(ComboButtonOpts.Content(l.list)),
 	)...)
 	l.buttonOpts = nil
 
+	l.button.themeSections = []func(t *Theme) *ButtonTheme{
+		func(t *Theme) *ButtonTheme {
+			if t.ListComboButton == nil {
+				return nil
+			}
+			return t.ListComboButton.Button
+		},
+	}
+
 	if len(l.list.entries) > 0 {
 		firstEntry := l.list.entries[0]
 		l.button.SetSelectedEntry(firstEntry)
 		l.list.SetSelectedEntry(firstEntry)
 	}
 
-	l.button.EntrySelectedEvent.AddHandler(func(args interface{}) {
-		a := args.(*SelectComboButtonEntrySelectedEventArgs)
+	l.button.EntrySelectedEvent.AddHandler(func(a *SelectComboButtonEntrySelectedEventArgs) {
 		l.EntrySelectedEvent.Fire(&ListComboButtonEntrySelectedEventArgs{
 			Button:        l,
 			Entry:         a.Entry,
@@ -145,11 +198,14 @@ func (l *ListComboButton) createWidget() {
 		})
 	})
 
-	l.list.EntrySelectedEvent.AddHandler(func(args interface{}) {
-		a := args.(*ListEntrySelectedEventArgs)
+	l.list.EntrySelectedEvent.AddHandler(func(a *ListEntrySelectedEventArgs) {
 		l.SetContentVisible(false)
 		l.SetSelectedEntry(a.Entry)
 	})
+
+	setEventQueueFunc(l.button.GetWidget(), l.EntrySelectedEvent)
+
+	l.button.GetWidget().owner = l
 }
 
 func (l *ListComboButton) SetSelectedEntry(e interface{}) {
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/blizzy78/ebitenui/event"
+	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/matryer/is"
 )
 
@@ -152,6 +153,31 @@ func TestListComboButton_ContentVisible_Programmatic(t *testing.T) {
 	is.True(!l.ContentVisible())
 }
 
+func TestListComboButton_SelectedEntry_Actions(t *testing.T) {
+	is := is.New(t)
+
+	entries := []interface{}{"first", "second", "third"}
+
+	l := newListComboButton(t,
+		ListComboButtonOpts.ListOpts(ListOpts.Entries(entries)),
+		ListComboButtonOpts.EntryLabelFunc(func(e interface{}) string {
+			return e.(string)
+		}, func(e interface{}) string {
+			return e.(string)
+		}))
+	l.Focus(true)
+
+	keyInput(l, ebiten.KeyArrowDown, t)
+	is.Equal(l.SelectedEntry(), entries[1])
+
+	keyInput(l, ebiten.KeyEnter, t)
+	is.True(l.ContentVisible())
+
+	keyInput(l, ebiten.KeyArrowDown, t)
+	is.Equal(l.SelectedEntry(), entries[2])
+	is.True(!l.ContentVisible())
+}
+
 func newListComboButton(t *testing.T, opts ...ListComboButtonOpt) *ListComboButton {
 	t.Helper()
 
@@ -0,0 +1,361 @@
+package widget
+
+import (
+	"image"
+
+	"github.com/blizzy78/ebitenui/event"
+	"github.com/blizzy78/ebitenui/input"
+	internalevent "github.com/blizzy78/ebitenui/internal/event"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// EventPhase is the phase of an event that propagates through the widget tree.
+type EventPhase int
+
+// EventPropagation is embedded into the arguments of events that propagate through the widget tree:
+// mouse button press and release events, click events, mouse wheel scroll events, and key events.
+//
+// These events are first fired with EventPhaseCapture from the root widget down to the target widget's
+// parent, using Widget.CaptureEvent. They are then fired with EventPhaseTarget for the target widget itself,
+// and finally bubble up with EventPhaseBubble from the target widget's parent to the root widget, using
+// the same event as for the target widget.
+//
+// The target of a mouse event is the innermost widget that would fire the event on its own. Its parents
+// only receive the event through propagation.
+type EventPropagation struct {
+	// Target is the widget the event has originally been fired for.
+	Target *Widget
+
+	// Phase is the current phase of the event.
+	Phase EventPhase
+
+	state *propagationState
+}
+
+// PropagatingEventArgs are the arguments of events that propagate through the widget tree.
+type PropagatingEventArgs interface {
+	// Propagation returns the propagation state of the event.
+	Propagation() *EventPropagation
+}
+
+// WidgetCaptureHandlerFunc is a function that handles events in EventPhaseCapture.
+type WidgetCaptureHandlerFunc func(args PropagatingEventArgs) //nolint:golint
+
+type propagationState struct {
+	stopped bool
+	handled bool
+}
+
+type propagatingEventKind int
+
+type propagatingEvent struct {
+	target *Widget
+	kind   propagatingEventKind
+	button ebiten.MouseButton
+	args   func(w *Widget, p EventPropagation) PropagatingEventArgs
+}
+
+type propagationStep struct {
+	widget *Widget
+	kind   propagatingEventKind
+	args   PropagatingEventArgs
+}
+
+// propagation is a deferred action that fires one propagation step at a time, so that event handlers
+// of a step are executed before the next step checks whether propagation has been stopped.
+type propagation struct {
+	queue *event.Queue
+	steps []propagationStep
+}
+
+type dispatchPendingEvents struct {
+	queue *event.Queue
+}
+
+const (
+	EventPhaseCapture = EventPhase(iota + 1)
+	EventPhaseTarget
+	EventPhaseBubble
+)
+
+const (
+	propagatingMouseButtonPressed = propagatingEventKind(iota)
+	propagatingMouseButtonReleased
+	propagatingClicked
+	propagatingScrolled
+	propagatingKeyPressed
+	propagatingKeyReleased
+)
+
+// pendingEvents contains the events fired during the current frame, per event queue.
+var pendingEvents = map[*event.Queue][]*propagatingEvent{}
+
+// CaptureHandler configures a Widget with capture event handler f. f receives the arguments of all
+// propagating events in EventPhaseCapture that are fired for descendants of the widget.
+func (o WidgetOptions) CaptureHandler(f WidgetCaptureHandlerFunc) WidgetOpt {
+	return func(w *Widget) {
+		w.CaptureEvent.AddHandler(f)
+	}
+}
+
+// Propagation implements PropagatingEventArgs.
+func (p *EventPropagation) Propagation() *EventPropagation {
+	return p
+}
+
+// StopPropagation stops the event from propagating to any further widgets. Remaining handlers of the
+// current widget are still executed.
+func (p *EventPropagation) StopPropagation() {
+	p.ensureState().stopped = true
+}
+
+// PropagationStopped returns whether StopPropagation has been called.
+func (p *EventPropagation) PropagationStopped() bool {
+	return p.state != nil && p.state.stopped
+}
+
+// SetHandled marks the event as handled. Handled events still propagate, but handlers of further
+// widgets may use Handled to decide not to react to them.
+func (p *EventPropagation) SetHandled() {
+	p.ensureState().handled = true
+}
+
+// Handled returns whether SetHandled has been called.
+func (p *EventPropagation) Handled() bool {
+	return p.state != nil && p.state.handled
+}
+
+func (p *EventPropagation) ensureState() *propagationState {
+	if p.state == nil {
+		p.state = &propagationState{}
+	}
+	return p.state
+}
+
+// firePropagatingEvent fires e with w as its target. The event is not propagated immediately: all events
+// fired during the same frame are collected first, so that events whose target has a descendant firing
+// the same event can be dropped in favor of the descendant's event.
+func (w *Widget) firePropagatingEvent(e *propagatingEvent) {
+	e.target = w
+
+	q := w.EventQueue()
+	if q == nil {
+		q = internalevent.CurrentQueue()
+	}
+
+	es, ok := pendingEvents[q]
+	pendingEvents[q] = append(es, e)
+
+	if !ok {
+		q.Add(dispatchPendingEvents{
+			queue: q,
+		})
+	}
+}
+
+func (w *Widget) fireMouseButtonPressedEvent(b ebiten.MouseButton, p image.Point) {
+	w.firePropagatingEvent(&propagatingEvent{
+		kind:   propagatingMouseButtonPressed,
+		button: b,
+		args: func(pw *Widget, ep EventPropagation) PropagatingEventArgs {
+			off := p.Sub(pw.Rect.Min)
+			return &WidgetMouseButtonPressedEventArgs{
+				Widget:           pw,
+				EventPropagation: ep,
+				Button:           b,
+				OffsetX:          off.X,
+				OffsetY:          off.Y,
+			}
+		},
+	})
+}
+
+func (w *Widget) fireMouseButtonReleasedEvent(b ebiten.MouseButton, p image.Point) {
+	w.firePropagatingEvent(&propagatingEvent{
+		kind:   propagatingMouseButtonReleased,
+		button: b,
+		args: func(pw *Widget, ep EventPropagation) PropagatingEventArgs {
+			off := p.Sub(pw.Rect.Min)
+			return &WidgetMouseButtonReleasedEventArgs{
+				Widget:           pw,
+				EventPropagation: ep,
+				Button:           b,
+				Inside:           p.In(pw.Rect),
+				OffsetX:          off.X,
+				OffsetY:          off.Y,
+			}
+		},
+	})
+}
+
+func (w *Widget) fireClickedEventWithCount(b ebiten.MouseButton, p image.Point, count int) {
+	w.firePropagatingEvent(&propagatingEvent{
+		kind:   propagatingClicked,
+		button: b,
+		args: func(pw *Widget, ep EventPropagation) PropagatingEventArgs {
+			off := p.Sub(pw.Rect.Min)
+			return &WidgetClickedEventArgs{
+				Widget:           pw,
+				EventPropagation: ep,
+				Button:           b,
+				Count:            count,
+				OffsetX:          off.X,
+				OffsetY:          off.Y,
+			}
+		},
+	})
+}
+
+func (w *Widget) fireScrolledEvent(x float64, y float64) {
+	w.firePropagatingEvent(&propagatingEvent{
+		kind: propagatingScrolled,
+		args: func(pw *Widget, ep EventPropagation) PropagatingEventArgs {
+			return &WidgetScrolledEventArgs{
+				Widget:           pw,
+				EventPropagation: ep,
+				X:                x,
+				Y:                y,
+			}
+		},
+	})
+}
+
+func (w *Widget) fireKeyPressedEvent(k ebiten.Key, m input.Modifiers, repeat bool) {
+	w.firePropagatingEvent(&propagatingEvent{
+		kind: propagatingKeyPressed,
+		args: func(pw *Widget, ep EventPropagation) PropagatingEventArgs {
+			return &WidgetKeyPressedEventArgs{
+				Widget:           pw,
+				EventPropagation: ep,
+				Key:              k,
+				Modifiers:        m,
+				Repeat:           repeat,
+			}
+		},
+	})
+}
+
+func (w *Widget) fireKeyReleasedEvent(k ebiten.Key, m input.Modifiers) {
+	w.firePropagatingEvent(&propagatingEvent{
+		kind: propagatingKeyReleased,
+		args: func(pw *Widget, ep EventPropagation) PropagatingEventArgs {
+			return &WidgetKeyReleasedEventArgs{
+				Widget:           pw,
+				EventPropagation: ep,
+				Key:              k,
+				Modifiers:        m,
+			}
+		},
+	})
+}
+
+// Do implements DeferredAction.
+func (d dispatchPendingEvents) Do() {
+	es := pendingEvents[d.queue]
+	delete(pendingEvents, d.queue)
+
+	p := &propagation{
+		queue: d.queue,
+	}
+	for _, e := range es {
+		if !hasDescendantEvent(e, es) {
+			p.add(e)
+		}
+	}
+
+	if len(p.steps) > 0 {
+		d.queue.Add(p)
+	}
+}
+
+// hasDescendantEvent returns whether es contains an event of the same kind as e whose target is
+// a descendant of e's target.
+func hasDescendantEvent(e *propagatingEvent, es []*propagatingEvent) bool {
+	for _, o := range es {
+		if o == e || o.kind != e.kind || o.button != e.button {
+			continue
+		}
+
+		for p := o.target.parent; p != nil; p = p.parent {
+			if p == e.target {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (p *propagation) add(e *propagatingEvent) {
+	state := &propagationState{}
+
+	path := []*Widget{}
+	for w := e.target.parent; w != nil; w = w.parent {
+		path = append(path, w)
+	}
+
+	for i := len(path) - 1; i >= 0; i-- {
+		p.addStep(e, path[i], EventPhaseCapture, state)
+	}
+
+	p.addStep(e, e.target, EventPhaseTarget, state)
+
+	for _, w := range path {
+		p.addStep(e, w, EventPhaseBubble, state)
+	}
+}
+
+func (p *propagation) addStep(e *propagatingEvent, w *Widget, phase EventPhase, state *propagationState) {
+	p.steps = append(p.steps, propagationStep{
+		widget: w,
+		kind:   e.kind,
+		args: e.args(w, EventPropagation{
+			Target: e.target,
+			Phase:  phase,
+			state:  state,
+		}),
+	})
+}
+
+// Do implements DeferredAction.
+func (p *propagation) Do() {
+	for len(p.steps) > 0 {
+		s := p.steps[0]
+		p.steps = p.steps[1:]
+
+		if s.args.Propagation().PropagationStopped() {
+			continue
+		}
+
+		s.fire()
+
+		if len(p.steps) > 0 {
+			p.queue.Add(p)
+		}
+
+		return
+	}
+}
+
+func (s propagationStep) fire() {
+	if s.args.Propagation().Phase == EventPhaseCapture {
+		s.widget.CaptureEvent.Fire(s.args)
+		return
+	}
+
+	switch s.kind {
+	case propagatingMouseButtonPressed:
+		s.widget.MouseButtonPressedEvent.Fire(s.args.(*WidgetMouseButtonPressedEventArgs))
+	case propagatingMouseButtonReleased:
+		s.widget.MouseButtonReleasedEvent.Fire(s.args.(*WidgetMouseButtonReleasedEventArgs))
+	case propagatingClicked:
+		s.widget.ClickedEvent.Fire(s.args.(*WidgetClickedEventArgs))
+	case propagatingScrolled:
+		s.widget.ScrolledEvent.Fire(s.args.(*WidgetScrolledEventArgs))
+	case propagatingKeyPressed:
+		s.widget.KeyPressedEvent.Fire(s.args.(*WidgetKeyPressedEventArgs))
+	default:
+		s.widget.KeyReleasedEvent.Fire(s.args.(*WidgetKeyReleasedEventArgs))
+	}
+}
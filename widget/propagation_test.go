@@ -0,0 +1,158 @@
+package widget
+
+import (
+	"image"
+	"testing"
+
+	"github.com/blizzy78/ebitenui/event"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestWidget_MouseButtonPressedEvent_Propagation(t *testing.T) {
+	is := is.New(t)
+
+	fired := []string{}
+	record := func(name string) WidgetOpt {
+		return WidgetOpts.MouseButtonPressedHandler(func(args *WidgetMouseButtonPressedEventArgs) {
+			fired = append(fired, name)
+		})
+	}
+	capture := func(name string) WidgetOpt {
+		return WidgetOpts.CaptureHandler(func(args PropagatingEventArgs) {
+			if _, ok := args.(*WidgetMouseButtonPressedEventArgs); ok {
+				is.Equal(args.Propagation().Phase, EventPhaseCapture)
+				fired = append(fired, "capture "+name)
+			}
+		})
+	}
+
+	root := newWidget(t, record("root"), capture("root"))
+	card := newWidget(t, record("card"), capture("card"))
+	label := newWidget(t, record("label"), capture("label"))
+	tree(root, card, label)
+
+	root.Rect = image.Rect(0, 0, 100, 100)
+	card.Rect = image.Rect(10, 10, 50, 50)
+	label.Rect = image.Rect(20, 20, 30, 30)
+
+	mouseButtonTreeInput(t, ebiten.MouseButtonLeft, true, 25, 25, root, card, label)
+	is.Equal(fired, []string{"capture root", "capture card", "label", "card", "root"})
+
+	fired = fired[:0]
+	mouseButtonTreeInput(t, ebiten.MouseButtonLeft, false, 25, 25, root, card, label)
+	mouseButtonTreeInput(t, ebiten.MouseButtonLeft, true, 15, 15, root, card, label)
+	is.Equal(fired, []string{"capture root", "card", "root"})
+
+	mouseButtonTreeInput(t, ebiten.MouseButtonLeft, false, 15, 15, root, card, label)
+}
+
+func TestWidget_ClickedEvent_Propagation_Args(t *testing.T) {
+	is := is.New(t)
+
+	var cardArgs *WidgetClickedEventArgs
+
+	root := newWidget(t)
+	card := newWidget(t, WidgetOpts.ClickedHandler(func(args *WidgetClickedEventArgs) {
+		cardArgs = args
+	}))
+	label := newWidget(t)
+	tree(root, card, label)
+
+	root.Rect = image.Rect(0, 0, 100, 100)
+	card.Rect = image.Rect(10, 10, 50, 50)
+	label.Rect = image.Rect(20, 20, 30, 30)
+
+	mouseButtonTreeInput(t, ebiten.MouseButtonLeft, true, 25, 26, root, card, label)
+	mouseButtonTreeInput(t, ebiten.MouseButtonLeft, false, 25, 26, root, card, label)
+
+	is.True(cardArgs != nil)
+	is.Equal(cardArgs.Widget, card)
+	is.Equal(cardArgs.Target, label)
+	is.Equal(cardArgs.Phase, EventPhaseBubble)
+	is.Equal(cardArgs.OffsetX, 15)
+	is.Equal(cardArgs.OffsetY, 16)
+	is.Equal(cardArgs.Count, 1)
+}
+
+func TestWidget_StopPropagation(t *testing.T) {
+	is := is.New(t)
+
+	fired := []string{}
+
+	root := newWidget(t, WidgetOpts.ScrolledHandler(func(args *WidgetScrolledEventArgs) {
+		fired = append(fired, "root")
+	}))
+	card := newWidget(t, WidgetOpts.ScrolledHandler(func(args *WidgetScrolledEventArgs) {
+		fired = append(fired, "card")
+		args.StopPropagation()
+	}))
+	label := newWidget(t, WidgetOpts.ScrolledHandler(func(args *WidgetScrolledEventArgs) {
+		fired = append(fired, "label")
+		args.SetHandled()
+	}))
+	tree(root, card, label)
+
+	root.Rect = image.Rect(0, 0, 100, 100)
+	card.Rect = image.Rect(10, 10, 50, 50)
+	label.Rect = image.Rect(20, 20, 30, 30)
+
+	fakeInput.SetCursorPosition(25, 25)
+	fakeInput.Scroll(0, 1)
+	inputFrame(t)
+	for _, w := range []*Widget{root, card, label} {
+		w.fireEvents()
+	}
+	event.ExecuteDeferred()
+
+	is.Equal(fired, []string{"label", "card"})
+}
+
+func TestWidget_StopPropagation_Capture(t *testing.T) {
+	is := is.New(t)
+
+	fired := []string{}
+
+	root := newWidget(t, WidgetOpts.CaptureHandler(func(args PropagatingEventArgs) {
+		if _, ok := args.(*WidgetMouseButtonPressedEventArgs); ok {
+			is.Equal(args.Propagation().Handled(), false)
+			args.Propagation().SetHandled()
+			args.Propagation().StopPropagation()
+		}
+	}))
+	label := newWidget(t, WidgetOpts.MouseButtonPressedHandler(func(args *WidgetMouseButtonPressedEventArgs) {
+		fired = append(fired, "label")
+	}))
+	tree(root, label)
+
+	root.Rect = image.Rect(0, 0, 100, 100)
+	label.Rect = image.Rect(20, 20, 30, 30)
+
+	mouseButtonTreeInput(t, ebiten.MouseButtonLeft, true, 25, 25, root, label)
+	mouseButtonTreeInput(t, ebiten.MouseButtonLeft, false, 25, 25, root, label)
+
+	is.Equal(len(fired), 0)
+}
+
+// tree makes each widget in ws the parent of the next one.
+func tree(ws ...*Widget) {
+	for i := 1; i < len(ws); i++ {
+		ws[i].parent = ws[i-1]
+	}
+}
+
+// mouseButtonTreeInput simulates the user pressing or releasing mouse button b with the cursor at x,y,
+// then lets ws fire their events in order, as if they were rendered.
+func mouseButtonTreeInput(t *testing.T, b ebiten.MouseButton, pressed bool, x int, y int, ws ...*Widget) {
+	t.Helper()
+
+	fakeInput.SetCursorPosition(x, y)
+	fakeInput.SetMouseButton(b, pressed)
+	inputFrame(t)
+
+	for _, w := range ws {
+		w.fireEvents()
+	}
+	event.ExecuteDeferred()
+}
@@ -0,0 +1,103 @@
+package widget
+
+// WidgetPredicateFunc is a function that returns whether w matches a query.
+type WidgetPredicateFunc func(w HasWidget) bool //nolint:golint
+
+// FindWidget returns the first widget that matches p, searching the widget trees rooted at roots in
+// depth-first order. Children of Composite widgets such as ScrollContainer, FlipBook, TabBook, and SplitPane
+// are searched as well. It returns nil if no widget matches.
+func FindWidget(p WidgetPredicateFunc, roots ...HasWidget) HasWidget {
+	var found HasWidget
+	walkWidgets(roots, func(w HasWidget) bool {
+		if p(w) {
+			found = w
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// FindWidgets returns all widgets that match p, searching the widget trees rooted at roots in depth-first order.
+func FindWidgets(p WidgetPredicateFunc, roots ...HasWidget) []HasWidget {
+	found := []HasWidget{}
+	walkWidgets(roots, func(w HasWidget) bool {
+		if p(w) {
+			found = append(found, w)
+		}
+		return true
+	})
+	return found
+}
+
+// FindWidgetByID returns the first widget whose ID is id, searching the widget trees rooted at roots.
+// It returns nil if no widget has ID id.
+func FindWidgetByID(id string, roots ...HasWidget) HasWidget {
+	return FindWidget(WidgetByID(id), roots...)
+}
+
+// FindWidgetsByTag returns all widgets that have tag, searching the widget trees rooted at roots.
+func FindWidgetsByTag(tag string, roots ...HasWidget) []HasWidget {
+	return FindWidgets(WidgetByTag(tag), roots...)
+}
+
+// FindWidgetsOfType returns all widgets of type T, searching the widget trees rooted at roots.
+func FindWidgetsOfType[T HasWidget](roots ...HasWidget) []T {
+	found := []T{}
+	walkWidgets(roots, func(w HasWidget) bool {
+		if t, ok := w.(T); ok {
+			found = append(found, t)
+		}
+		return true
+	})
+	return found
+}
+
+// WidgetByID returns a predicate that matches widgets whose ID is id.
+func WidgetByID(id string) WidgetPredicateFunc { //nolint:golint
+	return func(w HasWidget) bool {
+		return w.GetWidget().ID == id
+	}
+}
+
+// WidgetByTag returns a predicate that matches widgets that have tag.
+func WidgetByTag(tag string) WidgetPredicateFunc { //nolint:golint
+	return func(w HasWidget) bool {
+		return w.GetWidget().HasTag(tag)
+	}
+}
+
+// FindByID returns the first widget in c's tree, including c itself, whose ID is id, or nil.
+func (c *Container) FindByID(id string) HasWidget {
+	return FindWidgetByID(id, c)
+}
+
+// FindByTag returns all widgets in c's tree, including c itself, that have tag.
+func (c *Container) FindByTag(tag string) []HasWidget {
+	return FindWidgetsByTag(tag, c)
+}
+
+// Find returns the first widget in c's tree, including c itself, that matches p, or nil.
+func (c *Container) Find(p WidgetPredicateFunc) HasWidget {
+	return FindWidget(p, c)
+}
+
+// FindAll returns all widgets in c's tree, including c itself, that match p.
+func (c *Container) FindAll(p WidgetPredicateFunc) []HasWidget {
+	return FindWidgets(p, c)
+}
+
+// walkWidgets calls f for all widgets in the trees rooted at ws, in depth-first order, until f returns false.
+func walkWidgets(ws []HasWidget, f func(w HasWidget) bool) {
+	Walk(func(w HasWidget, parents []HasWidget) WalkResult {
+		// don't report a Label's Text in addition to the Label itself
+		if len(parents) > 0 && parents[len(parents)-1].GetWidget() == w.GetWidget() {
+			return WalkContinue
+		}
+
+		if !f(w) {
+			return WalkStop
+		}
+		return WalkContinue
+	}, ws...)
+}
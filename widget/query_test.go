@@ -0,0 +1,79 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestFindWidgetByID(t *testing.T) {
+	is := is.New(t)
+
+	c, b, _ := queryTree(t)
+
+	is.Equal(c.FindByID("button"), b)
+	is.Equal(c.FindByID("root"), c)
+	is.Equal(c.FindByID("unknown"), nil)
+}
+
+func TestFindWidgetsByTag(t *testing.T) {
+	is := is.New(t)
+
+	c, b, l := queryTree(t)
+
+	is.Equal(c.FindByTag("settings"), []HasWidget{b, l})
+	is.Equal(c.FindByTag("unknown"), []HasWidget{})
+}
+
+func TestFindWidgetsOfType(t *testing.T) {
+	is := is.New(t)
+
+	c, b, _ := queryTree(t)
+
+	is.Equal(FindWidgetsOfType[*Button](c), []*Button{b})
+}
+
+func TestContainer_Find(t *testing.T) {
+	is := is.New(t)
+
+	c, _, l := queryTree(t)
+
+	is.Equal(c.Find(func(w HasWidget) bool {
+		_, ok := w.(*Label)
+		return ok
+	}), l)
+}
+
+func TestWidget_Tags(t *testing.T) {
+	is := is.New(t)
+
+	w := newWidget(t, WidgetOpts.Tags("b", "a"))
+	is.True(w.HasTag("a"))
+	is.Equal(w.Tags(), []string{"a", "b"})
+
+	w.RemoveTag("a")
+	is.True(!w.HasTag("a"))
+	is.Equal(w.Tags(), []string{"b"})
+}
+
+// queryTree returns a widget tree with a button nested in a scroll container, and a label nested
+// in a tab book.
+func queryTree(t *testing.T) (*Container, *Button, *Label) {
+	t.Helper()
+
+	b := NewButton(ButtonOpts.WidgetOpts(WidgetOpts.ID("button"), WidgetOpts.Tags("settings")))
+	l := newLabel(t, LabelOpts.TextOpts(TextOpts.WidgetOpts(WidgetOpts.Tags("settings"))))
+
+	sc := NewScrollContainer(ScrollContainerOpts.Content(newContainer(t, ContainerOpts.WidgetOpts(WidgetOpts.ID("content")))))
+	sc.content.(*Container).AddChild(b)
+
+	tb := newTabBook(t, TabBookOpts.Tabs(
+		NewTabBookTab("1", newContainer(t)),
+		NewTabBookTab("2", l)))
+
+	c := newContainer(t, ContainerOpts.WidgetOpts(WidgetOpts.ID("root")))
+	c.AddChild(sc)
+	c.AddChild(tb)
+
+	return c, b, l
+}
@@ -3,7 +3,7 @@ package widget
 import "github.com/blizzy78/ebitenui/event"
 
 type RadioGroup struct {
-	ChangedEvent *event.Event
+	ChangedEvent *event.Of[*RadioGroupChangedEventArgs]
 
 	checkboxes []*Checkbox
 	active     *Checkbox
@@ -26,7 +26,7 @@ var RadioGroupOpts RadioGroupOptions
 
 func NewRadioGroup(opts ...RadioGroupOpt) *RadioGroup {
 	r := &RadioGroup{
-		ChangedEvent: &event.Event{},
+		ChangedEvent: &event.Of[*RadioGroupChangedEventArgs]{},
 
 		listen:    true,
 		doneEvent: &event.Event{},
@@ -38,9 +38,9 @@ func NewRadioGroup(opts ...RadioGroupOpt) *RadioGroup {
 
 	// use deferred event to initialize
 	e := &event.Event{}
-	event.AddEventHandlerOneShot(e, func(_ interface{}) {
+	e.AddHandler(func(_ interface{}) {
 		r.create()
-	})
+	}, event.HandlerOpts.Once())
 	e.Fire(nil)
 
 	return r
@@ -54,9 +54,7 @@ func (o RadioGroupOptions) Checkboxes(cb ...*Checkbox) RadioGroupOpt {
 
 func (o RadioGroupOptions) ChangedHandler(f RadioGroupChangedHandlerFunc) RadioGroupOpt {
 	return func(r *RadioGroup) {
-		r.ChangedEvent.AddHandler(func(args interface{}) {
-			f(args.(*RadioGroupChangedEventArgs))
-		})
+		r.ChangedEvent.AddHandler(f)
 	}
 }
 
@@ -80,9 +78,9 @@ func (r *RadioGroup) SetActive(a *Checkbox) {
 	}
 
 	// SetState() fires deferred events, so we need something *after* those to tell us we should listen again
-	event.AddEventHandlerOneShot(r.doneEvent, func(_ interface{}) {
+	r.doneEvent.AddHandler(func(_ interface{}) {
 		r.listen = true
-	})
+	}, event.HandlerOpts.Once())
 	r.doneEvent.Fire(nil)
 
 	if a != oldActive {
@@ -94,13 +92,12 @@ func (r *RadioGroup) SetActive(a *Checkbox) {
 
 func (r *RadioGroup) create() {
 	for _, c := range r.checkboxes {
-		c.ChangedEvent.AddHandler(func(args interface{}) {
+		c.ChangedEvent.AddHandler(func(args *CheckboxChangedEventArgs) {
 			if !r.listen {
 				return
 			}
 
-			a := args.(*CheckboxChangedEventArgs)
-			r.SetActive(a.Checkbox)
+			r.SetActive(args.Checkbox)
 		})
 	}
 
@@ -39,8 +39,8 @@ func TestRadioGroup_ChangedEvent_User(t *testing.T) {
 	r := newRadioGroup(t, cbs)
 
 	var eventArgs *RadioGroupChangedEventArgs
-	r.ChangedEvent.AddHandler(func(args interface{}) {
-		eventArgs = args.(*RadioGroupChangedEventArgs)
+	r.ChangedEvent.AddHandler(func(args *RadioGroupChangedEventArgs) {
+		eventArgs = args
 	})
 
 	leftMouseButtonClick(cbs[1], t)
@@ -63,8 +63,8 @@ func TestRadioGroup_SetActive(t *testing.T) {
 	r := newRadioGroup(t, cbs)
 
 	var eventArgs *RadioGroupChangedEventArgs
-	r.ChangedEvent.AddHandler(func(args interface{}) {
-		eventArgs = args.(*RadioGroupChangedEventArgs)
+	r.ChangedEvent.AddHandler(func(args *RadioGroupChangedEventArgs) {
+		eventArgs = args
 	})
 
 	r.SetActive(cbs[1])
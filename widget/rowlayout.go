@@ -92,6 +92,41 @@ func (r *RowLayout) PreferredSize(widgets []PreferredSizeLocateableWidget) (int,
 	return rect.Dx() + r.padding.Dx(), rect.Dy() + r.padding.Dy()
 }
 
+// PreferredHeightForWidth implements HeightForWidthLayouter.
+func (r *RowLayout) PreferredHeightForWidth(widgets []PreferredSizeLocateableWidget, width int) int {
+	width -= r.padding.Dx()
+	h := 0
+
+	for i, widget := range widgets {
+		ww, wh := widget.PreferredSize()
+
+		if rld, ok := widget.GetWidget().LayoutData.(RowLayoutData); ok {
+			pw := ww
+
+			if rld.Stretch && r.direction == DirectionVertical {
+				ww = width
+			}
+
+			ww, wh = r.applyMaxSize(rld, ww, wh)
+			wh = r.applyHeightForWidth(widget, rld, pw, ww, wh)
+		}
+
+		if r.direction == DirectionHorizontal {
+			if wh > h {
+				h = wh
+			}
+			continue
+		}
+
+		h += wh
+		if i > 0 {
+			h += r.spacing
+		}
+	}
+
+	return h + r.padding.Dy()
+}
+
 // Layout implements Layouter.
 func (r *RowLayout) Layout(widgets []PreferredSizeLocateableWidget, rect image.Rectangle) {
 	r.layout(widgets, rect, true, func(w PreferredSizeLocateableWidget, wr image.Rectangle) {
@@ -113,7 +148,7 @@ func (r *RowLayout) layout(widgets []PreferredSizeLocateableWidget, rect image.R
 
 		ld := widget.GetWidget().LayoutData
 		if rld, ok := ld.(RowLayoutData); ok {
-			wx, wy, ww, wh = r.applyLayoutData(rld, wx, wy, ww, wh, usePosition, rect, x, y)
+			wx, wy, ww, wh = r.applyLayoutData(widget, rld, wx, wy, ww, wh, usePosition, rect, x, y)
 		}
 
 		wr := image.Rect(0, 0, ww, wh)
@@ -129,13 +164,20 @@ func (r *RowLayout) layout(widgets []PreferredSizeLocateableWidget, rect image.R
 	}
 }
 
-func (r *RowLayout) applyLayoutData(ld RowLayoutData, wx int, wy int, ww int, wh int, usePosition bool, rect image.Rectangle, x int, y int) (int, int, int, int) {
+func (r *RowLayout) applyLayoutData(w PreferredSizeLocateableWidget, ld RowLayoutData, wx int, wy int, ww int, wh int, usePosition bool, rect image.Rectangle, x int, y int) (int, int, int, int) {
+	pw := ww
+
 	if usePosition {
 		ww, wh = r.applyStretch(ld, ww, wh, rect)
 	}
 
 	ww, wh = r.applyMaxSize(ld, ww, wh)
 
+	// a stretched height must not be replaced
+	if !usePosition || !ld.Stretch || r.direction != DirectionHorizontal {
+		wh = r.applyHeightForWidth(w, ld, pw, ww, wh)
+	}
+
 	if usePosition {
 		wx, wy = r.applyPosition(ld, wx, wy, ww, wh, rect, x, y)
 	}
@@ -157,6 +199,15 @@ func (r *RowLayout) applyStretch(ld RowLayoutData, ww int, wh int, rect image.Re
 	return ww, wh
 }
 
+func (r *RowLayout) applyHeightForWidth(w PreferredSizeLocateableWidget, ld RowLayoutData, pw int, ww int, wh int) int {
+	if ww == pw {
+		return wh
+	}
+
+	_, wh = r.applyMaxSize(ld, ww, PreferredHeightForWidth(w, ww))
+	return wh
+}
+
 func (r *RowLayout) applyMaxSize(ld RowLayoutData, ww int, wh int) (int, int) {
 	if ld.MaxWidth > 0 && ww > ld.MaxWidth {
 		ww = ld.MaxWidth
@@ -130,6 +130,51 @@ func TestRowLayout_Layout(t *testing.T) {
 	}
 }
 
+func TestRowLayout_PreferredHeightForWidth(t *testing.T) {
+	is := is.New(t)
+
+	l := NewRowLayout(
+		RowLayoutOpts.Direction(DirectionVertical),
+		RowLayoutOpts.Padding(NewInsetsSimple(10)),
+		RowLayoutOpts.Spacing(5))
+
+	widgets := []PreferredSizeLocateableWidget{
+		newSimpleWidget(20, 20, RowLayoutData{
+			Stretch: true,
+		}),
+		newHeightForWidthWidget(20, 40, RowLayoutData{
+			Stretch: true,
+		}),
+		newHeightForWidthWidget(20, 40, nil),
+	}
+
+	h := l.PreferredHeightForWidth(widgets, 100)
+
+	is.Equal(h, 20+5+10+5+40+20)
+}
+
+func TestRowLayout_Layout_HeightForWidth(t *testing.T) {
+	is := is.New(t)
+
+	l := NewRowLayout(
+		RowLayoutOpts.Direction(DirectionVertical),
+		RowLayoutOpts.Spacing(5))
+
+	widgets := []PreferredSizeLocateableWidget{
+		newHeightForWidthWidget(20, 40, RowLayoutData{
+			Stretch: true,
+		}),
+		newHeightForWidthWidget(20, 40, RowLayoutData{
+			MaxWidth: 10,
+		}),
+	}
+
+	l.Layout(widgets, image.Rect(0, 0, 80, 200))
+
+	is.Equal(widgets[0].GetWidget().Rect, image.Rect(0, 0, 80, 10))
+	is.Equal(widgets[1].GetWidget().Rect, image.Rect(0, 15, 10, 95))
+}
+
 func newRowLayout(t *testing.T, opts ...RowLayoutOpt) Layouter {
 	t.Helper()
 	l := NewRowLayout(opts...)
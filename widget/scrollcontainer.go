@@ -4,6 +4,7 @@ import (
 	img "image"
 	"math"
 
+	"github.com/blizzy78/ebitenui/event"
 	"github.com/blizzy78/ebitenui/image"
 	"github.com/blizzy78/ebitenui/input"
 
@@ -14,10 +15,14 @@ type ScrollContainer struct {
 	ScrollLeft float64
 	ScrollTop  float64
 
+	// TouchScrolledEvent fires an event with *ScrollContainerTouchScrolledEventArgs when the user scrolls the
+	// content by dragging it using touch input.
+	TouchScrolledEvent *event.Of[*ScrollContainerTouchScrolledEventArgs]
+
 	widgetOpts          []WidgetOpt
 	image               *ScrollContainerImage
 	content             HasWidget
-	padding             Insets
+	padding             *Insets
 	stretchContentWidth bool
 
 	init      *MultiOnce
@@ -33,6 +38,16 @@ type ScrollContainerImage struct {
 	Mask     *image.NineSlice
 }
 
+// ScrollContainerTouchScrolledEventArgs are the arguments for touch scroll events.
+type ScrollContainerTouchScrolledEventArgs struct {
+	ScrollContainer *ScrollContainer
+	ScrollLeft      float64
+	ScrollTop       float64
+}
+
+// ScrollContainerTouchScrolledHandlerFunc is a function that handles touch scroll events.
+type ScrollContainerTouchScrolledHandlerFunc func(args *ScrollContainerTouchScrolledEventArgs)
+
 type ScrollContainerOptions struct {
 }
 
@@ -40,6 +55,8 @@ var ScrollContainerOpts ScrollContainerOptions
 
 func NewScrollContainer(opts ...ScrollContainerOpt) *ScrollContainer {
 	s := &ScrollContainer{
+		TouchScrolledEvent: &event.Of[*ScrollContainerTouchScrolledEventArgs]{},
+
 		init: &MultiOnce{},
 
 		renderBuf: image.NewMaskedRenderBuffer(),
@@ -74,7 +91,7 @@ func (o ScrollContainerOptions) Content(c HasWidget) ScrollContainerOpt {
 
 func (o ScrollContainerOptions) Padding(p Insets) ScrollContainerOpt {
 	return func(s *ScrollContainer) {
-		s.padding = p
+		s.padding = &p
 	}
 }
 
@@ -84,11 +101,26 @@ func (o ScrollContainerOptions) StretchContentWidth() ScrollContainerOpt {
 	}
 }
 
+// TouchScrolledHandler configures a scroll container with touch scroll event handler f.
+func (o ScrollContainerOptions) TouchScrolledHandler(f ScrollContainerTouchScrolledHandlerFunc) ScrollContainerOpt {
+	return func(s *ScrollContainer) {
+		s.TouchScrolledEvent.AddHandler(f)
+	}
+}
+
 func (s *ScrollContainer) GetWidget() *Widget {
 	s.init.Do()
 	return s.widget
 }
 
+// Children implements Composite.
+func (s *ScrollContainer) Children() []HasWidget {
+	if s.content == nil {
+		return nil
+	}
+	return []HasWidget{s.content}
+}
+
 func (s *ScrollContainer) SetLocation(rect img.Rectangle) {
 	s.init.Do()
 	s.widget.Rect = rect
@@ -107,7 +139,17 @@ func (s *ScrollContainer) PreferredSize() (int, int) {
 	}
 
 	w, h := p.PreferredSize()
-	return w + s.padding.Dx(), h + s.padding.Dy()
+	pad := s.resolvedPadding()
+	return w + pad.Dx(), h + pad.Dy()
+}
+
+// RequestRelayout implements Relayoutable.
+func (s *ScrollContainer) RequestRelayout() {
+	s.init.Do()
+
+	if r, ok := s.content.(Relayoutable); ok {
+		r.RequestRelayout()
+	}
 }
 
 func (s *ScrollContainer) SetupInputLayer(def input.DeferredSetupInputLayerFunc) {
@@ -115,7 +157,7 @@ func (s *ScrollContainer) SetupInputLayer(def input.DeferredSetupInputLayerFunc)
 
 	s.content.GetWidget().ElevateToNewInputLayer(&input.Layer{
 		DebugLabel: "scroll container content",
-		EventTypes: input.LayerEventTypeAll ^ input.LayerEventTypeWheel,
+		EventTypes: input.LayerEventTypeAll ^ input.LayerEventTypeWheel ^ input.LayerEventTypeTouch,
 		BlockLower: true,
 		FullScreen: false,
 		RectFunc:   s.ContentRect,
@@ -129,6 +171,7 @@ func (s *ScrollContainer) SetupInputLayer(def input.DeferredSetupInputLayerFunc)
 func (s *ScrollContainer) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 	s.init.Do()
 
+	s.handleTouch()
 	s.clampScroll()
 	s.content.GetWidget().Disabled = s.widget.Disabled
 
@@ -140,10 +183,12 @@ func (s *ScrollContainer) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 }
 
 func (s *ScrollContainer) draw(screen *ebiten.Image) {
-	i := s.image.Idle
+	si := s.resolvedImage()
+
+	i := si.Idle
 	if s.widget.Disabled {
-		if s.image.Disabled != nil {
-			i = s.image.Disabled
+		if si.Disabled != nil {
+			i = si.Disabled
 		}
 	}
 
@@ -156,7 +201,7 @@ func (s *ScrollContainer) draw(screen *ebiten.Image) {
 }
 
 func (s *ScrollContainer) drawImageOptions(opts *ebiten.DrawImageOptions) {
-	if s.widget.Disabled && s.image.Disabled == nil {
+	if s.widget.Disabled && s.resolvedImage().Disabled == nil {
 		opts.ColorM.Scale(1, 1, 1, 0.35)
 	}
 }
@@ -166,6 +211,8 @@ func (s *ScrollContainer) renderContent(screen *ebiten.Image, def DeferredRender
 		return
 	}
 
+	pad := s.resolvedPadding()
+
 	r, ok := s.content.(Renderer)
 	if !ok {
 		return
@@ -180,20 +227,20 @@ func (s *ScrollContainer) renderContent(screen *ebiten.Image, def DeferredRender
 		crect := s.ContentRect()
 		if s.stretchContentWidth && cw < crect.Dx() {
 			cw = crect.Dx()
+
+			if p, ok := s.content.(PreferredSizer); ok {
+				ch = PreferredHeightForWidth(p, cw)
+			}
 		}
 
 		rect := img.Rect(0, 0, cw, ch)
 		rect = rect.Add(s.widget.Rect.Min)
-		rect = rect.Add(img.Point{s.padding.Left, s.padding.Top})
+		rect = rect.Add(img.Point{pad.Left, pad.Top})
 
 		rect = rect.Sub(img.Point{int(math.Round(float64(cw-crect.Dx()) * s.ScrollLeft)), int(math.Round(float64(ch-crect.Dy()) * s.ScrollTop))})
 
 		if rect != s.content.GetWidget().Rect {
 			l.SetLocation(rect)
-
-			if r, ok := s.content.(Relayoutable); ok {
-				r.RequestRelayout()
-			}
 		}
 	}
 
@@ -202,8 +249,8 @@ func (s *ScrollContainer) renderContent(screen *ebiten.Image, def DeferredRender
 			r.Render(buf, def)
 		},
 		func(buf *ebiten.Image) {
-			s.image.Mask.Draw(buf, s.widget.Rect.Dx()-s.padding.Dx(), s.widget.Rect.Dy()-s.padding.Dy(), func(opts *ebiten.DrawImageOptions) {
-				opts.GeoM.Translate(float64(s.widget.Rect.Min.X+s.padding.Left), float64(s.widget.Rect.Min.Y+s.padding.Top))
+			s.resolvedImage().Mask.Draw(buf, s.widget.Rect.Dx()-pad.Dx(), s.widget.Rect.Dy()-pad.Dy(), func(opts *ebiten.DrawImageOptions) {
+				opts.GeoM.Translate(float64(s.widget.Rect.Min.X+pad.Left), float64(s.widget.Rect.Min.Y+pad.Top))
 				opts.CompositeMode = ebiten.CompositeModeCopy
 			})
 		})
@@ -211,7 +258,34 @@ func (s *ScrollContainer) renderContent(screen *ebiten.Image, def DeferredRender
 
 func (s *ScrollContainer) ContentRect() img.Rectangle {
 	s.init.Do()
-	return s.padding.Apply(s.widget.Rect)
+	return s.resolvedPadding().Apply(s.widget.Rect)
+}
+
+func (s *ScrollContainer) theme() *ScrollContainerTheme {
+	return themeSection(s.widget, func(t *Theme) *ScrollContainerTheme {
+		return t.ScrollContainer
+	})
+}
+
+// resolvedImage returns s's image, or the image of s's theme if it has none.
+func (s *ScrollContainer) resolvedImage() *ScrollContainerImage {
+	if s.image != nil {
+		return s.image
+	}
+	if t := s.theme(); t != nil {
+		return t.Image
+	}
+	return nil
+}
+
+func (s *ScrollContainer) resolvedPadding() Insets {
+	if s.padding != nil {
+		return *s.padding
+	}
+	if t := s.theme(); t != nil && t.Padding != nil {
+		return *t.Padding
+	}
+	return Insets{}
 }
 
 func (s *ScrollContainer) clampScroll() {
@@ -228,7 +302,49 @@ func (s *ScrollContainer) clampScroll() {
 	}
 }
 
+// handleTouch scrolls the content along with one-finger drags and two-finger scrolls.
+func (s *ScrollContainer) handleTouch() {
+	layer := s.widget.EffectiveInputLayer()
+
+	var dx, dy float64
+	if x, y, ok := input.TouchDragLayer(layer); ok {
+		dx, dy = float64(x), float64(y)
+	} else if x, y, ok := input.TouchScrollLayer(layer); ok {
+		dx, dy = x, y
+	}
+
+	if dx == 0 && dy == 0 {
+		return
+	}
+
+	crect := s.ContentRect()
+	rect := s.content.GetWidget().Rect
+
+	if rect.Dx() > crect.Dx() {
+		s.ScrollLeft -= dx / float64(rect.Dx()-crect.Dx())
+	}
+	if rect.Dy() > crect.Dy() {
+		s.ScrollTop -= dy / float64(rect.Dy()-crect.Dy())
+	}
+
+	s.clampScroll()
+
+	s.TouchScrolledEvent.Fire(&ScrollContainerTouchScrolledEventArgs{
+		ScrollContainer: s,
+		ScrollLeft:      s.ScrollLeft,
+		ScrollTop:       s.ScrollTop,
+	})
+}
+
 func (s *ScrollContainer) createWidget() {
 	s.widget = NewWidget(s.widgetOpts...)
 	s.widgetOpts = nil
+
+	if s.content != nil {
+		s.content.GetWidget().parent = s.widget
+	}
+
+	setEventQueueFunc(s.widget, s.TouchScrolledEvent)
+
+	s.widget.owner = s
 }
@@ -10,10 +10,11 @@ import (
 )
 
 type SelectComboButton struct {
-	EntrySelectedEvent *event.Event
+	EntrySelectedEvent *event.Of[*SelectComboButtonEntrySelectedEventArgs]
 
 	buttonOpts     []ComboButtonOpt
 	entryLabelFunc SelectComboButtonEntryLabelFunc
+	themeSections  []func(t *Theme) *ButtonTheme
 
 	init          *MultiOnce
 	button        *ComboButton
@@ -39,7 +40,7 @@ var SelectComboButtonOpts SelectComboButtonOptions
 
 func NewSelectComboButton(opts ...SelectComboButtonOpt) *SelectComboButton {
 	s := &SelectComboButton{
-		EntrySelectedEvent: &event.Event{},
+		EntrySelectedEvent: &event.Of[*SelectComboButtonEntrySelectedEventArgs]{},
 
 		init: &MultiOnce{},
 	}
@@ -63,9 +64,7 @@ func (o SelectComboButtonOptions) ComboButtonOpts(opts ...ComboButtonOpt) Select
 
 func (o SelectComboButtonOptions) EntrySelectedHandler(f SelectComboButtonEntrySelectedHandlerFunc) SelectComboButtonOpt {
 	return func(s *SelectComboButton) {
-		s.EntrySelectedEvent.AddHandler(func(args interface{}) {
-			f(args.(*SelectComboButtonEntrySelectedEventArgs))
-		})
+		s.EntrySelectedEvent.AddHandler(f)
 	}
 }
 
@@ -80,6 +79,12 @@ func (s *SelectComboButton) GetWidget() *Widget {
 	return s.button.GetWidget()
 }
 
+// Children implements Composite.
+func (s *SelectComboButton) Children() []HasWidget {
+	s.init.Do()
+	return s.button.Children()
+}
+
 func (s *SelectComboButton) SetLocation(rect image.Rectangle) {
 	s.init.Do()
 	s.button.SetLocation(rect)
@@ -105,9 +110,26 @@ func (s *SelectComboButton) Render(screen *ebiten.Image, def DeferredRenderFunc)
 	s.button.Render(screen, def)
 }
 
+// Focus implements Focuser. See ComboButton.Focus.
+func (s *SelectComboButton) Focus(focused bool) {
+	s.init.Do()
+	s.button.Focus(focused)
+}
+
 func (s *SelectComboButton) createWidget() {
 	s.button = NewComboButton(s.buttonOpts...)
 	s.buttonOpts = nil
+
+	s.button.themeSections = append(s.themeSections, func(t *Theme) *ButtonTheme {
+		if t.SelectComboButton == nil {
+			return nil
+		}
+		return t.SelectComboButton.Button
+	})
+
+	setEventQueueFunc(s.button.GetWidget(), s.EntrySelectedEvent)
+
+	s.button.GetWidget().owner = s
 }
 
 func (s *SelectComboButton) SetSelectedEntry(e interface{}) {
@@ -17,13 +17,13 @@ type Slider struct {
 	Current           int
 	DrawTrackDisabled bool
 
-	ChangedEvent *event.Event
+	ChangedEvent *event.Of[*SliderChangedEventArgs]
 
 	widgetOpts   []WidgetOpt
-	handleOpts   []ButtonOpt
 	direction    Direction
 	trackImage   *SliderTrackImage
-	trackPadding Insets
+	handleImage  *ButtonImage
+	trackPadding *Insets
 	handleSize   int
 	pageSizeFunc SliderPageSizeFunc
 
@@ -38,6 +38,9 @@ type Slider struct {
 	handlePressedOffsetX         int
 	handlePressedOffsetY         int
 	handlePressedInternalCurrent float64
+	touchScrollOffset            float64
+	gamepadOffset                float64
+	focused                      bool
 }
 
 type SliderTrackImage struct {
@@ -69,9 +72,8 @@ func NewSlider(opts ...SliderOpt) *Slider {
 		Max:     100,
 		Current: 1,
 
-		ChangedEvent: &event.Event{},
+		ChangedEvent: &event.Of[*SliderChangedEventArgs]{},
 
-		trackImage: &SliderTrackImage{},
 		handleSize: 16,
 		pageSizeFunc: func() int {
 			return 10
@@ -106,13 +108,13 @@ func (o SliderOptions) Direction(d Direction) SliderOpt {
 func (o SliderOptions) Images(track *SliderTrackImage, handle *ButtonImage) SliderOpt {
 	return func(s *Slider) {
 		s.trackImage = track
-		s.handleOpts = append(s.handleOpts, ButtonOpts.Image(handle))
+		s.handleImage = handle
 	}
 }
 
 func (o SliderOptions) TrackPadding(i Insets) SliderOpt {
 	return func(s *Slider) {
-		s.trackPadding = i
+		s.trackPadding = &i
 	}
 }
 
@@ -137,9 +139,7 @@ func (o SliderOptions) PageSizeFunc(f SliderPageSizeFunc) SliderOpt {
 
 func (o SliderOptions) ChangedHandler(f SliderChangedHandlerFunc) SliderOpt {
 	return func(s *Slider) {
-		s.ChangedEvent.AddHandler(func(args interface{}) {
-			f(args.(*SliderChangedEventArgs))
-		})
+		s.ChangedEvent.AddHandler(f)
 	}
 }
 
@@ -148,12 +148,22 @@ func (s *Slider) GetWidget() *Widget {
 	return s.widget
 }
 
+// Children implements Composite.
+func (s *Slider) Children() []HasWidget {
+	s.init.Do()
+	return []HasWidget{s.handle}
+}
+
 func (s *Slider) PreferredSize() (int, int) {
+	s.init.Do()
+
+	tp := s.resolvedTrackPadding()
+
 	if s.direction == DirectionHorizontal {
-		return 200, s.handleSize + s.trackPadding.Top + s.trackPadding.Bottom
+		return 200, s.handleSize + tp.Top + tp.Bottom
 	}
 
-	return s.handleSize + s.trackPadding.Left + s.trackPadding.Right, 200
+	return s.handleSize + tp.Left + tp.Right, 200
 }
 
 func (s *Slider) SetLocation(rect img.Rectangle) {
@@ -164,7 +174,7 @@ func (s *Slider) SetLocation(rect img.Rectangle) {
 func (s *Slider) SetupInputLayer(def input.DeferredSetupInputLayerFunc) {
 	s.handle.GetWidget().ElevateToNewInputLayer(&input.Layer{
 		DebugLabel: "slider handle",
-		EventTypes: input.LayerEventTypeAll,
+		EventTypes: input.LayerEventTypeAll ^ input.LayerEventTypeTouch,
 		BlockLower: true,
 		FullScreen: false,
 		RectFunc: func() img.Rectangle {
@@ -178,8 +188,12 @@ func (s *Slider) SetupInputLayer(def input.DeferredSetupInputLayerFunc) {
 func (s *Slider) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 	s.init.Do()
 
+	s.handleTouch()
+	s.handleGamepad()
+	s.handleActions()
 	s.clampCurrentMinMax()
 	s.handle.GetWidget().Disabled = s.widget.Disabled
+	s.handle.Image = s.resolvedHandleImage()
 
 	s.widget.Render(screen, def)
 
@@ -196,15 +210,53 @@ func (s *Slider) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 	s.lastCurrent = s.Current
 }
 
+func (s *Slider) theme() *SliderTheme {
+	return themeSection(s.widget, func(t *Theme) *SliderTheme {
+		return t.Slider
+	})
+}
+
+func (s *Slider) resolvedTrackImage() *SliderTrackImage {
+	if s.trackImage != nil {
+		return s.trackImage
+	}
+	if t := s.theme(); t != nil && t.TrackImage != nil {
+		return t.TrackImage
+	}
+	return &SliderTrackImage{}
+}
+
+func (s *Slider) resolvedHandleImage() *ButtonImage {
+	if s.handleImage != nil {
+		return s.handleImage
+	}
+	if t := s.theme(); t != nil {
+		return t.HandleImage
+	}
+	return nil
+}
+
+func (s *Slider) resolvedTrackPadding() Insets {
+	if s.trackPadding != nil {
+		return *s.trackPadding
+	}
+	if t := s.theme(); t != nil && t.TrackPadding != nil {
+		return *t.TrackPadding
+	}
+	return Insets{}
+}
+
 func (s *Slider) draw(screen *ebiten.Image) {
-	i := s.trackImage.Idle
+	ti := s.resolvedTrackImage()
+
+	i := ti.Idle
 	if s.widget.Disabled || s.DrawTrackDisabled {
-		if s.trackImage.Disabled != nil {
-			i = s.trackImage.Disabled
+		if ti.Disabled != nil {
+			i = ti.Disabled
 		}
-	} else if s.hovering {
-		if s.trackImage.Hover != nil {
-			i = s.trackImage.Hover
+	} else if s.hovering || s.focused {
+		if ti.Hover != nil {
+			i = ti.Hover
 		}
 	}
 
@@ -222,7 +274,93 @@ func (s *Slider) fireEvents() {
 	}
 }
 
+// handleTouch moves the handle along with two-finger scrolls.
+func (s *Slider) handleTouch() {
+	if s.widget.Disabled {
+		return
+	}
+
+	dx, dy, ok := input.TouchScrollLayer(s.widget.EffectiveInputLayer())
+	if !ok {
+		return
+	}
+
+	d := dx
+	if s.direction == DirectionVertical {
+		d = dy
+	}
+
+	hl, tl := s.handleLengthAndTrackLength()
+	if tl <= hl {
+		return
+	}
+
+	s.touchScrollOffset += d / (tl - hl) * float64(s.Max-s.Min)
+	steps := math.Trunc(s.touchScrollOffset)
+	s.touchScrollOffset -= steps
+	s.Current += int(steps)
+}
+
+// handleGamepad moves the handle along with the gamepad's analog stick while the cursor is over the slider.
+// At full deflection, the handle moves by a tenth of a page per frame.
+func (s *Slider) handleGamepad() {
+	if s.widget.Disabled || !s.hovering {
+		return
+	}
+
+	x, y := input.GamepadStickLayer(s.widget.EffectiveInputLayer())
+
+	d := x
+	if s.direction == DirectionVertical {
+		d = y
+	}
+
+	if d == 0 {
+		return
+	}
+
+	s.gamepadOffset += d * float64(s.pageSizeFunc()) / 10
+	steps := math.Trunc(s.gamepadOffset)
+	s.gamepadOffset -= steps
+	s.Current += int(steps)
+}
+
+// Focus implements Focuser. While focused, input.ActionLeft and input.ActionRight, or input.ActionUp and
+// input.ActionDown for vertical sliders, move the handle by a tenth of a page.
+func (s *Slider) Focus(focused bool) {
+	s.init.Do()
+	WidgetFireFocusEvent(s.widget, focused)
+	s.focused = focused
+}
+
+func (s *Slider) handleActions() {
+	if !s.focused || s.widget.Disabled {
+		return
+	}
+
+	dec, inc := input.ActionLeft, input.ActionRight
+	if s.direction == DirectionVertical {
+		dec, inc = input.ActionUp, input.ActionDown
+	}
+
+	step := s.pageSizeFunc() / 10
+	if step < 1 {
+		step = 1
+	}
+
+	m := s.widget.ActionMap()
+
+	switch {
+	case m.ActionJustPressed(dec):
+		s.Current -= step
+	case m.ActionJustPressed(inc):
+		s.Current += step
+	}
+}
+
 func (s *Slider) updateHandleSize(handleLength float64) {
+	tp := s.resolvedTrackPadding()
+
 	l := int(math.Round(handleLength))
 	if l < s.handleSize {
 		l = s.handleSize
@@ -232,15 +370,17 @@ func (s *Slider) updateHandleSize(handleLength float64) {
 
 	var p img.Point
 	if s.direction == DirectionHorizontal {
-		p = img.Point{l, rect.Dy() - s.trackPadding.Top - s.trackPadding.Bottom}
+		p = img.Point{l, rect.Dy() - tp.Top - tp.Bottom}
 	} else {
-		p = img.Point{rect.Dx() - s.trackPadding.Left - s.trackPadding.Right, l}
+		p = img.Point{rect.Dx() - tp.Left - tp.Right, l}
 	}
 
 	s.handle.GetWidget().Rect.Max = s.handle.GetWidget().Rect.Min.Add(p)
 }
 
 func (s *Slider) updateHandleLocation(handleLength float64, trackLength float64) {
+	tp := s.resolvedTrackPadding()
+
 	internalTrackLength := int(math.Ceil(trackLength - handleLength))
 	internalTrackStart := int(math.Floor(handleLength / 2))
 	internalTrackEnd := internalTrackStart + internalTrackLength
@@ -277,19 +417,21 @@ func (s *Slider) updateHandleLocation(handleLength float64, trackLength float64)
 
 	rect := s.widget.Rect
 	if s.direction == DirectionHorizontal {
-		rect.Min = rect.Min.Add(img.Point{off + s.trackPadding.Left, s.trackPadding.Top})
+		rect.Min = rect.Min.Add(img.Point{off + tp.Left, tp.Top})
 	} else {
-		rect.Min = rect.Min.Add(img.Point{s.trackPadding.Left, off + s.trackPadding.Top})
+		rect.Min = rect.Min.Add(img.Point{tp.Left, off + tp.Top})
 	}
 	s.handle.GetWidget().Rect = rect
 }
 
 func (s *Slider) handleLengthAndTrackLength() (float64, float64) {
+	tp := s.resolvedTrackPadding()
+
 	var trackLength float64
 	if s.direction == DirectionHorizontal {
-		trackLength = float64(s.widget.Rect.Dx()) - float64(s.trackPadding.Left) - float64(s.trackPadding.Right)
+		trackLength = float64(s.widget.Rect.Dx()) - float64(tp.Left) - float64(tp.Right)
 	} else {
-		trackLength = float64(s.widget.Rect.Dy()) - float64(s.trackPadding.Top) - float64(s.trackPadding.Bottom)
+		trackLength = float64(s.widget.Rect.Dy()) - float64(tp.Top) - float64(tp.Bottom)
 	}
 
 	length := float64(s.Max - s.Min + 1)
@@ -340,7 +482,7 @@ func (s *Slider) createWidget() {
 
 		// TODO: keeping the mouse button pressed should move the handle repeatedly (in PageSize steps) until it stops under the cursor
 		WidgetOpts.MouseButtonPressedHandler(func(args *WidgetMouseButtonPressedEventArgs) {
-			if !s.widget.Disabled {
+			if !s.widget.Disabled && args.Button == ebiten.MouseButtonLeft {
 				x, y := input.CursorPosition()
 				ps := s.pageSizeFunc()
 				rect := s.handle.GetWidget().Rect
@@ -364,7 +506,7 @@ func (s *Slider) createWidget() {
 	}...)...)
 	s.widgetOpts = nil
 
-	s.handle = NewButton(append(s.handleOpts, []ButtonOpt{
+	s.handle = NewButton(
 		ButtonOpts.KeepPressedOnExit(),
 
 		ButtonOpts.PressedHandler(func(args *ButtonPressedEventArgs) {
@@ -378,5 +520,10 @@ func (s *Slider) createWidget() {
 		ButtonOpts.ReleasedHandler(func(args *ButtonReleasedEventArgs) {
 			s.dragging = false
 		}),
-	}...)...)
+	)
+
+	setEventQueueFunc(s.widget, s.ChangedEvent)
+
+	s.handle.GetWidget().parent = s.widget
+	s.widget.owner = s
 }
@@ -1,9 +1,12 @@
 package widget
 
 import (
+	"image"
 	"testing"
 
 	"github.com/blizzy78/ebitenui/event"
+	"github.com/blizzy78/ebitenui/input"
+	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/matryer/is"
 )
 
@@ -21,6 +24,78 @@ func TestSlider_Current_Initial(t *testing.T) {
 	is.Equal(eventArgs.Current, 10)
 }
 
+func TestSlider_TouchScroll(t *testing.T) {
+	is := is.New(t)
+
+	s := newSlider(t,
+		SliderOpts.MinMax(0, 100),
+		SliderOpts.PageSizeFunc(func() int {
+			return 1
+		}))
+	s.SetLocation(image.Rect(0, 0, 101, 10))
+	render(s, t)
+
+	touchInput(t, input.Touch{ID: 1, X: 10, Y: 5}, input.Touch{ID: 2, X: 20, Y: 5})
+	touchInput(t, input.Touch{ID: 1, X: 30, Y: 5}, input.Touch{ID: 2, X: 40, Y: 5})
+	render(s, t)
+	touchInput(t)
+
+	is.True(s.Current > 0)
+}
+
+func TestSlider_GamepadStick(t *testing.T) {
+	is := is.New(t)
+
+	s := newSlider(t,
+		SliderOpts.MinMax(0, 100),
+		SliderOpts.PageSizeFunc(func() int {
+			return 20
+		}))
+	s.SetLocation(image.Rect(0, 0, 101, 10))
+	render(s, t)
+
+	cursorInput(s.widget, 50, 5, t)
+	render(s, t)
+	start := s.Current
+
+	gamepadInput(t, input.Gamepad{ID: 0, Axes: []float64{1, 0}})
+	render(s, t)
+	render(s, t)
+	gamepadInput(t)
+
+	is.Equal(s.Current, start+4)
+
+	cursorInput(s.widget, 500, 5, t)
+	render(s, t)
+
+	gamepadInput(t, input.Gamepad{ID: 0, Axes: []float64{1, 0}})
+	render(s, t)
+	gamepadInput(t)
+
+	is.Equal(s.Current, start+4)
+}
+
+func TestSlider_Current_Actions(t *testing.T) {
+	is := is.New(t)
+
+	s := newSlider(t,
+		SliderOpts.MinMax(0, 100),
+		SliderOpts.PageSizeFunc(func() int {
+			return 20
+		}))
+	s.Focus(true)
+	start := s.Current
+
+	keyInput(s, ebiten.KeyArrowRight, t)
+	is.Equal(s.Current, start+2)
+
+	keyInput(s, ebiten.KeyArrowDown, t)
+	is.Equal(s.Current, start+2) // horizontal slider
+
+	keyInput(s, ebiten.KeyArrowLeft, t)
+	is.Equal(s.Current, start)
+}
+
 func newSlider(t *testing.T, opts ...SliderOpt) *Slider {
 	s := NewSlider(append(opts, SliderOpts.Images(&SliderTrackImage{
 		Idle: newNineSliceEmpty(t),
@@ -0,0 +1,440 @@
+package widget
+
+import (
+	img "image"
+
+	"github.com/blizzy78/ebitenui/event"
+	"github.com/blizzy78/ebitenui/input"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// A SplitPane layouts two widgets next to each other, either horizontally or vertically, separated by a
+// divider that can be dragged by the user to change the space given to each widget.
+type SplitPane struct {
+	// Position is the position of the divider in pixels, measured from the start of the split pane. If it
+	// is negative, the divider is positioned according to the first widget's preferred size.
+	Position int
+
+	// ChangedEvent fires an event with *SplitPaneChangedEventArgs when Position changes.
+	ChangedEvent *event.Of[*SplitPaneChangedEventArgs]
+
+	widgetOpts    []WidgetOpt
+	dividerOpts   []ButtonOpt
+	direction     Direction
+	first         PreferredSizeLocateableWidget
+	second        PreferredSizeLocateableWidget
+	dividerSize   int
+	firstMinSize  int
+	secondMinSize int
+	collapsible   bool
+
+	init                  *MultiOnce
+	widget                *Widget
+	divider               *Button
+	dividerImage          *ButtonImage
+	lastPosition          int
+	lastRect              img.Rectangle
+	dragging              bool
+	dividerPressedCursorX int
+	dividerPressedCursorY int
+	dividerPressedPos     int
+}
+
+// SplitPaneOpt is a function that configures s.
+type SplitPaneOpt func(s *SplitPane)
+
+// SplitPaneChangedEventArgs are the arguments for divider position change events.
+type SplitPaneChangedEventArgs struct {
+	SplitPane *SplitPane
+	Position  int
+	Dragging  bool
+}
+
+// SplitPaneChangedHandlerFunc is a function that handles divider position change events.
+type SplitPaneChangedHandlerFunc func(args *SplitPaneChangedEventArgs)
+
+type SplitPaneOptions struct {
+}
+
+// SplitPaneOpts contains functions that configure a SplitPane.
+var SplitPaneOpts SplitPaneOptions
+
+// NewSplitPane constructs a new SplitPane configured with opts.
+func NewSplitPane(opts ...SplitPaneOpt) *SplitPane {
+	s := &SplitPane{
+		Position: -1,
+
+		ChangedEvent: &event.Of[*SplitPaneChangedEventArgs]{},
+
+		dividerSize: 8,
+
+		lastPosition: -1,
+
+		init: &MultiOnce{},
+	}
+
+	s.init.Append(s.createWidget)
+
+	for _, o := range opts {
+		o(s)
+	}
+
+	return s
+}
+
+// WidgetOpts configures a SplitPane with opts.
+func (o SplitPaneOptions) WidgetOpts(opts ...WidgetOpt) SplitPaneOpt {
+	return func(s *SplitPane) {
+		s.widgetOpts = append(s.widgetOpts, opts...)
+	}
+}
+
+// Direction configures a SplitPane to place its widgets next to each other in direction d.
+func (o SplitPaneOptions) Direction(d Direction) SplitPaneOpt {
+	return func(s *SplitPane) {
+		s.direction = d
+	}
+}
+
+// Widgets configures a SplitPane to show first and second on either side of the divider. This option
+// is required.
+func (o SplitPaneOptions) Widgets(first PreferredSizeLocateableWidget, second PreferredSizeLocateableWidget) SplitPaneOpt {
+	return func(s *SplitPane) {
+		s.first = first
+		s.second = second
+	}
+}
+
+// DividerImage configures a SplitPane to draw the divider using i.
+func (o SplitPaneOptions) DividerImage(i *ButtonImage) SplitPaneOpt {
+	return func(s *SplitPane) {
+		s.dividerOpts = append(s.dividerOpts, ButtonOpts.Image(i))
+	}
+}
+
+// DividerSize configures a SplitPane to use a divider of thickness size.
+func (o SplitPaneOptions) DividerSize(size int) SplitPaneOpt {
+	return func(s *SplitPane) {
+		s.dividerSize = size
+	}
+}
+
+// MinSizes configures a SplitPane to keep the first widget at least first pixels wide (or high),
+// and the second widget at least second pixels wide (or high.)
+func (o SplitPaneOptions) MinSizes(first int, second int) SplitPaneOpt {
+	return func(s *SplitPane) {
+		s.firstMinSize = first
+		s.secondMinSize = second
+	}
+}
+
+// Collapsible configures a SplitPane to allow collapsing either widget by moving the divider to the edge.
+// A widget is collapsed when the divider is moved past half of the widget's minimum size.
+func (o SplitPaneOptions) Collapsible() SplitPaneOpt {
+	return func(s *SplitPane) {
+		s.collapsible = true
+	}
+}
+
+// Position configures a SplitPane to initially place the divider at position p.
+func (o SplitPaneOptions) Position(p int) SplitPaneOpt {
+	return func(s *SplitPane) {
+		s.Position = p
+	}
+}
+
+// ChangedHandler configures a SplitPane with divider position change event handler f.
+func (o SplitPaneOptions) ChangedHandler(f SplitPaneChangedHandlerFunc) SplitPaneOpt {
+	return func(s *SplitPane) {
+		s.ChangedEvent.AddHandler(f)
+	}
+}
+
+// GetWidget implements HasWidget.
+func (s *SplitPane) GetWidget() *Widget {
+	s.init.Do()
+	return s.widget
+}
+
+// Children implements Composite.
+func (s *SplitPane) Children() []HasWidget {
+	s.init.Do()
+	return []HasWidget{s.first, s.second, s.divider}
+}
+
+// PreferredSize implements PreferredSizer.
+func (s *SplitPane) PreferredSize() (int, int) {
+	s.init.Do()
+
+	fw, fh := s.first.PreferredSize()
+	sw, sh := s.second.PreferredSize()
+
+	if s.direction == DirectionHorizontal {
+		return fw + s.dividerSize + sw, maxInt(fh, sh)
+	}
+
+	return maxInt(fw, sw), fh + s.dividerSize + sh
+}
+
+// SetLocation implements Locateable.
+func (s *SplitPane) SetLocation(rect img.Rectangle) {
+	s.init.Do()
+	s.widget.Rect = rect
+}
+
+// RequestRelayout implements Relayoutable.
+func (s *SplitPane) RequestRelayout() {
+	s.init.Do()
+
+	s.lastRect = img.Rectangle{}
+
+	for _, w := range []PreferredSizeLocateableWidget{s.first, s.second} {
+		if r, ok := w.(Relayoutable); ok {
+			r.RequestRelayout()
+		}
+	}
+}
+
+// SetupInputLayer implements input.Layerer.
+func (s *SplitPane) SetupInputLayer(def input.DeferredSetupInputLayerFunc) {
+	s.init.Do()
+
+	for _, w := range []PreferredSizeLocateableWidget{s.first, s.second} {
+		if il, ok := w.(input.Layerer); ok {
+			il.SetupInputLayer(def)
+		}
+	}
+
+	s.divider.GetWidget().ElevateToNewInputLayer(&input.Layer{
+		DebugLabel: "split pane divider",
+		EventTypes: input.LayerEventTypeAll,
+		BlockLower: true,
+		FullScreen: false,
+		RectFunc: func() img.Rectangle {
+			return s.divider.GetWidget().Rect
+		},
+	})
+
+	s.divider.SetupInputLayer(def)
+}
+
+// Render implements Renderer.
+func (s *SplitPane) Render(screen *ebiten.Image, def DeferredRenderFunc) {
+	s.init.Do()
+
+	s.first.GetWidget().Disabled = s.widget.Disabled
+	s.second.GetWidget().Disabled = s.widget.Disabled
+	s.divider.GetWidget().Disabled = s.widget.Disabled
+
+	if s.dividerImage == nil {
+		s.divider.Image = nil
+		if t := s.theme(); t != nil {
+			s.divider.Image = t.DividerImage
+		}
+	}
+
+	s.widget.Render(screen, def)
+
+	s.updatePosition()
+	s.layout()
+
+	for _, w := range []PreferredSizeLocateableWidget{s.first, s.second} {
+		if r, ok := w.(Renderer); ok {
+			r.Render(screen, def)
+		}
+	}
+
+	s.divider.Render(screen, def)
+
+	s.fireEvents()
+
+	s.lastPosition = s.Position
+}
+
+// WidgetAt implements Locater.
+func (s *SplitPane) WidgetAt(x int, y int) HasWidget {
+	s.init.Do()
+
+	p := img.Point{x, y}
+
+	if !p.In(s.widget.Rect) {
+		return nil
+	}
+
+	for _, w := range []PreferredSizeLocateableWidget{s.first, s.second} {
+		if l, ok := w.(Locater); ok {
+			if lw := l.WidgetAt(x, y); lw != nil {
+				return lw
+			}
+
+			continue
+		}
+
+		if p.In(w.GetWidget().Rect) {
+			return w
+		}
+	}
+
+	return s
+}
+
+// First returns the widget shown on the first side of the divider.
+func (s *SplitPane) First() PreferredSizeLocateableWidget {
+	return s.first
+}
+
+// Second returns the widget shown on the second side of the divider.
+func (s *SplitPane) Second() PreferredSizeLocateableWidget {
+	return s.second
+}
+
+func (s *SplitPane) updatePosition() {
+	if s.Position < 0 {
+		w, h := s.first.PreferredSize()
+		if s.direction == DirectionHorizontal {
+			s.Position = w
+		} else {
+			s.Position = h
+		}
+	}
+
+	if s.dragging {
+		x, y := input.CursorPosition()
+		if s.direction == DirectionHorizontal {
+			s.Position = s.dividerPressedPos + x - s.dividerPressedCursorX
+		} else {
+			s.Position = s.dividerPressedPos + y - s.dividerPressedCursorY
+		}
+	}
+
+	s.Position = s.clampPosition(s.Position)
+}
+
+func (s *SplitPane) clampPosition(p int) int {
+	avail := s.availableLength()
+
+	if s.collapsible {
+		if p < s.firstMinSize/2 {
+			return 0
+		}
+
+		if avail-p < s.secondMinSize/2 {
+			return avail
+		}
+	}
+
+	if avail-p < s.secondMinSize {
+		p = avail - s.secondMinSize
+	}
+
+	if p < s.firstMinSize {
+		p = s.firstMinSize
+	}
+
+	if p > avail {
+		p = avail
+	}
+
+	if p < 0 {
+		p = 0
+	}
+
+	return p
+}
+
+func (s *SplitPane) availableLength() int {
+	if s.direction == DirectionHorizontal {
+		return s.widget.Rect.Dx() - s.dividerSize
+	}
+
+	return s.widget.Rect.Dy() - s.dividerSize
+}
+
+func (s *SplitPane) layout() {
+	if s.widget.Rect == s.lastRect && s.Position == s.lastPosition {
+		return
+	}
+
+	s.lastRect = s.widget.Rect
+
+	rect := s.widget.Rect
+	var fr, dr, sr img.Rectangle
+	if s.direction == DirectionHorizontal {
+		fr = img.Rect(rect.Min.X, rect.Min.Y, rect.Min.X+s.Position, rect.Max.Y)
+		dr = img.Rect(fr.Max.X, rect.Min.Y, fr.Max.X+s.dividerSize, rect.Max.Y)
+		sr = img.Rect(dr.Max.X, rect.Min.Y, rect.Max.X, rect.Max.Y)
+	} else {
+		fr = img.Rect(rect.Min.X, rect.Min.Y, rect.Max.X, rect.Min.Y+s.Position)
+		dr = img.Rect(rect.Min.X, fr.Max.Y, rect.Max.X, fr.Max.Y+s.dividerSize)
+		sr = img.Rect(rect.Min.X, dr.Max.Y, rect.Max.X, rect.Max.Y)
+	}
+
+	s.first.SetLocation(fr)
+	s.divider.SetLocation(dr)
+	s.second.SetLocation(sr)
+}
+
+func (s *SplitPane) fireEvents() {
+	if s.lastPosition >= 0 && s.Position != s.lastPosition {
+		s.ChangedEvent.Fire(&SplitPaneChangedEventArgs{
+			SplitPane: s,
+			Position:  s.Position,
+			Dragging:  s.dragging,
+		})
+	}
+}
+
+func (s *SplitPane) createWidget() {
+	if s.first == nil || s.second == nil {
+		panic("SplitPane requires both widgets to be set using SplitPaneOpts.Widgets")
+	}
+
+	s.widget = NewWidget(s.widgetOpts...)
+	s.widgetOpts = nil
+
+	s.first.GetWidget().parent = s.widget
+	s.second.GetWidget().parent = s.widget
+
+	s.divider = NewButton(append(s.dividerOpts, []ButtonOpt{
+		ButtonOpts.KeepPressedOnExit(),
+
+		ButtonOpts.PressedHandler(func(args *ButtonPressedEventArgs) {
+			s.dragging = true
+			s.dividerPressedCursorX, s.dividerPressedCursorY = input.CursorPosition()
+			s.dividerPressedPos = s.Position
+		}),
+
+		ButtonOpts.ReleasedHandler(func(args *ButtonReleasedEventArgs) {
+			s.dragging = false
+
+			// let listeners commit the final position
+			s.ChangedEvent.Fire(&SplitPaneChangedEventArgs{
+				SplitPane: s,
+				Position:  s.Position,
+				Dragging:  false,
+			})
+		}),
+	}...)...)
+	s.dividerOpts = nil
+	s.dividerImage = s.divider.Image
+
+	s.divider.GetWidget().parent = s.widget
+
+	setEventQueueFunc(s.widget, s.ChangedEvent)
+
+	s.widget.owner = s
+}
+
+func (s *SplitPane) theme() *SplitPaneTheme {
+	return themeSection(s.widget, func(t *Theme) *SplitPaneTheme {
+		return t.SplitPane
+	})
+}
+
+func maxInt(a int, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
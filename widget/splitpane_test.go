@@ -0,0 +1,167 @@
+package widget
+
+import (
+	"image"
+	"testing"
+
+	"github.com/blizzy78/ebitenui/event"
+	"github.com/matryer/is"
+)
+
+func TestSplitPane_PreferredSize(t *testing.T) {
+	is := is.New(t)
+
+	s := newSplitPane(t,
+		SplitPaneOpts.Widgets(newSimpleWidget(30, 40, nil), newSimpleWidget(50, 20, nil)),
+		SplitPaneOpts.DividerSize(5))
+
+	w, h := s.PreferredSize()
+	is.Equal(w, 30+5+50)
+	is.Equal(h, 40)
+}
+
+func TestSplitPane_Layout(t *testing.T) {
+	is := is.New(t)
+
+	first := newSimpleWidget(30, 40, nil)
+	second := newSimpleWidget(50, 20, nil)
+
+	s := newSplitPane(t,
+		SplitPaneOpts.Widgets(first, second),
+		SplitPaneOpts.DividerSize(5))
+
+	s.SetLocation(image.Rect(10, 10, 210, 110))
+	render(s, t)
+
+	is.Equal(s.Position, 30)
+	is.Equal(first.GetWidget().Rect, image.Rect(10, 10, 40, 110))
+	is.Equal(s.divider.GetWidget().Rect, image.Rect(40, 10, 45, 110))
+	is.Equal(second.GetWidget().Rect, image.Rect(45, 10, 210, 110))
+}
+
+func TestSplitPane_Layout_Vertical(t *testing.T) {
+	is := is.New(t)
+
+	first := newSimpleWidget(30, 40, nil)
+	second := newSimpleWidget(50, 20, nil)
+
+	s := newSplitPane(t,
+		SplitPaneOpts.Direction(DirectionVertical),
+		SplitPaneOpts.Widgets(first, second),
+		SplitPaneOpts.DividerSize(5))
+
+	s.SetLocation(image.Rect(10, 10, 210, 110))
+	render(s, t)
+
+	is.Equal(s.Position, 40)
+	is.Equal(first.GetWidget().Rect, image.Rect(10, 10, 210, 50))
+	is.Equal(s.divider.GetWidget().Rect, image.Rect(10, 50, 210, 55))
+	is.Equal(second.GetWidget().Rect, image.Rect(10, 55, 210, 110))
+}
+
+func TestSplitPane_MinSizes(t *testing.T) {
+	is := is.New(t)
+
+	s := newSplitPane(t,
+		SplitPaneOpts.Widgets(newSimpleWidget(30, 40, nil), newSimpleWidget(50, 20, nil)),
+		SplitPaneOpts.DividerSize(5),
+		SplitPaneOpts.MinSizes(20, 40))
+
+	s.SetLocation(image.Rect(0, 0, 100, 100))
+
+	s.Position = 5
+	render(s, t)
+	is.Equal(s.Position, 20)
+
+	s.Position = 90
+	render(s, t)
+	is.Equal(s.Position, 100-5-40)
+}
+
+func TestSplitPane_Collapsible(t *testing.T) {
+	is := is.New(t)
+
+	s := newSplitPane(t,
+		SplitPaneOpts.Widgets(newSimpleWidget(30, 40, nil), newSimpleWidget(50, 20, nil)),
+		SplitPaneOpts.DividerSize(5),
+		SplitPaneOpts.MinSizes(20, 40),
+		SplitPaneOpts.Collapsible())
+
+	s.SetLocation(image.Rect(0, 0, 100, 100))
+
+	s.Position = 15
+	render(s, t)
+	is.Equal(s.Position, 20)
+
+	s.Position = 5
+	render(s, t)
+	is.Equal(s.Position, 0)
+
+	s.Position = 80
+	render(s, t)
+	is.Equal(s.Position, 100-5)
+}
+
+func TestSplitPane_ChangedEvent(t *testing.T) {
+	is := is.New(t)
+
+	var eventArgs *SplitPaneChangedEventArgs
+
+	s := newSplitPane(t,
+		SplitPaneOpts.Widgets(newSimpleWidget(30, 40, nil), newSimpleWidget(50, 20, nil)),
+		SplitPaneOpts.ChangedHandler(func(args *SplitPaneChangedEventArgs) {
+			eventArgs = args
+		}))
+
+	s.SetLocation(image.Rect(0, 0, 100, 100))
+	render(s, t)
+	is.True(eventArgs == nil)
+
+	s.Position = 42
+	render(s, t)
+	is.True(eventArgs != nil)
+	is.Equal(eventArgs.Position, 42)
+}
+
+func TestSplitPane_ChangedEvent_Released(t *testing.T) {
+	is := is.New(t)
+
+	var eventArgs *SplitPaneChangedEventArgs
+
+	s := newSplitPane(t,
+		SplitPaneOpts.Widgets(newSimpleWidget(30, 40, nil), newSimpleWidget(50, 20, nil)),
+		SplitPaneOpts.ChangedHandler(func(args *SplitPaneChangedEventArgs) {
+			eventArgs = args
+		}))
+
+	s.SetLocation(image.Rect(0, 0, 100, 100))
+	render(s, t)
+
+	leftMouseButtonPress(s.divider, t)
+	is.True(s.dragging)
+
+	leftMouseButtonRelease(s.divider, t)
+	is.True(eventArgs != nil)
+	is.Equal(eventArgs.Position, 30)
+	is.Equal(eventArgs.Dragging, false)
+}
+
+func TestSplitPane_Widgets_Required(t *testing.T) {
+	is := is.New(t)
+
+	defer func() {
+		is.True(recover() != nil)
+	}()
+
+	newSplitPane(t).GetWidget()
+}
+
+func newSplitPane(t *testing.T, opts ...SplitPaneOpt) *SplitPane {
+	t.Helper()
+
+	s := NewSplitPane(append(opts, SplitPaneOpts.DividerImage(&ButtonImage{
+		Idle: newNineSliceEmpty(t),
+	}))...)
+	event.ExecuteDeferred()
+	return s
+}
@@ -66,6 +66,12 @@ func (s *StateButton) GetWidget() *Widget {
 	return s.button.GetWidget()
 }
 
+// Children implements Composite.
+func (s *StateButton) Children() []HasWidget {
+	s.init.Do()
+	return s.button.Children()
+}
+
 func (s *StateButton) PreferredSize() (int, int) {
 	s.init.Do()
 	return s.button.PreferredSize()
@@ -94,7 +100,15 @@ func (s *StateButton) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 	s.button.Render(screen, def)
 }
 
+// Focus implements Focuser.
+func (s *StateButton) Focus(focused bool) {
+	s.init.Do()
+	s.button.Focus(focused)
+}
+
 func (s *StateButton) createWidget() {
 	s.button = NewButton(append(s.buttonOpts, ButtonOpts.Image(s.images[s.State]))...)
 	s.buttonOpts = nil
+
+	s.button.GetWidget().owner = s
 }
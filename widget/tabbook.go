@@ -10,7 +10,7 @@ import (
 )
 
 type TabBook struct {
-	TabSelectedEvent *event.Event
+	TabSelectedEvent *event.Of[*TabBookTabSelectedEventArgs]
 
 	tabs          []*TabBookTab
 	containerOpts []ContainerOpt
@@ -22,11 +22,13 @@ type TabBook struct {
 	buttonSpacing int
 	spacing       int
 
-	init        *MultiOnce
-	container   *Container
-	tabToButton map[*TabBookTab]*StateButton
-	flipBook    *FlipBook
-	tab         *TabBookTab
+	init             *MultiOnce
+	container        *Container
+	buttonsContainer *Container
+	tabToButton      map[*TabBookTab]*StateButton
+	flipBook         *FlipBook
+	tab              *TabBookTab
+	focused          bool
 }
 
 type TabBookTab struct {
@@ -53,7 +55,7 @@ var TabBookOpts TabBookOptions
 
 func NewTabBook(opts ...TabBookOpt) *TabBook {
 	t := &TabBook{
-		TabSelectedEvent: &event.Event{},
+		TabSelectedEvent: &event.Of[*TabBookTabSelectedEventArgs]{},
 
 		init:        &MultiOnce{},
 		tabToButton: map[*TabBookTab]*StateButton{},
@@ -75,6 +77,11 @@ func NewTabBookTab(label string, widget PreferredSizeLocateableWidget) *TabBookT
 	}
 }
 
+// Label returns the label of t.
+func (t *TabBookTab) Label() string {
+	return t.label
+}
+
 func (o TabBookOptions) ContainerOpts(opts ...ContainerOpt) TabBookOpt {
 	return func(t *TabBook) {
 		t.containerOpts = append(t.containerOpts, opts...)
@@ -129,9 +136,7 @@ func (o TabBookOptions) Tabs(tabs ...*TabBookTab) TabBookOpt {
 
 func (o TabBookOptions) TabSelectedHandler(f TabBookTabSelectedHandlerFunc) TabBookOpt {
 	return func(t *TabBook) {
-		t.TabSelectedEvent.AddHandler(func(args interface{}) {
-			f(args.(*TabBookTabSelectedEventArgs))
-		})
+		t.TabSelectedEvent.AddHandler(f)
 	}
 }
 
@@ -140,8 +145,21 @@ func (t *TabBook) GetWidget() *Widget {
 	return t.container.GetWidget()
 }
 
+// Children implements Composite. It returns the container of the tab buttons, and the widgets
+// of all tabs, including those that are not currently shown.
+func (t *TabBook) Children() []HasWidget {
+	t.init.Do()
+
+	ws := []HasWidget{t.buttonsContainer}
+	for _, tab := range t.tabs {
+		ws = append(ws, tab.widget)
+	}
+	return ws
+}
+
 func (t *TabBook) PreferredSize() (int, int) {
 	t.init.Do()
+	t.applyTheme()
 	return t.container.PreferredSize()
 }
 
@@ -168,9 +186,108 @@ func (t *TabBook) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 		b.GetWidget().Disabled = d || tab.Disabled
 	}
 
+	t.handleActions()
+
+	t.applyTheme()
+
 	t.container.Render(screen, def)
 }
 
+// WidgetAt implements Locater. It returns t itself for its tab buttons, so that t can be focused.
+func (t *TabBook) WidgetAt(x int, y int) HasWidget {
+	t.init.Do()
+
+	p := image.Point{x, y}
+
+	if !p.In(t.container.GetWidget().Rect) {
+		return nil
+	}
+
+	if w := t.flipBook.WidgetAt(x, y); w != nil {
+		return w
+	}
+
+	return t
+}
+
+// Focus implements Focuser. While focused, input.ActionLeft and input.ActionRight select the previous or
+// next enabled tab.
+func (t *TabBook) Focus(focused bool) {
+	t.init.Do()
+	WidgetFireFocusEvent(t.container.GetWidget(), focused)
+	t.focused = focused
+}
+
+func (t *TabBook) handleActions() {
+	if !t.focused || t.container.GetWidget().Disabled {
+		return
+	}
+
+	m := t.container.GetWidget().ActionMap()
+
+	switch {
+	case m.ActionJustPressed(input.ActionLeft):
+		t.selectAdjacentTab(-1)
+	case m.ActionJustPressed(input.ActionRight):
+		t.selectAdjacentTab(1)
+	}
+}
+
+// selectAdjacentTab selects the nearest enabled tab in direction d, if there is one.
+func (t *TabBook) selectAdjacentTab(d int) {
+	i := 0
+	for ti, tab := range t.tabs {
+		if tab == t.tab {
+			i = ti
+			break
+		}
+	}
+
+	for i += d; i >= 0 && i < len(t.tabs); i += d {
+		if !t.tabs[i].Disabled {
+			t.SetTab(t.tabs[i])
+			return
+		}
+	}
+}
+
+// applyTheme updates t's tab buttons according to t's configuration and its theme.
+func (t *TabBook) applyTheme() {
+	th := t.theme()
+	if th == nil {
+		th = &TabBookTheme{}
+	}
+
+	idle, selected := th.TabButtonImage, th.TabButtonSelectedImage
+	if t.buttonImages != nil {
+		idle, selected = t.buttonImages[false], t.buttonImages[true]
+	}
+
+	face := t.buttonFace
+	if face == nil {
+		face = th.TabButtonFace
+	}
+
+	col := t.buttonColor
+	if col == nil {
+		col = th.TabButtonColor
+	}
+
+	for _, b := range t.tabToButton {
+		b.images[false] = idle
+		b.images[true] = selected
+		b.button.Image = b.images[b.State]
+		b.button.textFace = face
+		b.button.TextColor = col
+	}
+}
+
+func (t *TabBook) theme() *TabBookTheme {
+	return themeSection(t.container.GetWidget(), func(th *Theme) *TabBookTheme {
+		return th.TabBook
+	})
+}
+
 func (t *TabBook) createWidget() {
 	t.container = NewContainer(append(t.containerOpts, []ContainerOpt{
 		ContainerOpts.Layout(NewGridLayout(
@@ -181,27 +298,25 @@ func (t *TabBook) createWidget() {
 	}...)...)
 	t.containerOpts = nil
 
-	buttonsContainer := NewContainer(
+	t.buttonsContainer = NewContainer(
 		ContainerOpts.Layout(NewRowLayout(
 			RowLayoutOpts.Spacing(t.buttonSpacing))))
-	t.container.AddChild(buttonsContainer)
+	t.container.AddChild(t.buttonsContainer)
 
 	for _, tab := range t.tabs {
 		tab := tab
 		b := NewStateButton(append(t.buttonOpts, []StateButtonOpt{
-			StateButtonOpts.StateImages(t.buttonImages),
 			StateButtonOpts.ButtonOpts(
-				ButtonOpts.Text(tab.label, t.buttonFace, t.buttonColor),
+				ButtonOpts.Text(tab.label, nil, nil),
 				ButtonOpts.ClickedHandler(func(args *ButtonClickedEventArgs) {
 					t.SetTab(tab)
 				})),
 		}...)...)
-		buttonsContainer.AddChild(b)
+		t.buttonsContainer.AddChild(b)
 
 		t.tabToButton[tab] = b
 	}
 	t.buttonOpts = nil
-	t.buttonImages = nil
 
 	t.flipBook = NewFlipBook(append(t.flipBookOpts,
 		FlipBookOpts.ContainerOpts(ContainerOpts.AutoDisableChildren()))...)
@@ -209,6 +324,10 @@ func (t *TabBook) createWidget() {
 	t.flipBookOpts = nil
 
 	t.setTab(t.tabs[0], false)
+
+	setEventQueueFunc(t.container.GetWidget(), t.TabSelectedEvent)
+
+	t.container.GetWidget().owner = t
 }
 
 func (t *TabBook) SetTab(tab *TabBookTab) {
@@ -240,3 +359,8 @@ func (t *TabBook) setTab(tab *TabBookTab, fireEvent bool) {
 func (t *TabBook) Tab() *TabBookTab {
 	return t.tab
 }
+
+// Tabs returns the tabs of t.
+func (t *TabBook) Tabs() []*TabBookTab {
+	return t.tabs
+}
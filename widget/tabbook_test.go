@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/blizzy78/ebitenui/event"
+	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/matryer/is"
 )
 
@@ -77,6 +78,27 @@ func TestTabBook_TabSelectedEvent_User(t *testing.T) {
 	is.Equal(numEvents, 1)
 }
 
+func TestTabBook_Tab_Actions(t *testing.T) {
+	is := is.New(t)
+
+	tab1 := NewTabBookTab("Tab 1", newSimpleWidget(50, 50, nil))
+	tab2 := NewTabBookTab("Tab 2", newSimpleWidget(50, 50, nil))
+	tab2.Disabled = true
+	tab3 := NewTabBookTab("Tab 3", newSimpleWidget(50, 50, nil))
+
+	tb := newTabBook(t, TabBookOpts.Tabs(tab1, tab2, tab3))
+	tb.Focus(true)
+
+	keyInput(tb, ebiten.KeyArrowRight, t)
+	is.Equal(tb.Tab(), tab3)
+
+	keyInput(tb, ebiten.KeyArrowRight, t)
+	is.Equal(tb.Tab(), tab3)
+
+	keyInput(tb, ebiten.KeyArrowLeft, t)
+	is.Equal(tb.Tab(), tab1)
+}
+
 func newTabBook(t *testing.T, opts ...TabBookOpt) *TabBook {
 	t.Helper()
 
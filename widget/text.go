@@ -21,10 +21,14 @@ type Text struct {
 	widgetOpts         []WidgetOpt
 	horizontalPosition TextPosition
 	verticalPosition   TextPosition
-
-	init         *MultiOnce
-	widget       *Widget
-	measurements textMeasurements
+	wrap               bool
+	themeSections      []func(t *Theme) *TextTheme
+
+	init                *MultiOnce
+	widget              *Widget
+	measurements        textMeasurements
+	wrappedMeasurements textMeasurements
+	preferredSize       *image.Point
 }
 
 type TextOpt func(t *Text)
@@ -41,8 +45,9 @@ type TextOptions struct {
 }
 
 type textMeasurements struct {
-	label string
-	face  font.Face
+	label    string
+	face     font.Face
+	maxWidth int
 
 	lines             []string
 	lineWidths        []float64
@@ -89,6 +94,15 @@ func (o TextOptions) Position(h TextPosition, v TextPosition) TextOpt {
 	}
 }
 
+// Wrap configures a Text to wrap lines at word boundaries so that they fit the width of the widget.
+// The preferred size is still that of the unwrapped text, but the preferred height for a given width
+// is that of the wrapped text.
+func (o TextOptions) Wrap() TextOpt {
+	return func(t *Text) {
+		t.wrap = true
+	}
+}
+
 func (t *Text) GetWidget() *Widget {
 	t.init.Do()
 	return t.widget
@@ -101,18 +115,55 @@ func (t *Text) SetLocation(rect image.Rectangle) {
 
 func (t *Text) PreferredSize() (int, int) {
 	t.init.Do()
+
+	s := t.size()
+	t.preferredSize = &s
+	return s.X, s.Y
+}
+
+// PreferredHeightForWidth implements PreferredHeightForWidther.
+func (t *Text) PreferredHeightForWidth(width int) int {
+	t.init.Do()
+
+	if !t.wrap {
+		return t.size().Y
+	}
+
+	t.wrappedMeasurements = measureText(t.wrappedMeasurements, t.Label, t.face(), width)
+	return int(math.Ceil(t.wrappedMeasurements.boundingBoxHeight))
+}
+
+func (t *Text) size() image.Point {
 	t.measure()
-	return int(math.Ceil(t.measurements.boundingBoxWidth)), int(math.Ceil(t.measurements.boundingBoxHeight))
+	return image.Point{int(math.Ceil(t.measurements.boundingBoxWidth)), int(math.Ceil(t.measurements.boundingBoxHeight))}
 }
 
 func (t *Text) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 	t.init.Do()
+
+	// Label or face may have changed since the preferred size was last used for layout
+	if t.preferredSize != nil && t.size() != *t.preferredSize {
+		t.preferredSize = nil
+		t.widget.requestParentRelayout()
+	}
+
 	t.widget.Render(screen, def)
 	t.draw(screen)
 }
 
 func (t *Text) draw(screen *ebiten.Image) {
-	t.measure()
+	m := &t.measurements
+	if t.wrap {
+		t.wrappedMeasurements = measureText(t.wrappedMeasurements, t.Label, t.face(), t.widget.Rect.Dx())
+		m = &t.wrappedMeasurements
+	} else {
+		t.measure()
+	}
+
+	c := t.color()
+	if m.face == nil || c == nil {
+		return
+	}
 
 	r := t.widget.Rect
 	w := r.Dx()
@@ -120,57 +171,136 @@ func (t *Text) draw(screen *ebiten.Image) {
 
 	switch t.verticalPosition {
 	case TextPositionCenter:
-		p = p.Add(image.Point{0, int((float64(r.Dy()) - t.measurements.boundingBoxHeight) / 2)})
+		p = p.Add(image.Point{0, int((float64(r.Dy()) - m.boundingBoxHeight) / 2)})
 	case TextPositionEnd:
-		p = p.Add(image.Point{0, int((float64(r.Dy()) - t.measurements.boundingBoxHeight))})
+		p = p.Add(image.Point{0, int((float64(r.Dy()) - m.boundingBoxHeight))})
 	}
 
-	for i, line := range t.measurements.lines {
+	for i, line := range m.lines {
 		lx := p.X
 		switch t.horizontalPosition {
 		case TextPositionCenter:
-			lx += int(math.Round((float64(w) - t.measurements.lineWidths[i]) / 2))
+			lx += int(math.Round((float64(w) - m.lineWidths[i]) / 2))
 		case TextPositionEnd:
-			lx += int(math.Ceil(float64(w) - t.measurements.lineWidths[i]))
+			lx += int(math.Ceil(float64(w) - m.lineWidths[i]))
 		}
 
-		ly := int(math.Round(float64(p.Y) + t.measurements.lineHeight*float64(i) + t.measurements.ascent))
+		ly := int(math.Round(float64(p.Y) + m.lineHeight*float64(i) + m.ascent))
 
-		text.Draw(screen, line, t.Face, lx, ly, t.Color)
+		text.Draw(screen, line, m.face, lx, ly, c)
 	}
 }
 
+// measure updates t's measurements of its unwrapped label.
 func (t *Text) measure() {
-	if t.Label == t.measurements.label && t.Face == t.measurements.face {
-		return
+	t.measurements = measureText(t.measurements, t.Label, t.face(), 0)
+}
+
+// measureText measures label when drawn using face, wrapping lines so that they fit into maxWidth. If maxWidth
+// is 0 or less, lines are not wrapped. If nothing has changed since prev has been measured, prev is returned.
+func measureText(prev textMeasurements, label string, face font.Face, maxWidth int) textMeasurements {
+	if maxWidth < 0 {
+		maxWidth = 0
 	}
 
-	m := t.Face.Metrics()
+	if label == prev.label && face == prev.face && maxWidth == prev.maxWidth {
+		return prev
+	}
+
+	// without a face, the text has no size and is not drawn
+	if face == nil {
+		return textMeasurements{
+			label:    label,
+			maxWidth: maxWidth,
+		}
+	}
+
+	m := face.Metrics()
 
-	t.measurements = textMeasurements{
-		label:  t.Label,
-		face:   t.Face,
-		ascent: fixedInt26_6ToFloat64(m.Ascent),
+	tm := textMeasurements{
+		label:    label,
+		face:     face,
+		maxWidth: maxWidth,
+		ascent:   fixedInt26_6ToFloat64(m.Ascent),
 	}
 
 	fh := fixedInt26_6ToFloat64(m.Ascent + m.Descent)
-	t.measurements.lineHeight = fixedInt26_6ToFloat64(m.Height)
-	ld := t.measurements.lineHeight - fh
+	tm.lineHeight = fixedInt26_6ToFloat64(m.Height)
+	ld := tm.lineHeight - fh
 
-	s := bufio.NewScanner(strings.NewReader(t.Label))
+	s := bufio.NewScanner(strings.NewReader(label))
 	for s.Scan() {
-		line := s.Text()
-		t.measurements.lines = append(t.measurements.lines, line)
+		for _, line := range wrapLine(s.Text(), face, maxWidth) {
+			tm.lines = append(tm.lines, line)
+
+			lw := fixedInt26_6ToFloat64(font.MeasureString(face, line))
+			tm.lineWidths = append(tm.lineWidths, lw)
+
+			if lw > tm.boundingBoxWidth {
+				tm.boundingBoxWidth = lw
+			}
+		}
+	}
+
+	tm.boundingBoxHeight = float64(len(tm.lines))*tm.lineHeight - ld
+
+	return tm
+}
 
-		lw := fixedInt26_6ToFloat64(font.MeasureString(t.Face, line))
-		t.measurements.lineWidths = append(t.measurements.lineWidths, lw)
+// wrapLine splits line at word boundaries so that each resulting line fits into maxWidth. Words that do
+// not fit on their own are put on a line of their own. If maxWidth is 0, line is returned as is.
+func wrapLine(line string, face font.Face, maxWidth int) []string {
+	if maxWidth == 0 || fixedInt26_6ToFloat64(font.MeasureString(face, line)) <= float64(maxWidth) {
+		return []string{line}
+	}
+
+	lines := []string{}
+	current := ""
+	for _, word := range strings.Fields(line) {
+		next := word
+		if current != "" {
+			next = current + " " + word
+		}
 
-		if lw > t.measurements.boundingBoxWidth {
-			t.measurements.boundingBoxWidth = lw
+		if current != "" && fixedInt26_6ToFloat64(font.MeasureString(face, next)) > float64(maxWidth) {
+			lines = append(lines, current)
+			current = word
+			continue
 		}
+
+		current = next
+	}
+
+	return append(lines, current)
+}
+
+// textThemeValue returns the first non-zero value returned by v for the theme sections of t's composite widget,
+// such as TextToolTip, and then for the Text section.
+func textThemeValue[V any](t *Text, v func(th *TextTheme) V) V {
+	sections := append(t.themeSections[:len(t.themeSections):len(t.themeSections)], func(th *Theme) *TextTheme {
+		return th.Text
+	})
+	return themeValue(t.widget, sections, v)
+}
+
+// face returns t.Face, or the face of t's theme if it is nil.
+func (t *Text) face() font.Face {
+	if t.Face != nil {
+		return t.Face
 	}
+	return textThemeValue(t, func(th *TextTheme) font.Face {
+		return th.Face
+	})
+}
 
-	t.measurements.boundingBoxHeight = float64(len(t.measurements.lines))*t.measurements.lineHeight - ld
+// color returns t.Color, or the color of t's theme if it is nil.
+func (t *Text) color() color.Color {
+	if t.Color != nil {
+		return t.Color
+	}
+	return textThemeValue(t, func(th *TextTheme) color.Color {
+		return th.Color
+	})
 }
 
 func (t *Text) createWidget() {
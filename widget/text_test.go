@@ -0,0 +1,42 @@
+package widget
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/blizzy78/ebitenui/event"
+	"github.com/matryer/is"
+)
+
+func TestText_PreferredHeightForWidth(t *testing.T) {
+	is := is.New(t)
+
+	tx := newText(t, TextOpts.Text("foo bar baz", loadFont(t), color.White))
+
+	w, h := tx.PreferredSize()
+	is.Equal(tx.PreferredHeightForWidth(w/2), h)
+}
+
+func TestText_PreferredHeightForWidth_Wrap(t *testing.T) {
+	is := is.New(t)
+
+	tx := newText(t,
+		TextOpts.Text("foo bar baz", loadFont(t), color.White),
+		TextOpts.Wrap())
+
+	w, h := tx.PreferredSize()
+	is.Equal(tx.PreferredHeightForWidth(w), h)
+
+	wh := tx.PreferredHeightForWidth(w / 2)
+	is.True(wh > h)
+	is.True(len(tx.wrappedMeasurements.lines) > 1)
+}
+
+func newText(t *testing.T, opts ...TextOpt) *Text {
+	t.Helper()
+
+	tx := NewText(opts...)
+	event.ExecuteDeferred()
+	render(tx, t)
+	return tx
+}
@@ -3,6 +3,8 @@ package widget
 import (
 	img "image"
 
+	"github.com/blizzy78/ebitenui/image"
+
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -11,11 +13,13 @@ type TextToolTip struct {
 
 	containerOpts []ContainerOpt
 	textOpts      []TextOpt
-	padding       Insets
+	padding       *Insets
 
-	init      *MultiOnce
-	container *Container
-	text      *Text
+	init            *MultiOnce
+	container       *Container
+	layout          *AnchorLayout
+	text            *Text
+	backgroundImage *image.NineSlice
 }
 
 type TextToolTipOpt func(t *TextToolTip)
@@ -53,7 +57,7 @@ func (o TextToolTipOptions) TextOpts(opts ...TextOpt) TextToolTipOpt {
 
 func (o TextToolTipOptions) Padding(i Insets) TextToolTipOpt {
 	return func(t *TextToolTip) {
-		t.padding = i
+		t.padding = &i
 	}
 }
 
@@ -62,6 +66,12 @@ func (t *TextToolTip) GetWidget() *Widget {
 	return t.container.GetWidget()
 }
 
+// Children implements Composite.
+func (t *TextToolTip) Children() []HasWidget {
+	t.init.Do()
+	return t.container.Children()
+}
+
 func (t *TextToolTip) SetLocation(rect img.Rectangle) {
 	t.init.Do()
 	t.container.SetLocation(rect)
@@ -71,6 +81,7 @@ func (t *TextToolTip) PreferredSize() (int, int) {
 	t.init.Do()
 
 	t.text.Label = t.Label
+	t.applyTheme()
 
 	return t.container.PreferredSize()
 }
@@ -84,19 +95,58 @@ func (t *TextToolTip) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 	t.init.Do()
 
 	t.text.Label = t.Label
+	t.applyTheme()
 
 	t.container.Render(screen, def)
 }
 
+// applyTheme updates t's background image and padding according to t's configuration and its theme.
+func (t *TextToolTip) applyTheme() {
+	th := themeSection(t.container.GetWidget(), func(th *Theme) *TextToolTipTheme {
+		return th.TextToolTip
+	})
+	if th == nil {
+		th = &TextToolTipTheme{}
+	}
+
+	if t.backgroundImage == nil {
+		t.container.BackgroundImage = th.BackgroundImage
+	}
+
+	p := t.padding
+	if p == nil {
+		p = th.Padding
+	}
+	if p == nil {
+		p = &Insets{}
+	}
+
+	if *p != t.layout.padding {
+		t.layout.padding = *p
+		t.container.RequestRelayout()
+	}
+}
+
 func (t *TextToolTip) createWidget() {
+	t.layout = NewAnchorLayout()
 	t.container = NewContainer(append(t.containerOpts,
-		ContainerOpts.Layout(NewAnchorLayout(
-			AnchorLayoutOpts.Padding(t.padding),
-		)),
+		ContainerOpts.Layout(t.layout),
 	)...)
+	t.containerOpts = nil
+	t.backgroundImage = t.container.BackgroundImage
 
 	t.text = NewText(t.textOpts...)
 	t.text.Label = ""
+	t.text.themeSections = []func(th *Theme) *TextTheme{
+		func(th *Theme) *TextTheme {
+			if th.TextToolTip == nil {
+				return nil
+			}
+			return th.TextToolTip.Text
+		},
+	}
 	t.container.AddChild(t.text)
 	t.textOpts = nil
+
+	t.container.GetWidget().owner = t
 }
@@ -5,7 +5,6 @@ import (
 	"image/color"
 	"math"
 	"strings"
-	"sync/atomic"
 	"time"
 
 	"github.com/blizzy78/ebitenui/event"
@@ -16,7 +15,7 @@ import (
 )
 
 type TextInput struct {
-	ChangedEvent *event.Event
+	ChangedEvent *event.Of[*TextInputChangedEventArgs]
 
 	InputText string
 
@@ -24,17 +23,19 @@ type TextInput struct {
 	caretOpts       []CaretOpt
 	image           *TextInputImage
 	color           *TextInputColor
-	padding         Insets
+	padding         *Insets
 	face            font.Face
-	repeatDelay     time.Duration
-	repeatInterval  time.Duration
+	repeater        input.Repeater
 	validationFunc  TextInputValidationFunc
 	placeholderText string
+	actions         *input.ActionMap
 
 	init            *MultiOnce
 	commandToFunc   map[textInputControlCommand]textInputCommandFunc
 	widget          *Widget
 	caret           *Caret
+	caretFace       font.Face
+	caretWidth      int
 	text            *Text
 	renderBuf       *image.MaskedRenderBuffer
 	mask            *image.NineSlice
@@ -90,27 +91,32 @@ const (
 	textInputDelete
 )
 
-var textInputKeyToCommand = map[ebiten.Key]textInputControlCommand{
-	ebiten.KeyLeft:      textInputGoLeft,
-	ebiten.KeyRight:     textInputGoRight,
-	ebiten.KeyHome:      textInputGoStart,
-	ebiten.KeyEnd:       textInputGoEnd,
-	ebiten.KeyBackspace: textInputBackspace,
-	ebiten.KeyDelete:    textInputDelete,
+var textInputActionToCommand = map[input.Action]textInputControlCommand{
+	input.ActionLeft:           textInputGoLeft,
+	input.ActionRight:          textInputGoRight,
+	input.ActionStart:          textInputGoStart,
+	input.ActionEnd:            textInputGoEnd,
+	input.ActionDeleteBackward: textInputBackspace,
+	input.ActionDeleteForward:  textInputDelete,
+}
+
+// textInputTypingKeys are keys that usually produce input characters.
+var textInputTypingKeys = []ebiten.Key{
+	ebiten.KeySpace, ebiten.KeyQuote, ebiten.KeyBackquote, ebiten.KeyBackslash, ebiten.KeyBracketLeft,
+	ebiten.KeyBracketRight, ebiten.KeyComma, ebiten.KeyEqual, ebiten.KeyMinus, ebiten.KeyPeriod,
+	ebiten.KeySemicolon, ebiten.KeySlash, ebiten.KeyNumpadAdd, ebiten.KeyNumpadDecimal, ebiten.KeyNumpadDivide,
+	ebiten.KeyNumpadMultiply, ebiten.KeyNumpadSubtract,
 }
 
 func NewTextInput(opts ...TextInputOpt) *TextInput {
 	t := &TextInput{
-		ChangedEvent: &event.Event{},
-
-		repeatDelay:    300 * time.Millisecond,
-		repeatInterval: 35 * time.Millisecond,
+		ChangedEvent: &event.Of[*TextInputChangedEventArgs]{},
 
 		init:          &MultiOnce{},
 		commandToFunc: map[textInputControlCommand]textInputCommandFunc{},
 		renderBuf:     image.NewMaskedRenderBuffer(),
 	}
-	t.state = t.idleState(true)
+	t.state = t.idleState()
 
 	t.commandToFunc[textInputGoLeft] = t.doGoLeft
 	t.commandToFunc[textInputGoRight] = t.doGoRight
@@ -142,9 +148,7 @@ func (o TextInputOptions) CaretOpts(opts ...CaretOpt) TextInputOpt {
 
 func (o TextInputOptions) ChangedHandler(f TextInputChangedHandlerFunc) TextInputOpt {
 	return func(t *TextInput) {
-		t.ChangedEvent.AddHandler(func(args interface{}) {
-			f(args.(*TextInputChangedEventArgs))
-		})
+		t.ChangedEvent.AddHandler(f)
 	}
 }
 
@@ -162,7 +166,7 @@ func (o TextInputOptions) Color(c *TextInputColor) TextInputOpt {
 
 func (o TextInputOptions) Padding(i Insets) TextInputOpt {
 	return func(t *TextInput) {
-		t.padding = i
+		t.padding = &i
 	}
 }
 
@@ -172,9 +176,17 @@ func (o TextInputOptions) Face(f font.Face) TextInputOpt {
 	}
 }
 
+// RepeatDelay configures a text input to start repeating held cursor movement and text deletion after
+// delay d. By default, input.KeyRepeatDelay is used.
+func (o TextInputOptions) RepeatDelay(d time.Duration) TextInputOpt {
+	return func(t *TextInput) {
+		t.repeater.Delay = d
+	}
+}
+
 func (o TextInputOptions) RepeatInterval(i time.Duration) TextInputOpt {
 	return func(t *TextInput) {
-		t.repeatInterval = i
+		t.repeater.Interval = i
 	}
 }
 
@@ -196,11 +208,25 @@ func (o TextInputOptions) Secure(b bool) TextInputOpt {
 	}
 }
 
+// ActionMap configures a text input to use action map m to map user input to cursor movement and
+// text deletion. By default, the widget's action map is used, see Widget.ActionMap.
+func (o TextInputOptions) ActionMap(m *input.ActionMap) TextInputOpt {
+	return func(t *TextInput) {
+		t.actions = m
+	}
+}
+
 func (t *TextInput) GetWidget() *Widget {
 	t.init.Do()
 	return t.widget
 }
 
+// Children implements Composite.
+func (t *TextInput) Children() []HasWidget {
+	t.init.Do()
+	return []HasWidget{t.text, t.caret}
+}
+
 func (t *TextInput) SetLocation(rect img.Rectangle) {
 	t.init.Do()
 	t.widget.Rect = rect
@@ -208,8 +234,10 @@ func (t *TextInput) SetLocation(rect img.Rectangle) {
 
 func (t *TextInput) PreferredSize() (int, int) {
 	t.init.Do()
+	t.applyTheme()
 	_, h := t.caret.PreferredSize()
-	return 50, h + t.padding.Top + t.padding.Bottom
+	p := t.resolvedPadding()
+	return 50, h + p.Top + p.Bottom
 }
 
 func (t *TextInput) Render(screen *ebiten.Image, def DeferredRenderFunc) {
@@ -248,14 +276,15 @@ func (t *TextInput) Render(screen *ebiten.Image, def DeferredRenderFunc) {
 
 	t.widget.Render(screen, def)
 
+	t.applyTheme()
 	t.renderImage(screen)
 	t.renderTextAndCaret(screen, def)
 }
 
-func (t *TextInput) idleState(newKeyOrCommand bool) textInputState {
+func (t *TextInput) idleState() textInputState {
 	return func() (textInputState, bool) {
 		if !t.focused {
-			return t.idleState(true), false
+			return t.idleState(), false
 		}
 
 		chars := input.InputChars()
@@ -263,7 +292,7 @@ func (t *TextInput) idleState(newKeyOrCommand bool) textInputState {
 			return t.charsInputState(chars), true
 		}
 
-		st := textInputCheckForCommand(t, newKeyOrCommand)
+		st := textInputCheckForCommand(t)
 		if st != nil {
 			return st, true
 		}
@@ -272,24 +301,15 @@ func (t *TextInput) idleState(newKeyOrCommand bool) textInputState {
 			t.doGoXY(input.CursorPosition())
 		}
 
-		return t.idleState(true), false
+		return t.idleState(), false
 	}
 }
 
-func textInputCheckForCommand(t *TextInput, newKeyOrCommand bool) textInputState {
-	for key, cmd := range textInputKeyToCommand {
-		if !input.KeyPressed(key) {
-			continue
-		}
-
-		var delay time.Duration
-		if newKeyOrCommand {
-			delay = t.repeatDelay
-		} else {
-			delay = t.repeatInterval
+func textInputCheckForCommand(t *TextInput) textInputState {
+	for action, cmd := range textInputActionToCommand {
+		if t.actionMap().ActionPressed(action) {
+			return t.commandState(cmd, action)
 		}
-
-		return t.commandState(cmd, key, delay, nil, nil)
 	}
 
 	return nil
@@ -303,31 +323,20 @@ func (t *TextInput) charsInputState(c []rune) textInputState {
 
 		t.caret.ResetBlinking()
 
-		return t.idleState(true), false
+		return t.idleState(), false
 	}
 }
 
-func (t *TextInput) commandState(cmd textInputControlCommand, key ebiten.Key, delay time.Duration, timer *time.Timer, expired *atomic.Value) textInputState {
+func (t *TextInput) commandState(cmd textInputControlCommand, action input.Action) textInputState {
 	return func() (textInputState, bool) {
-		if !input.KeyPressed(key) {
-			return t.idleState(true), true
-		}
+		pressed := t.focused && t.actionMap().ActionPressed(action)
 
-		if timer != nil && expired.Load().(bool) {
-			return t.idleState(false), true
-		}
-
-		if timer == nil {
+		if fire, _ := t.repeater.Update(pressed, timeNow()); fire {
 			t.commandToFunc[cmd]()
+		}
 
-			expired = &atomic.Value{}
-			expired.Store(false)
-
-			timer = time.AfterFunc(delay, func() {
-				expired.Store(true)
-			})
-
-			return t.commandState(cmd, key, delay, timer, expired), false
+		if !pressed {
+			return t.idleState(), true
 		}
 
 		return nil, false
@@ -372,7 +381,7 @@ func (t *TextInput) doGoEnd() {
 func (t *TextInput) doGoXY(x int, y int) {
 	p := img.Point{x, y}
 	if p.In(t.widget.Rect) {
-		tr := t.padding.Apply(t.widget.Rect)
+		tr := t.resolvedPadding().Apply(t.widget.Rect)
 		if x < tr.Min.X {
 			x = tr.Min.X
 		}
@@ -380,7 +389,7 @@ func (t *TextInput) doGoXY(x int, y int) {
 			x = tr.Max.X
 		}
 
-		t.cursorPosition = fontStringIndex([]rune(t.InputText), t.face, x-t.scrollOffset-tr.Min.X)
+		t.cursorPosition = fontStringIndex([]rune(t.InputText), t.resolvedFace(), x-t.scrollOffset-tr.Min.X)
 		t.caret.ResetBlinking()
 	}
 }
@@ -416,10 +425,10 @@ func removeChar(r []rune, pos int) []rune {
 }
 
 func (t *TextInput) renderImage(screen *ebiten.Image) {
-	if t.image != nil {
-		i := t.image.Idle
-		if t.widget.Disabled && t.image.Disabled != nil {
-			i = t.image.Disabled
+	if ti := t.resolvedImage(); ti != nil {
+		i := ti.Idle
+		if t.widget.Disabled && ti.Disabled != nil {
+			i = ti.Disabled
 		}
 
 		rect := t.widget.Rect
@@ -436,9 +445,10 @@ func (t *TextInput) renderTextAndCaret(screen *ebiten.Image, def DeferredRenderF
 		},
 		func(buf *ebiten.Image) {
 			rect := t.widget.Rect
-			t.mask.Draw(buf, rect.Dx()-t.padding.Left-t.padding.Right, rect.Dy()-t.padding.Top-t.padding.Bottom,
+			p := t.resolvedPadding()
+			t.mask.Draw(buf, rect.Dx()-p.Left-p.Right, rect.Dy()-p.Top-p.Bottom,
 				func(opts *ebiten.DrawImageOptions) {
-					opts.GeoM.Translate(float64(rect.Min.X+t.padding.Left), float64(rect.Min.Y+t.padding.Top))
+					opts.GeoM.Translate(float64(rect.Min.X+p.Left), float64(rect.Min.Y+p.Top))
 					opts.CompositeMode = ebiten.CompositeModeCopy
 				})
 		})
@@ -446,8 +456,9 @@ func (t *TextInput) renderTextAndCaret(screen *ebiten.Image, def DeferredRenderF
 
 func (t *TextInput) drawTextAndCaret(screen *ebiten.Image, def DeferredRenderFunc) {
 	rect := t.widget.Rect
+	p := t.resolvedPadding()
 	tr := rect
-	tr = tr.Add(img.Point{t.padding.Left, t.padding.Top})
+	tr = tr.Add(img.Point{p.Left, p.Top})
 
 	inputStr := t.InputText
 	if t.secure {
@@ -457,14 +468,14 @@ func (t *TextInput) drawTextAndCaret(screen *ebiten.Image, def DeferredRenderFun
 	cx := 0
 	if t.focused {
 		sub := string([]rune(inputStr)[:t.cursorPosition])
-		cx = fontAdvance(sub, t.face)
+		cx = fontAdvance(sub, t.resolvedFace())
 
-		dx := tr.Min.X + t.scrollOffset + cx + t.caret.Width + t.padding.Right - rect.Max.X
+		dx := tr.Min.X + t.scrollOffset + cx + t.caret.Width + p.Right - rect.Max.X
 		if dx > 0 {
 			t.scrollOffset -= dx
 		}
 
-		dx = tr.Min.X + t.scrollOffset + cx - t.padding.Left - rect.Min.X
+		dx = tr.Min.X + t.scrollOffset + cx - p.Left - rect.Min.X
 		if dx < 0 {
 			t.scrollOffset -= dx
 		}
@@ -478,18 +489,19 @@ func (t *TextInput) drawTextAndCaret(screen *ebiten.Image, def DeferredRenderFun
 	} else {
 		t.text.Label = t.placeholderText
 	}
+	col := t.resolvedColor()
 	if t.widget.Disabled || len([]rune(t.InputText)) == 0 {
-		t.text.Color = t.color.Disabled
+		t.text.Color = col.Disabled
 	} else {
-		t.text.Color = t.color.Idle
+		t.text.Color = col.Idle
 	}
 	t.text.Render(screen, def)
 
 	if t.focused {
 		if t.widget.Disabled {
-			t.caret.Color = t.color.DisabledCaret
+			t.caret.Color = col.DisabledCaret
 		} else {
-			t.caret.Color = t.color.Caret
+			t.caret.Color = col.Caret
 		}
 
 		tr = tr.Add(img.Point{cx, 0})
@@ -506,16 +518,125 @@ func (t *TextInput) Focus(focused bool) {
 	t.focused = focused
 }
 
+// ConsumesKeyChord implements KeyConsumer. While focused, t consumes all key chords that produce input
+// characters, as well as key chords bound to its actions, such as cursor movement.
+func (t *TextInput) ConsumesKeyChord(c input.KeyChord) bool {
+	if !t.focused {
+		return false
+	}
+
+	// key bindings without modifiers also apply while modifier keys are pressed
+	b := input.KeyBinding(c.Key, c.Modifiers)
+	kb := input.KeyBinding(c.Key)
+	for action := range textInputActionToCommand {
+		if t.actionMap().BoundTo(action, b) || t.actionMap().BoundTo(action, kb) {
+			return true
+		}
+	}
+
+	return c.Modifiers&^input.ModifierShift == 0 && textInputTypingKey(c.Key)
+}
+
+func (t *TextInput) actionMap() *input.ActionMap {
+	if t.actions != nil {
+		return t.actions
+	}
+	return t.widget.ActionMap()
+}
+
+func textInputTypingKey(k ebiten.Key) bool {
+	switch {
+	case k >= ebiten.KeyA && k <= ebiten.KeyZ,
+		k >= ebiten.KeyDigit0 && k <= ebiten.KeyDigit9,
+		k >= ebiten.KeyNumpad0 && k <= ebiten.KeyNumpad9:
+		return true
+	}
+
+	for _, tk := range textInputTypingKeys {
+		if tk == k {
+			return true
+		}
+	}
+
+	return false
+}
+
+// applyTheme updates t's text and caret according to t's configuration and its theme.
+func (t *TextInput) applyTheme() {
+	f := t.resolvedFace()
+	t.text.Face = f
+
+	t.caret.face = t.caretFace
+	if t.caret.face == nil {
+		t.caret.face = f
+	}
+
+	t.caret.Width = t.caretWidth
+	if th := t.theme(); t.caret.Width == 0 && th != nil {
+		t.caret.Width = th.CaretWidth
+	}
+}
+
+func (t *TextInput) theme() *TextInputTheme {
+	return themeSection(t.widget, func(th *Theme) *TextInputTheme {
+		return th.TextInput
+	})
+}
+
+func (t *TextInput) resolvedImage() *TextInputImage {
+	if t.image != nil {
+		return t.image
+	}
+	if th := t.theme(); th != nil {
+		return th.Image
+	}
+	return nil
+}
+
+func (t *TextInput) resolvedColor() *TextInputColor {
+	if t.color != nil {
+		return t.color
+	}
+	if th := t.theme(); th != nil && th.Color != nil {
+		return th.Color
+	}
+	return &TextInputColor{}
+}
+
+func (t *TextInput) resolvedFace() font.Face {
+	if t.face != nil {
+		return t.face
+	}
+	if th := t.theme(); th != nil {
+		return th.Face
+	}
+	return nil
+}
+
+func (t *TextInput) resolvedPadding() Insets {
+	if t.padding != nil {
+		return *t.padding
+	}
+	if th := t.theme(); th != nil && th.Padding != nil {
+		return *th.Padding
+	}
+	return Insets{}
+}
+
 func (t *TextInput) createWidget() {
 	t.widget = NewWidget(t.widgetOpts...)
 	t.widgetOpts = nil
 
-	t.caret = NewCaret(append(t.caretOpts, CaretOpts.Color(t.color.Caret))...)
+	t.caret = NewCaret(t.caretOpts...)
 	t.caretOpts = nil
+	t.caretFace = t.caret.face
+	t.caretWidth = t.caret.Width
 
-	t.text = NewText(TextOpts.Text("", t.face, color.White))
+	t.text = NewText(TextOpts.Text("", nil, color.White))
 
 	t.mask = image.NewNineSliceColor(color.RGBA{255, 0, 255, 255})
+
+	setEventQueueFunc(t.widget, t.ChangedEvent)
 }
 
 func fontAdvance(s string, f font.Face) int {
@@ -3,8 +3,11 @@ package widget
 import (
 	"image/color"
 	"testing"
+	"time"
 
 	"github.com/blizzy78/ebitenui/event"
+	"github.com/blizzy78/ebitenui/input"
+	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/matryer/is"
 )
 
@@ -45,8 +48,8 @@ func TestTextInput_DoBackspace(t *testing.T) {
 	ti.cursorPosition = 1
 	render(ti, t)
 
-	ti.ChangedEvent.AddHandler(func(args interface{}) {
-		is.Equal(args.(*TextInputChangedEventArgs).InputText, "oo")
+	ti.ChangedEvent.AddHandler(func(args *TextInputChangedEventArgs) {
+		is.Equal(args.InputText, "oo")
 	})
 
 	ti.doBackspace()
@@ -62,7 +65,7 @@ func TestTextInput_DoBackspace_Disabled(t *testing.T) {
 	ti.cursorPosition = 1
 	render(ti, t)
 
-	ti.ChangedEvent.AddHandler(func(args interface{}) {
+	ti.ChangedEvent.AddHandler(func(args *TextInputChangedEventArgs) {
 		is.Fail() // received event even though widget is disabled
 	})
 
@@ -77,8 +80,8 @@ func TestTextInput_DoDelete(t *testing.T) {
 	ti.InputText = "foo"
 	render(ti, t)
 
-	ti.ChangedEvent.AddHandler(func(args interface{}) {
-		is.Equal(args.(*TextInputChangedEventArgs).InputText, "oo")
+	ti.ChangedEvent.AddHandler(func(args *TextInputChangedEventArgs) {
+		is.Equal(args.InputText, "oo")
 	})
 
 	ti.doDelete()
@@ -93,7 +96,7 @@ func TestTextInput_DoDelete_Disabled(t *testing.T) {
 	ti.InputText = "foo"
 	render(ti, t)
 
-	ti.ChangedEvent.AddHandler(func(args interface{}) {
+	ti.ChangedEvent.AddHandler(func(args *TextInputChangedEventArgs) {
 		is.Fail() // received event even though widget is disabled
 	})
 
@@ -115,6 +118,122 @@ func TestTextInput_DoInsert(t *testing.T) {
 	is.Equal(ti.cursorPosition, 5)
 }
 
+func TestTextInput_ConsumesKeyChord(t *testing.T) {
+	is := is.New(t)
+
+	ti := newTextInput(t)
+	is.True(!ti.ConsumesKeyChord(input.NewKeyChord(ebiten.KeyA)))
+
+	ti.Focus(true)
+	is.True(ti.ConsumesKeyChord(input.NewKeyChord(ebiten.KeyA)))
+	is.True(ti.ConsumesKeyChord(input.NewKeyChord(ebiten.KeyA, input.ModifierShift)))
+	is.True(ti.ConsumesKeyChord(input.NewKeyChord(ebiten.KeyDigit5)))
+	is.True(ti.ConsumesKeyChord(input.NewKeyChord(ebiten.KeySpace)))
+	is.True(ti.ConsumesKeyChord(input.NewKeyChord(ebiten.KeyBackspace)))
+	is.True(ti.ConsumesKeyChord(input.NewKeyChord(ebiten.KeyBackspace, input.ModifierShift)))
+	is.True(!ti.ConsumesKeyChord(input.NewKeyChord(ebiten.KeyS, input.ModifierControl)))
+	is.True(!ti.ConsumesKeyChord(input.NewKeyChord(ebiten.KeyEscape)))
+	is.True(!ti.ConsumesKeyChord(input.NewKeyChord(ebiten.KeyF1)))
+}
+
+func TestTextInput_ActionMap(t *testing.T) {
+	is := is.New(t)
+
+	m := input.NewActionMap()
+	m.Bind(input.ActionStart, input.KeyBinding(ebiten.KeyF2))
+
+	ti := newTextInput(t, TextInputOpts.ActionMap(m))
+	ti.InputText = "foo"
+	ti.cursorPosition = 3
+	ti.Focus(true)
+
+	fakeInput.SetKey(ebiten.KeyHome, true)
+	inputFrame(t)
+	render(ti, t)
+	is.Equal(ti.cursorPosition, 3)
+
+	fakeInput.SetKey(ebiten.KeyHome, false)
+	fakeInput.SetKey(ebiten.KeyF2, true)
+	inputFrame(t)
+	render(ti, t)
+	is.Equal(ti.cursorPosition, 0)
+
+	fakeInput.SetKey(ebiten.KeyF2, false)
+	inputFrame(t)
+	is.True(ti.ConsumesKeyChord(input.NewKeyChord(ebiten.KeyF2)))
+	is.True(!ti.ConsumesKeyChord(input.NewKeyChord(ebiten.KeyHome)))
+}
+
+func TestTextInput_Shift(t *testing.T) {
+	is := is.New(t)
+
+	ti := newTextInput(t)
+	ti.InputText = "foo"
+	ti.cursorPosition = 3
+	ti.Focus(true)
+
+	fakeInput.SetKey(ebiten.KeyShift, true)
+	fakeInput.SetKey(ebiten.KeyBackspace, true)
+	inputFrame(t)
+	render(ti, t)
+	is.Equal(ti.InputText, "fo")
+
+	fakeInput.SetKey(ebiten.KeyBackspace, false)
+	inputFrame(t)
+	render(ti, t)
+
+	fakeInput.SetKey(ebiten.KeyArrowLeft, true)
+	inputFrame(t)
+	render(ti, t)
+	is.Equal(ti.cursorPosition, 1)
+
+	fakeInput.SetKey(ebiten.KeyArrowLeft, false)
+	fakeInput.SetKey(ebiten.KeyShift, false)
+	inputFrame(t)
+}
+
+func TestTextInput_Repeat(t *testing.T) {
+	is := is.New(t)
+
+	now := fakeTime(t)
+
+	ti := newTextInput(t,
+		TextInputOpts.RepeatDelay(100*time.Millisecond),
+		TextInputOpts.RepeatInterval(10*time.Millisecond))
+	ti.InputText = "foobar"
+	ti.cursorPosition = 6
+	ti.Focus(true)
+
+	fakeInput.SetKey(ebiten.KeyArrowLeft, true)
+	inputFrame(t)
+	render(ti, t)
+	is.Equal(ti.cursorPosition, 5)
+
+	*now = now.Add(50 * time.Millisecond)
+	render(ti, t)
+	is.Equal(ti.cursorPosition, 5)
+
+	*now = now.Add(50 * time.Millisecond)
+	render(ti, t)
+	is.Equal(ti.cursorPosition, 4)
+
+	*now = now.Add(10 * time.Millisecond)
+	render(ti, t)
+	is.Equal(ti.cursorPosition, 3)
+
+	fakeInput.SetKey(ebiten.KeyArrowLeft, false)
+	inputFrame(t)
+	render(ti, t)
+	is.Equal(ti.cursorPosition, 3)
+
+	fakeInput.SetKey(ebiten.KeyArrowLeft, true)
+	inputFrame(t)
+	render(ti, t)
+	fakeInput.SetKey(ebiten.KeyArrowLeft, false)
+	inputFrame(t)
+	is.Equal(ti.cursorPosition, 2)
+}
+
 func newTextInput(t *testing.T, opts ...TextInputOpt) *TextInput {
 	ti := NewTextInput(append(opts, []TextInputOpt{
 		TextInputOpts.Face(loadFont(t)),
@@ -0,0 +1,233 @@
+package widget
+
+import (
+	"image/color"
+	"reflect"
+
+	"github.com/blizzy78/ebitenui/image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"golang.org/x/image/font"
+)
+
+// Theme defines defaults for the images, font faces, colors, and paddings of the built-in widgets.
+//
+// A theme is attached to a widget using WidgetOpts.Theme, Container.SetTheme, or UI.Theme, and applies
+// to the widget and all of its descendants. Sections that are nil are inherited from the theme of the
+// nearest parent that defines them. Images, faces, colors, and paddings configured explicitly using a
+// widget's options take precedence over the theme.
+//
+// Sections of composite widgets, such as ComboButton, may contain sections for the widgets they are made
+// of. Fields that are not set in those are taken from the more general sections, for example, from
+// ComboButton.Button for a SelectComboButton, and then from Button.
+type Theme struct {
+	Button            *ButtonTheme
+	Checkbox          *CheckboxTheme
+	Label             *LabelTheme
+	Text              *TextTheme
+	TextInput         *TextInputTheme
+	Slider            *SliderTheme
+	ScrollContainer   *ScrollContainerTheme
+	List              *ListTheme
+	TabBook           *TabBookTheme
+	SplitPane         *SplitPaneTheme
+	ComboButton       *ComboButtonTheme
+	SelectComboButton *ComboButtonTheme
+	ListComboButton   *ListComboButtonTheme
+	BindingButton     *BindingButtonTheme
+	TextToolTip       *TextToolTipTheme
+	FlipBook          *FlipBookTheme
+	Graphic           *GraphicTheme
+	Window            *WindowTheme
+}
+
+// ButtonTheme defines defaults for Button.
+type ButtonTheme struct {
+	Image       *ButtonImage
+	TextFace    font.Face
+	TextColor   *ButtonTextColor
+	TextPadding *Insets
+
+	// GraphicImage is used by buttons configured using ButtonOpts.TextAndImage without an image.
+	GraphicImage *ButtonImageImage
+}
+
+// CheckboxTheme defines defaults for Checkbox and LabeledCheckbox.
+type CheckboxTheme struct {
+	ButtonImage *ButtonImage
+	Image       *CheckboxGraphicImage
+}
+
+// LabelTheme defines defaults for Label and LabeledCheckbox.
+type LabelTheme struct {
+	Face  font.Face
+	Color *LabelColor
+}
+
+// TextTheme defines defaults for Text.
+type TextTheme struct {
+	Face  font.Face
+	Color color.Color
+}
+
+// TextInputTheme defines defaults for TextInput.
+type TextInputTheme struct {
+	Image      *TextInputImage
+	Color      *TextInputColor
+	Face       font.Face
+	Padding    *Insets
+	CaretWidth int
+}
+
+// SliderTheme defines defaults for Slider.
+type SliderTheme struct {
+	TrackImage   *SliderTrackImage
+	HandleImage  *ButtonImage
+	TrackPadding *Insets
+}
+
+// ScrollContainerTheme defines defaults for ScrollContainer.
+type ScrollContainerTheme struct {
+	Image   *ScrollContainerImage
+	Padding *Insets
+}
+
+// ListTheme defines defaults for List, and for the lists of ListComboButton.
+type ListTheme struct {
+	ScrollContainerImage *ScrollContainerImage
+	SliderTrackImage     *SliderTrackImage
+	SliderHandleImage    *ButtonImage
+	EntryFace            font.Face
+	EntryColor           *ListEntryColor
+	EntryTextPadding     *Insets
+}
+
+// TabBookTheme defines defaults for TabBook.
+type TabBookTheme struct {
+	TabButtonImage         *ButtonImage
+	TabButtonSelectedImage *ButtonImage
+	TabButtonFace          font.Face
+	TabButtonColor         *ButtonTextColor
+}
+
+// SplitPaneTheme defines defaults for SplitPane.
+type SplitPaneTheme struct {
+	DividerImage *ButtonImage
+}
+
+// ComboButtonTheme defines defaults for ComboButton and SelectComboButton.
+type ComboButtonTheme struct {
+	Button *ButtonTheme
+}
+
+// ListComboButtonTheme defines defaults for ListComboButton.
+type ListComboButtonTheme struct {
+	Button *ButtonTheme
+	List   *ListTheme
+}
+
+// BindingButtonTheme defines defaults for BindingButton.
+type BindingButtonTheme struct {
+	Button *ButtonTheme
+}
+
+// TextToolTipTheme defines defaults for TextToolTip.
+type TextToolTipTheme struct {
+	BackgroundImage *image.NineSlice
+	Padding         *Insets
+	Text            *TextTheme
+}
+
+// FlipBookTheme defines defaults for FlipBook.
+type FlipBookTheme struct {
+	Padding *Insets
+}
+
+// GraphicTheme defines defaults for Graphic. The images are shown by graphics that have no image of their own.
+type GraphicTheme struct {
+	Image          *ebiten.Image
+	ImageNineSlice *image.NineSlice
+}
+
+// WindowTheme defines defaults for Window.
+type WindowTheme struct {
+	// BackgroundImage is drawn behind the contents of windows whose contents have no background image.
+	BackgroundImage *image.NineSlice
+}
+
+// Theme configures a Widget to use theme t. It applies to the widget and all of its descendants.
+func (o WidgetOptions) Theme(t *Theme) WidgetOpt {
+	return func(w *Widget) {
+		w.theme = t
+	}
+}
+
+// Theme returns the theme of w, or the theme of its nearest parent that has one. It returns nil if there is none.
+func (w *Widget) Theme() *Theme {
+	for ; w != nil; w = w.themeParent() {
+		if w.theme != nil {
+			return w.theme
+		}
+	}
+	return nil
+}
+
+// SetTheme sets the theme of c to t, which applies to c and all of its descendants. Existing widgets are
+// refreshed to use the new theme.
+func (c *Container) SetTheme(t *Theme) {
+	c.init.Do()
+	setTheme(c, t)
+}
+
+// setTheme sets the theme of w to t and requests relayouts of w and all of its descendants, so that they
+// pick up the theme's images, faces, and paddings.
+func setTheme(w HasWidget, t *Theme) {
+	if w.GetWidget().theme == t {
+		return
+	}
+
+	w.GetWidget().theme = t
+
+	if r, ok := w.(Relayoutable); ok {
+		r.RequestRelayout()
+	}
+}
+
+// themeSection returns the section of the theme of w or of its nearest parent that defines it, as
+// returned by f. It returns nil if there is no such theme.
+func themeSection[T any](w *Widget, f func(t *Theme) *T) *T {
+	for ; w != nil; w = w.themeParent() {
+		if w.theme == nil {
+			continue
+		}
+
+		if s := f(w.theme); s != nil {
+			return s
+		}
+	}
+	return nil
+}
+
+// themeValue returns the first non-zero value returned by v for sections, ordered from most to least specific,
+// as found using themeSection. It returns the zero value if there is none.
+func themeValue[T any, V any](w *Widget, sections []func(t *Theme) *T, v func(s *T) V) V {
+	for _, f := range sections {
+		if s := themeSection(w, f); s != nil {
+			if val := v(s); !reflect.ValueOf(&val).Elem().IsZero() {
+				return val
+			}
+		}
+	}
+
+	var zero V
+	return zero
+}
+
+// themeParent returns the widget that w inherits its theme from: its parent, or, for widgets that are shown
+// outside of the widget tree, such as the content of a ComboButton, the widget they are shown for.
+func (w *Widget) themeParent() *Widget {
+	if w.parent != nil {
+		return w.parent
+	}
+	return w.themeOwner
+}
@@ -0,0 +1,340 @@
+package widget
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/blizzy78/ebitenui/event"
+	"github.com/matryer/is"
+)
+
+func TestTheme_Inherited(t *testing.T) {
+	is := is.New(t)
+
+	face := loadFont(t)
+	th := &Theme{
+		Label: &LabelTheme{
+			Face: face,
+			Color: &LabelColor{
+				Idle: color.White,
+			},
+		},
+	}
+
+	l := NewLabel()
+	c := newThemeContainer(t, th, l)
+	render(c, t)
+
+	is.Equal(labelText(l).Face, face)
+	is.Equal(labelText(l).Color, color.White)
+}
+
+func TestTheme_None(t *testing.T) {
+	is := is.New(t)
+
+	l := NewLabel()
+	l.Label = "foo"
+	tx := NewText(TextOpts.Text("bar", nil, nil))
+	c := newThemeContainer(t, nil, l, tx)
+	render(c, t)
+
+	w, h := l.PreferredSize()
+	is.Equal(w, 0)
+	is.Equal(h, 0)
+
+	w, h = tx.PreferredSize()
+	is.Equal(w, 0)
+	is.Equal(h, 0)
+}
+
+func TestTheme_Explicit(t *testing.T) {
+	is := is.New(t)
+
+	th := &Theme{
+		Label: &LabelTheme{
+			Face: loadFont(t),
+			Color: &LabelColor{
+				Idle: color.White,
+			},
+		},
+	}
+
+	l := NewLabel(LabelOpts.Text("", loadFont(t), &LabelColor{
+		Idle: color.Black,
+	}))
+	c := newThemeContainer(t, th, l)
+	render(c, t)
+
+	is.Equal(labelText(l).Color, color.Black)
+}
+
+func TestTheme_SectionFromParent(t *testing.T) {
+	is := is.New(t)
+
+	buttonImage := &ButtonImage{
+		Idle: newNineSliceEmpty(t),
+	}
+
+	outer := &Theme{
+		Button: &ButtonTheme{
+			Image: buttonImage,
+		},
+		Label: &LabelTheme{
+			Face: loadFont(t),
+			Color: &LabelColor{
+				Idle: color.White,
+			},
+		},
+	}
+
+	inner := &Theme{
+		Label: &LabelTheme{
+			Face: loadFont(t),
+			Color: &LabelColor{
+				Idle: color.Black,
+			},
+		},
+	}
+
+	b := NewButton()
+	l := NewLabel()
+	c := newThemeContainer(t, inner, b, l)
+	o := newThemeContainer(t, outer, c)
+	render(o, t)
+
+	is.Equal(b.image(), buttonImage)
+	is.Equal(labelText(l).Color, color.Black)
+}
+
+func TestContainer_SetTheme(t *testing.T) {
+	is := is.New(t)
+
+	l := NewLabel()
+	c := newThemeContainer(t, &Theme{
+		Label: &LabelTheme{
+			Face: loadFont(t),
+			Color: &LabelColor{
+				Idle: color.White,
+			},
+		},
+	}, l)
+	render(c, t)
+
+	c.SetTheme(&Theme{
+		Label: &LabelTheme{
+			Face: loadFont(t),
+			Color: &LabelColor{
+				Idle: color.Black,
+			},
+		},
+	})
+	render(c, t)
+
+	is.Equal(labelText(l).Color, color.Black)
+}
+
+func TestTheme_ComboButton(t *testing.T) {
+	is := is.New(t)
+
+	buttonImage := &ButtonImage{
+		Idle: newNineSliceEmpty(t),
+	}
+	comboImage := &ButtonImage{
+		Idle: newNineSliceEmpty(t),
+	}
+	selectImage := &ButtonImage{
+		Idle: newNineSliceEmpty(t),
+	}
+	textColor := &ButtonTextColor{
+		Idle: color.White,
+	}
+
+	th := &Theme{
+		Button: &ButtonTheme{
+			Image:     buttonImage,
+			TextFace:  loadFont(t),
+			TextColor: textColor,
+		},
+		ComboButton: &ComboButtonTheme{
+			Button: &ButtonTheme{
+				Image: comboImage,
+			},
+		},
+		SelectComboButton: &ComboButtonTheme{
+			Button: &ButtonTheme{
+				Image: selectImage,
+			},
+		},
+	}
+
+	b := NewButton()
+	cb := NewComboButton(ComboButtonOpts.ButtonOpts(ButtonOpts.Text("", nil, nil)))
+	s := NewSelectComboButton(SelectComboButtonOpts.ComboButtonOpts(ComboButtonOpts.ButtonOpts(ButtonOpts.Text("", nil, nil))))
+	c := newThemeContainer(t, th, b, cb, s)
+	render(c, t)
+
+	is.Equal(b.image(), buttonImage)
+	is.Equal(cb.button.image(), comboImage)
+	is.Equal(s.button.button.image(), selectImage)
+	is.Equal(cb.button.resolvedTextColor(), textColor)
+	is.Equal(s.button.button.resolvedTextColor(), textColor)
+}
+
+func TestTheme_ListComboButton(t *testing.T) {
+	is := is.New(t)
+
+	listImage := &ScrollContainerImage{
+		Idle:     newNineSliceEmpty(t),
+		Disabled: newNineSliceEmpty(t),
+		Mask:     newNineSliceEmpty(t),
+	}
+	comboListImage := &ScrollContainerImage{
+		Idle:     newNineSliceEmpty(t),
+		Disabled: newNineSliceEmpty(t),
+		Mask:     newNineSliceEmpty(t),
+	}
+	entryColor := &ListEntryColor{
+		Unselected:                 color.White,
+		Selected:                   color.White,
+		DisabledUnselected:         color.White,
+		DisabledSelected:           color.White,
+		SelectedBackground:         color.White,
+		DisabledSelectedBackground: color.White,
+	}
+
+	th := &Theme{
+		List: &ListTheme{
+			ScrollContainerImage: listImage,
+			SliderTrackImage:     &SliderTrackImage{},
+			SliderHandleImage: &ButtonImage{
+				Idle: newNineSliceEmpty(t),
+			},
+			EntryFace:  loadFont(t),
+			EntryColor: entryColor,
+		},
+		ListComboButton: &ListComboButtonTheme{
+			Button: &ButtonTheme{
+				Image: &ButtonImage{
+					Idle: newNineSliceEmpty(t),
+				},
+			},
+			List: &ListTheme{
+				ScrollContainerImage: comboListImage,
+			},
+		},
+	}
+
+	l := NewListComboButton(
+		ListComboButtonOpts.Text(nil, nil, nil),
+		ListComboButtonOpts.ListOpts(ListOpts.Entries([]interface{}{"first", "second"})),
+		ListComboButtonOpts.EntryLabelFunc(
+			func(e interface{}) string {
+				return e.(string)
+			}, func(e interface{}) string {
+				return e.(string)
+			}))
+	c := newThemeContainer(t, th, l)
+	render(c, t)
+
+	l.list.PreferredSize()
+
+	is.Equal(l.button.button.button.image(), th.ListComboButton.Button.Image)
+	is.Equal(l.list.scrollContainer.image, comboListImage)
+	is.Equal(l.list.entryStyle.color, entryColor)
+}
+
+func TestTheme_TextToolTip(t *testing.T) {
+	is := is.New(t)
+
+	background := newNineSliceEmpty(t)
+	padding := Insets{
+		Left:  5,
+		Right: 5,
+	}
+
+	th := &Theme{
+		Text: &TextTheme{
+			Face:  loadFont(t),
+			Color: color.White,
+		},
+		TextToolTip: &TextToolTipTheme{
+			BackgroundImage: background,
+			Padding:         &padding,
+			Text: &TextTheme{
+				Color: color.Black,
+			},
+		},
+	}
+
+	tt := NewTextToolTip()
+	c := newThemeContainer(t, th, tt)
+	render(c, t)
+
+	is.Equal(tt.container.BackgroundImage, background)
+	is.Equal(tt.layout.padding, padding)
+	is.Equal(tt.text.face(), th.Text.Face)
+	is.Equal(tt.text.color(), color.Black)
+}
+
+func TestTheme_FlipBook(t *testing.T) {
+	is := is.New(t)
+
+	padding := Insets{
+		Top:    10,
+		Bottom: 10,
+	}
+
+	f := NewFlipBook()
+	f.SetPage(newSimpleWidget(20, 30, nil))
+	c := newThemeContainer(t, &Theme{
+		FlipBook: &FlipBookTheme{
+			Padding: &padding,
+		},
+	}, f)
+	render(c, t)
+
+	w, h := f.PreferredSize()
+	is.Equal(w, 20)
+	is.Equal(h, 50)
+}
+
+func TestTheme_Graphic(t *testing.T) {
+	is := is.New(t)
+
+	image := newImageEmptySize(10, 20, t)
+
+	g := NewGraphic()
+	b := NewButton(
+		ButtonOpts.Image(&ButtonImage{
+			Idle: newNineSliceEmpty(t),
+		}),
+		ButtonOpts.TextAndImage("", loadFont(t), nil, &ButtonTextColor{
+			Idle: color.White,
+		}))
+	c := newThemeContainer(t, &Theme{
+		Graphic: &GraphicTheme{
+			Image: image,
+		},
+	}, g, b)
+	render(c, t)
+
+	w, h := g.PreferredSize()
+	is.Equal(w, 10)
+	is.Equal(h, 20)
+
+	i, _ := b.graphic.images()
+	is.True(i == nil)
+}
+
+func newThemeContainer(t *testing.T, th *Theme, children ...PreferredSizeLocateableWidget) *Container {
+	t.Helper()
+
+	c := NewContainer(
+		ContainerOpts.WidgetOpts(WidgetOpts.Theme(th)),
+		ContainerOpts.Layout(NewRowLayout()))
+	for _, ch := range children {
+		c.AddChild(ch)
+	}
+	event.ExecuteDeferred()
+	return c
+}
@@ -171,6 +171,13 @@ func (t *ToolTip) showingState(srcWidget HasWidget, srcX int, srcY int, tipWidge
 			if tipWidget == nil {
 				return t.idleState(), false
 			}
+
+			// tool tips are shown outside of the widget tree, so they inherit the theme of their container
+			if hw, ok := tipWidget.(HasWidget); ok {
+				if cw, ok := t.container.(HasWidget); ok {
+					hw.GetWidget().themeOwner = cw.GetWidget()
+				}
+			}
 		}
 
 		if u, ok := t.contentsCreater.(ToolTipContentsUpdater); ok {
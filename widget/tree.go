@@ -0,0 +1,117 @@
+package widget
+
+// Composite is implemented by widgets that contain other widgets, including widgets that are only used
+// internally, such as a Button's Graphic and Text. All built-in composite widgets implement Composite.
+type Composite interface {
+	HasWidget
+
+	// Children returns the direct children of the widget. Widgets that share the widget's own Widget,
+	// such as the Button inside a Checkbox, are not returned themselves, but their children are. Label
+	// is an exception, it returns its Text.
+	Children() []HasWidget
+}
+
+var (
+	_ Composite = &BindingButton{}
+	_ Composite = &Button{}
+	_ Composite = &Checkbox{}
+	_ Composite = &ComboButton{}
+	_ Composite = &Container{}
+	_ Composite = &FlipBook{}
+	_ Composite = &Label{}
+	_ Composite = &LabeledCheckbox{}
+	_ Composite = &List{}
+	_ Composite = &ListComboButton{}
+	_ Composite = &ScrollContainer{}
+	_ Composite = &SelectComboButton{}
+	_ Composite = &Slider{}
+	_ Composite = &SplitPane{}
+	_ Composite = &StateButton{}
+	_ Composite = &TabBook{}
+	_ Composite = &TextInput{}
+	_ Composite = &TextToolTip{}
+)
+
+// WalkResult specifies how Walk continues after visiting a widget.
+type WalkResult int
+
+// WidgetVisitorFunc is a function that visits widget w. parents are the ancestors of w, starting with
+// the root widget. parents must not be retained after the function returns.
+type WidgetVisitorFunc func(w HasWidget, parents []HasWidget) WalkResult //nolint:golint
+
+const (
+	// WalkContinue continues walking with the widget's children.
+	WalkContinue = WalkResult(iota)
+
+	// WalkSkipChildren continues walking, but skips the widget's children.
+	WalkSkipChildren
+
+	// WalkStop stops walking.
+	WalkStop
+)
+
+// Walk calls f for all widgets in the widget trees rooted at roots, in depth-first order, descending into
+// the children of Composite widgets.
+func Walk(f WidgetVisitorFunc, roots ...HasWidget) {
+	walk(roots, []HasWidget{}, f)
+}
+
+// Parent returns the widget that w's Widget is a child of, which is the widget that w inherits its event
+// queue and theme from. Composite widgets are returned instead of the widgets they wrap, for example,
+// the Checkbox instead of its Button. It returns nil if w has no parent.
+//
+// Parent does not search a widget tree, but the result may differ from the widget whose Children include w:
+// The pages of a TabBook are children of the TabBook, but their parent is the TabBook's FlipBook, and
+// the content of a ComboButton has no parent at all. Use FindParent to search for the latter.
+func Parent(w HasWidget) HasWidget {
+	ww := w.GetWidget()
+	if ww.owner != nil && ww.owner != w {
+		return ww.owner
+	}
+
+	if ww.parent == nil || ww.parent.owner == nil {
+		return nil
+	}
+	return ww.parent.owner
+}
+
+// FindParent returns the widget in the widget trees rooted at roots whose Children include w, or nil if w
+// is not contained in the trees, or if w is one of roots. Unlike Parent, it searches the trees.
+func FindParent(w HasWidget, roots ...HasWidget) HasWidget {
+	var parent HasWidget
+	Walk(func(v HasWidget, parents []HasWidget) WalkResult {
+		if v != w {
+			return WalkContinue
+		}
+
+		if len(parents) > 0 {
+			parent = parents[len(parents)-1]
+		}
+		return WalkStop
+	}, roots...)
+	return parent
+}
+
+// walk walks ws, which are children of parents. It returns false if walking should stop.
+func walk(ws []HasWidget, parents []HasWidget, f WidgetVisitorFunc) bool {
+	for _, w := range ws {
+		if w == nil {
+			continue
+		}
+
+		switch f(w, parents) {
+		case WalkStop:
+			return false
+		case WalkSkipChildren:
+			continue
+		}
+
+		if c, ok := w.(Composite); ok {
+			if !walk(c.Children(), append(parents, w), f) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
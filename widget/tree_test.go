@@ -0,0 +1,128 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestWalk(t *testing.T) {
+	is := is.New(t)
+
+	c := newContainer(t)
+	cb := newLabeledCheckbox(t)
+	c.AddChild(cb)
+
+	visited := []HasWidget{}
+	depths := []int{}
+	Walk(func(w HasWidget, parents []HasWidget) WalkResult {
+		visited = append(visited, w)
+		depths = append(depths, len(parents))
+		return WalkContinue
+	}, c)
+
+	is.Equal(visited[0], c)
+	is.Equal(visited[1], cb)
+	is.Equal(visited[2], cb.checkbox)
+	is.Equal(depths[:3], []int{0, 1, 2})
+	is.True(len(visited) > 3) // checkbox button's graphic, label
+}
+
+func TestWalk_SkipChildren(t *testing.T) {
+	is := is.New(t)
+
+	c := newContainer(t)
+	cb := newLabeledCheckbox(t)
+	c.AddChild(cb)
+	c2 := newContainer(t)
+	c.AddChild(c2)
+
+	visited := []HasWidget{}
+	Walk(func(w HasWidget, parents []HasWidget) WalkResult {
+		visited = append(visited, w)
+		if w == cb {
+			return WalkSkipChildren
+		}
+		return WalkContinue
+	}, c)
+
+	is.Equal(visited, []HasWidget{c, cb, c2})
+}
+
+func TestWalk_Stop(t *testing.T) {
+	is := is.New(t)
+
+	c := newContainer(t)
+	c.AddChild(newContainer(t))
+	c.AddChild(newContainer(t))
+
+	visited := 0
+	Walk(func(w HasWidget, parents []HasWidget) WalkResult {
+		visited++
+		if visited == 2 {
+			return WalkStop
+		}
+		return WalkContinue
+	}, c)
+
+	is.Equal(visited, 2)
+}
+
+func TestFindParent(t *testing.T) {
+	is := is.New(t)
+
+	c := newContainer(t)
+	cb := newLabeledCheckbox(t)
+	c.AddChild(cb)
+
+	is.Equal(FindParent(cb.checkbox, c), cb)
+	is.Equal(FindParent(cb, c), c)
+	is.Equal(FindParent(c, c), nil)
+}
+
+func TestParent(t *testing.T) {
+	is := is.New(t)
+
+	c := newContainer(t)
+	cb := newLabeledCheckbox(t)
+	c.AddChild(cb)
+	l := newLabel(t)
+	c.AddChild(l)
+	s := newSlider(t)
+	c.AddChild(s)
+
+	is.Equal(Parent(c), nil)
+	is.Equal(Parent(cb), c)
+	is.Equal(Parent(cb.checkbox), cb)
+	is.Equal(Parent(cb.checkbox.button), cb.checkbox)
+	is.Equal(Parent(l), c)
+	is.Equal(Parent(l.text), l)
+	is.Equal(Parent(s.handle), s)
+}
+
+func TestLabel_Children(t *testing.T) {
+	is := is.New(t)
+
+	l := newLabel(t)
+
+	is.Equal(l.Children(), []HasWidget{l.text})
+	is.Equal(FindParent(l.text, l), l)
+}
+
+func TestWindow_Children(t *testing.T) {
+	is := is.New(t)
+
+	c := newContainer(t)
+	w := NewWindow(WindowOpts.Contents(c))
+
+	is.Equal(w.Children(), []HasWidget{c})
+	is.Equal(len(NewWindow().Children()), 0)
+}
+
+func TestSlider_Children(t *testing.T) {
+	is := is.New(t)
+
+	s := newSlider(t)
+
+	is.Equal(s.Children(), []HasWidget{s.handle})
+}
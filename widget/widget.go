@@ -2,6 +2,7 @@ package widget
 
 import (
 	"image"
+	"sort"
 
 	"github.com/blizzy78/ebitenui/event"
 	"github.com/blizzy78/ebitenui/input"
@@ -29,31 +30,69 @@ type Widget struct {
 	// the user's perspective, scrolling does not change state, but only the display of that state.
 	Disabled bool
 
+	// ID optionally identifies the widget, so that it can be found in a widget tree using FindWidgetByID.
+	// IDs should be unique within a UI.
+	ID string
+
 	// CursorEnterEvent fires an event with *WidgetCursorEnterEventArgs when the cursor enters the widget's Rect.
-	CursorEnterEvent *event.Event
+	CursorEnterEvent *event.Of[*WidgetCursorEnterEventArgs]
 
 	// CursorExitEvent fires an event with *WidgetCursorExitEventArgs when the cursor exits the widget's Rect.
-	CursorExitEvent *event.Event
+	CursorExitEvent *event.Of[*WidgetCursorExitEventArgs]
 
 	// MouseButtonPressedEvent fires an event with *WidgetMouseButtonPressedEventArgs when a mouse button is pressed
 	// while the cursor is inside the widget's Rect.
-	MouseButtonPressedEvent *event.Event
+	MouseButtonPressedEvent *event.Of[*WidgetMouseButtonPressedEventArgs]
 
 	// MouseButtonReleasedEvent fires an event with *WidgetMouseButtonReleasedEventArgs when a mouse button is released
 	// while the cursor is inside the widget's Rect.
-	MouseButtonReleasedEvent *event.Event
+	MouseButtonReleasedEvent *event.Of[*WidgetMouseButtonReleasedEventArgs]
 
 	// ScrolledEvent fires an event with *WidgetScrolledEventArgs when the mouse wheel is scrolled while
 	// the cursor is inside the widget's Rect.
-	ScrolledEvent *event.Event
-
-	FocusEvent *event.Event
-
-	parent                     *Widget
-	lastUpdateCursorEntered    bool
-	lastUpdateMouseLeftPressed bool
-	mouseLeftPressedInside     bool
-	inputLayer                 *input.Layer
+	ScrolledEvent *event.Of[*WidgetScrolledEventArgs]
+
+	FocusEvent *event.Of[*WidgetFocusEventArgs]
+
+	// ClickedEvent fires an event with *WidgetClickedEventArgs when a mouse button is pressed and released
+	// inside the widget's Rect. Consecutive clicks are counted if they happen within the widget's click
+	// interval and distance.
+	ClickedEvent *event.Of[*WidgetClickedEventArgs]
+
+	// LongPressedEvent fires an event with *WidgetLongPressedEventArgs when a mouse button is pressed inside
+	// the widget's Rect and held for the widget's long-press duration without moving the cursor. Releasing
+	// the button afterwards does not fire ClickedEvent.
+	LongPressedEvent *event.Of[*WidgetLongPressedEventArgs]
+
+	// HoverDwellEvent fires an event with *WidgetHoverDwellEventArgs when the cursor rests inside the
+	// widget's Rect for the widget's hover dwell duration.
+	HoverDwellEvent *event.Of[*WidgetHoverDwellEventArgs]
+
+	// KeyPressedEvent fires an event with *WidgetKeyPressedEventArgs when a key is pressed or repeated while
+	// the widget or one of its descendants is focused.
+	KeyPressedEvent *event.Of[*WidgetKeyPressedEventArgs]
+
+	// KeyReleasedEvent fires an event with *WidgetKeyReleasedEventArgs when a key is released while
+	// the widget or one of its descendants is focused.
+	KeyReleasedEvent *event.Of[*WidgetKeyReleasedEventArgs]
+
+	// CaptureEvent fires an event with the arguments of a propagating event, such as *WidgetMouseButtonPressedEventArgs,
+	// in EventPhaseCapture, before the event is fired for one of the widget's descendants. See EventPropagation.
+	CaptureEvent *event.Of[PropagatingEventArgs]
+
+	gestureConfig           gestureConfig
+	parent                  *Widget
+	owner                   HasWidget
+	container               *Container
+	lastUpdateCursorEntered bool
+	mouseButtonStates       map[ebiten.MouseButton]*mouseButtonState
+	hoverDwell              hoverDwellState
+	inputLayer              *input.Layer
+	eventQueue              *event.Queue
+	actionMap               *input.ActionMap
+	tags                    map[string]struct{}
+	theme                   *Theme
+	themeOwner              *Widget
 }
 
 // WidgetOpt is a function that configures w.
@@ -74,6 +113,13 @@ type Focuser interface {
 	Focus(focused bool)
 }
 
+// KeyConsumer may be implemented by concrete widget types that consume key presses while they are focused.
+// Key chords consumed by the focused widget take precedence over keyboard shortcuts.
+type KeyConsumer interface {
+	// ConsumesKeyChord returns whether the widget consumes key chord c.
+	ConsumesKeyChord(c input.KeyChord) bool
+}
+
 // RenderFunc is a function that renders a widget onto screen. def may be called to defer
 // additional rendering.
 type RenderFunc func(screen *ebiten.Image, def DeferredRenderFunc)
@@ -99,6 +145,8 @@ type WidgetCursorExitEventArgs struct { //nolint:golint
 // WidgetMouseButtonPressedEventArgs are the arguments for mouse button press events.
 type WidgetMouseButtonPressedEventArgs struct { //nolint:golint
 	Widget *Widget
+	EventPropagation
+
 	Button ebiten.MouseButton
 
 	// OffsetX is the x offset relative to the widget's Rect.
@@ -111,6 +159,8 @@ type WidgetMouseButtonPressedEventArgs struct { //nolint:golint
 // WidgetMouseButtonReleasedEventArgs are the arguments for mouse button release events.
 type WidgetMouseButtonReleasedEventArgs struct { //nolint:golint
 	Widget *Widget
+	EventPropagation
+
 	Button ebiten.MouseButton
 
 	// Inside specifies whether the button has been released inside the widget's Rect.
@@ -126,8 +176,10 @@ type WidgetMouseButtonReleasedEventArgs struct { //nolint:golint
 // WidgetScrolledEventArgs are the arguments for mouse wheel scroll events.
 type WidgetScrolledEventArgs struct { //nolint:golint
 	Widget *Widget
-	X      float64
-	Y      float64
+	EventPropagation
+
+	X float64
+	Y float64
 }
 
 type WidgetFocusEventArgs struct { //nolint:golint
@@ -135,6 +187,27 @@ type WidgetFocusEventArgs struct { //nolint:golint
 	Focused bool
 }
 
+// WidgetKeyPressedEventArgs are the arguments for key press events.
+type WidgetKeyPressedEventArgs struct { //nolint:golint
+	Widget *Widget
+	EventPropagation
+
+	Key       ebiten.Key
+	Modifiers input.Modifiers
+
+	// Repeat specifies whether the key is being held and has been repeated.
+	Repeat bool
+}
+
+// WidgetKeyReleasedEventArgs are the arguments for key release events.
+type WidgetKeyReleasedEventArgs struct { //nolint:golint
+	Widget *Widget
+	EventPropagation
+
+	Key       ebiten.Key
+	Modifiers input.Modifiers
+}
+
 // WidgetCursorEnterHandlerFunc is a function that handles cursor enter events.
 type WidgetCursorEnterHandlerFunc func(args *WidgetCursorEnterEventArgs) //nolint:golint
 
@@ -150,25 +223,54 @@ type WidgetMouseButtonReleasedHandlerFunc func(args *WidgetMouseButtonReleasedEv
 // WidgetScrolledHandlerFunc is a function that handles mouse wheel scroll events.
 type WidgetScrolledHandlerFunc func(args *WidgetScrolledEventArgs) //nolint:golint
 
+// WidgetKeyPressedHandlerFunc is a function that handles key press events.
+type WidgetKeyPressedHandlerFunc func(args *WidgetKeyPressedEventArgs) //nolint:golint
+
+// WidgetKeyReleasedHandlerFunc is a function that handles key release events.
+type WidgetKeyReleasedHandlerFunc func(args *WidgetKeyReleasedEventArgs) //nolint:golint
+
 type WidgetOptions struct { //nolint:golint
 }
 
 // WidgetOpts contains functions that configure a Widget.
 var WidgetOpts WidgetOptions
 
-var deferredRenders []RenderFunc
+// renderQueue is a queue of deferred render functions.
+type renderQueue struct {
+	renders []RenderFunc
+}
+
+var mouseButtons = []ebiten.MouseButton{ebiten.MouseButtonLeft, ebiten.MouseButtonMiddle, ebiten.MouseButtonRight}
+
+// gamepadTriggerScrollSpeed is the amount of mouse wheel movement per frame that fully pressed gamepad
+// triggers correspond to.
+const gamepadTriggerScrollSpeed = 0.2
 
 // NewWidget constructs a new Widget configured with opts.
 func NewWidget(opts ...WidgetOpt) *Widget {
 	w := &Widget{
-		CursorEnterEvent:         &event.Event{},
-		CursorExitEvent:          &event.Event{},
-		MouseButtonPressedEvent:  &event.Event{},
-		MouseButtonReleasedEvent: &event.Event{},
-		ScrolledEvent:            &event.Event{},
-		FocusEvent:               &event.Event{},
+		CursorEnterEvent:         &event.Of[*WidgetCursorEnterEventArgs]{},
+		CursorExitEvent:          &event.Of[*WidgetCursorExitEventArgs]{},
+		MouseButtonPressedEvent:  &event.Of[*WidgetMouseButtonPressedEventArgs]{},
+		MouseButtonReleasedEvent: &event.Of[*WidgetMouseButtonReleasedEventArgs]{},
+		ScrolledEvent:            &event.Of[*WidgetScrolledEventArgs]{},
+		FocusEvent:               &event.Of[*WidgetFocusEventArgs]{},
+		ClickedEvent:             &event.Of[*WidgetClickedEventArgs]{},
+		LongPressedEvent:         &event.Of[*WidgetLongPressedEventArgs]{},
+		HoverDwellEvent:          &event.Of[*WidgetHoverDwellEventArgs]{},
+		KeyPressedEvent:          &event.Of[*WidgetKeyPressedEventArgs]{},
+		KeyReleasedEvent:         &event.Of[*WidgetKeyReleasedEventArgs]{},
+		CaptureEvent:             &event.Of[PropagatingEventArgs]{},
+
+		gestureConfig: defaultGestureConfig,
+
+		mouseButtonStates: map[ebiten.MouseButton]*mouseButtonState{},
 	}
 
+	setEventQueueFunc(w, w.CursorEnterEvent, w.CursorExitEvent, w.MouseButtonPressedEvent, w.MouseButtonReleasedEvent,
+		w.ScrolledEvent, w.FocusEvent, w.ClickedEvent, w.LongPressedEvent, w.HoverDwellEvent, w.KeyPressedEvent,
+		w.KeyReleasedEvent, w.CaptureEvent)
+
 	for _, o := range opts {
 		o(w)
 	}
@@ -176,6 +278,13 @@ func NewWidget(opts ...WidgetOpt) *Widget {
 	return w
 }
 
+// setEventQueueFunc configures events es to put fired events into the event queue of the UI that w belongs to.
+func setEventQueueFunc(w *Widget, es ...interface{ SetQueueFunc(f event.QueueFunc) }) {
+	for _, e := range es {
+		e.SetQueueFunc(w.EventQueue)
+	}
+}
+
 // WithLayoutData configures a Widget with layout data ld.
 func (o WidgetOptions) LayoutData(ld interface{}) WidgetOpt {
 	return func(w *Widget) {
@@ -183,48 +292,69 @@ func (o WidgetOptions) LayoutData(ld interface{}) WidgetOpt {
 	}
 }
 
+// ID configures a Widget with ID id.
+func (o WidgetOptions) ID(id string) WidgetOpt {
+	return func(w *Widget) {
+		w.ID = id
+	}
+}
+
+// Tags configures a Widget with tags. Tags can be used to find groups of widgets in a widget tree
+// using FindWidgetsByTag.
+func (o WidgetOptions) Tags(tags ...string) WidgetOpt {
+	return func(w *Widget) {
+		for _, t := range tags {
+			w.AddTag(t)
+		}
+	}
+}
+
 // WithCursorEnterHandler configures a Widget with cursor enter event handler f.
 func (o WidgetOptions) CursorEnterHandler(f WidgetCursorEnterHandlerFunc) WidgetOpt {
 	return func(w *Widget) {
-		w.CursorEnterEvent.AddHandler(func(args interface{}) {
-			f(args.(*WidgetCursorEnterEventArgs))
-		})
+		w.CursorEnterEvent.AddHandler(f)
 	}
 }
 
 // WithCursorExitHandler configures a Widget with cursor exit event handler f.
 func (o WidgetOptions) CursorExitHandler(f WidgetCursorExitHandlerFunc) WidgetOpt {
 	return func(w *Widget) {
-		w.CursorExitEvent.AddHandler(func(args interface{}) {
-			f(args.(*WidgetCursorExitEventArgs))
-		})
+		w.CursorExitEvent.AddHandler(f)
 	}
 }
 
 // WithMouseButtonPressedHandler configures a Widget with mouse button press event handler f.
 func (o WidgetOptions) MouseButtonPressedHandler(f WidgetMouseButtonPressedHandlerFunc) WidgetOpt {
 	return func(w *Widget) {
-		w.MouseButtonPressedEvent.AddHandler(func(args interface{}) {
-			f(args.(*WidgetMouseButtonPressedEventArgs))
-		})
+		w.MouseButtonPressedEvent.AddHandler(f)
 	}
 }
 
 // WithMouseButtonReleasedHandler configures a Widget with mouse button release event handler f.
 func (o WidgetOptions) MouseButtonReleasedHandler(f WidgetMouseButtonReleasedHandlerFunc) WidgetOpt {
 	return func(w *Widget) {
-		w.MouseButtonReleasedEvent.AddHandler(func(args interface{}) {
-			f(args.(*WidgetMouseButtonReleasedEventArgs))
-		})
+		w.MouseButtonReleasedEvent.AddHandler(f)
 	}
 }
 
 // WithScrolledHandler configures a Widget with mouse wheel scroll event handler f.
 func (o WidgetOptions) ScrolledHandler(f WidgetScrolledHandlerFunc) WidgetOpt {
 	return func(w *Widget) {
-		w.ScrolledEvent.AddHandler(func(args interface{}) {
-			f(args.(*WidgetScrolledEventArgs))
-		})
+		w.ScrolledEvent.AddHandler(f)
+	}
+}
+
+// KeyPressedHandler configures a Widget with key press event handler f.
+func (o WidgetOptions) KeyPressedHandler(f WidgetKeyPressedHandlerFunc) WidgetOpt {
+	return func(w *Widget) {
+		w.KeyPressedEvent.AddHandler(f)
+	}
+}
+
+// KeyReleasedHandler configures a Widget with key release event handler f.
+func (o WidgetOptions) KeyReleasedHandler(f WidgetKeyReleasedHandlerFunc) WidgetOpt {
+	return func(w *Widget) {
+		w.KeyReleasedEvent.AddHandler(f)
 	}
 }
 
@@ -252,6 +382,32 @@ func (w *Widget) EffectiveInputLayer() *input.Layer {
 	return l
 }
 
+// requestParentRelayout is called when w's preferred size has changed. It marks the layout of the nearest
+// container w is contained in as dirty, and drops the cached preferred sizes of the containers above w.
+func (w *Widget) requestParentRelayout() {
+	if c := w.parentContainer(); c != nil {
+		c.layoutDirty = true
+		c.invalidatePreferredSize()
+	}
+}
+
+// parentLayoutDirty marks the layout of the nearest container w is contained in as dirty.
+func (w *Widget) parentLayoutDirty() {
+	if c := w.parentContainer(); c != nil {
+		c.layoutDirty = true
+	}
+}
+
+// parentContainer returns the nearest container w is contained in, or nil if there is none.
+func (w *Widget) parentContainer() *Container {
+	for p := w.parent; p != nil; p = p.parent {
+		if p.container != nil {
+			return p.container
+		}
+	}
+	return nil
+}
+
 // Render renders w onto screen. Since Widget is only an abstraction, it does not actually draw
 // anything, but it is still responsible for firing events. Concrete widget implementations should
 // always call this method first before rendering themselves.
@@ -280,39 +436,16 @@ func (w *Widget) fireEvents() {
 		w.lastUpdateCursorEntered = entered
 	}
 
-	if inside && input.MouseButtonJustPressedLayer(ebiten.MouseButtonLeft, layer) {
-		w.lastUpdateMouseLeftPressed = true
-		w.mouseLeftPressedInside = inside
-
-		off := p.Sub(w.Rect.Min)
-		w.MouseButtonPressedEvent.Fire(&WidgetMouseButtonPressedEventArgs{
-			Widget:  w,
-			Button:  ebiten.MouseButtonLeft,
-			OffsetX: off.X,
-			OffsetY: off.Y,
-		})
+	for _, b := range mouseButtons {
+		w.fireMouseButtonEvents(b, p, inside, layer)
 	}
 
-	if w.lastUpdateMouseLeftPressed && !input.MouseButtonPressedLayer(ebiten.MouseButtonLeft, layer) {
-		w.lastUpdateMouseLeftPressed = false
-
-		off := p.Sub(w.Rect.Min)
-		w.MouseButtonReleasedEvent.Fire(&WidgetMouseButtonReleasedEventArgs{
-			Widget:  w,
-			Button:  ebiten.MouseButtonLeft,
-			Inside:  inside,
-			OffsetX: off.X,
-			OffsetY: off.Y,
-		})
-	}
+	w.fireHoverDwellEvent(p, entered)
 
 	scrollX, scrollY := input.WheelLayer(layer)
+	scrollY -= input.GamepadTriggerScrollLayer(layer) * gamepadTriggerScrollSpeed
 	if inside && (scrollX != 0 || scrollY != 0) {
-		w.ScrolledEvent.Fire(&WidgetScrolledEventArgs{
-			Widget: w,
-			X:      scrollX,
-			Y:      scrollY,
-		})
+		w.fireScrolledEvent(scrollX, scrollY)
 	}
 }
 
@@ -327,10 +460,76 @@ func (w *Widget) ElevateToNewInputLayer(l *input.Layer) {
 	w.inputLayer = l
 }
 
+// Parent returns w's parent Widget. See also the Parent function, which returns the parent widget itself.
 func (w *Widget) Parent() *Widget {
 	return w.parent
 }
 
+// AddTag adds tag to w.
+func (w *Widget) AddTag(tag string) {
+	if w.tags == nil {
+		w.tags = map[string]struct{}{}
+	}
+	w.tags[tag] = struct{}{}
+}
+
+// RemoveTag removes tag from w.
+func (w *Widget) RemoveTag(tag string) {
+	delete(w.tags, tag)
+}
+
+// HasTag returns whether w has tag.
+func (w *Widget) HasTag(tag string) bool {
+	_, ok := w.tags[tag]
+	return ok
+}
+
+// Tags returns w's tags, in ascending order.
+func (w *Widget) Tags() []string {
+	tags := make([]string, 0, len(w.tags))
+	for t := range w.tags {
+		tags = append(tags, t)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// SetEventQueue sets the event queue of w to q. Only w itself is changed, but descendants of w that have
+// no event queue of their own use q as well, since EventQueue walks up to the nearest widget that has one.
+// This is usually called by the UI for its root widgets, so that events fired by widgets of one UI do not
+// interfere with other UIs.
+func (w *Widget) SetEventQueue(q *event.Queue) {
+	w.eventQueue = q
+}
+
+// EventQueue returns the event queue of the UI that w belongs to, by walking up to w's root widget.
+// It returns nil if w does not belong to a UI, in which case the current event queue is used.
+func (w *Widget) EventQueue() *event.Queue {
+	for ; w != nil; w = w.parent {
+		if w.eventQueue != nil {
+			return w.eventQueue
+		}
+	}
+	return nil
+}
+
+// SetActionMap sets the action map of w to m. Like SetEventQueue, only w itself is changed, but descendants
+// of w that have no action map of their own use m as well.
+func (w *Widget) SetActionMap(m *input.ActionMap) {
+	w.actionMap = m
+}
+
+// ActionMap returns the action map that built-in widgets use to map user input to actions, by walking up
+// to the nearest widget that has one. It returns input.Actions if no such widget exists.
+func (w *Widget) ActionMap() *input.ActionMap {
+	for ; w != nil; w = w.parent {
+		if w.actionMap != nil {
+			return w.actionMap
+		}
+	}
+	return input.Actions
+}
+
 func WidgetFireFocusEvent(w *Widget, focused bool) { //nolint:golint
 	w.FocusEvent.Fire(&WidgetFocusEventArgs{
 		Widget:  w,
@@ -338,28 +537,39 @@ func WidgetFireFocusEvent(w *Widget, focused bool) { //nolint:golint
 	})
 }
 
+// WidgetFireKeyPressedEvent fires a key press event for key k, with modifiers m pressed, on focused widget w.
+// The event then propagates through w's parents, as described in EventPropagation.
+func WidgetFireKeyPressedEvent(w *Widget, k ebiten.Key, m input.Modifiers, repeat bool) { //nolint:golint
+	w.fireKeyPressedEvent(k, m, repeat)
+}
+
+// WidgetFireKeyReleasedEvent fires a key release event for key k, with modifiers m pressed, on focused widget w.
+// The event then propagates through w's parents, as described in EventPropagation.
+func WidgetFireKeyReleasedEvent(w *Widget, k ebiten.Key, m input.Modifiers) { //nolint:golint
+	w.fireKeyReleasedEvent(k, m)
+}
+
 // RenderWithDeferred renders r to screen. This function should not be called directly.
+//
+// Each call uses its own deferred render queue, so that multiple UIs do not interfere with each other.
 func RenderWithDeferred(screen *ebiten.Image, rs []Renderer) {
+	q := renderQueue{}
 	for _, r := range rs {
-		appendToDeferredRenderQueue(r.Render)
+		q.add(r.Render)
 	}
 
-	renderDeferredRenderQueue(screen)
+	q.render(screen)
 }
 
-func renderDeferredRenderQueue(screen *ebiten.Image) {
-	defer func(d []RenderFunc) {
-		deferredRenders = d[:0]
-	}(deferredRenders)
-
-	for len(deferredRenders) > 0 {
-		r := deferredRenders[0]
-		deferredRenders = deferredRenders[1:]
+func (q *renderQueue) render(screen *ebiten.Image) {
+	for len(q.renders) > 0 {
+		r := q.renders[0]
+		q.renders = q.renders[1:]
 
-		r(screen, appendToDeferredRenderQueue)
+		r(screen, q.add)
 	}
 }
 
-func appendToDeferredRenderQueue(r RenderFunc) {
-	deferredRenders = append(deferredRenders, r)
+func (q *renderQueue) add(r RenderFunc) {
+	q.renders = append(q.renders, r)
 }
@@ -0,0 +1,151 @@
+package widget
+
+import (
+	"image"
+	"testing"
+
+	"github.com/blizzy78/ebitenui/event"
+	"github.com/blizzy78/ebitenui/input"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/matryer/is"
+)
+
+func TestWidget_MouseButtonPressedEvent(t *testing.T) {
+	is := is.New(t)
+
+	pressed := []ebiten.MouseButton{}
+
+	w := newWidget(t,
+		WidgetOpts.MouseButtonPressedHandler(func(args *WidgetMouseButtonPressedEventArgs) {
+			pressed = append(pressed, args.Button)
+			is.Equal(args.OffsetX, 5)
+			is.Equal(args.OffsetY, 6)
+		}))
+	w.Rect = image.Rect(10, 10, 20, 20)
+
+	for _, b := range []ebiten.MouseButton{ebiten.MouseButtonLeft, ebiten.MouseButtonMiddle, ebiten.MouseButtonRight} {
+		mouseButtonInput(w, b, true, 15, 16, t)
+		mouseButtonInput(w, b, false, 15, 16, t)
+	}
+
+	is.Equal(pressed, []ebiten.MouseButton{ebiten.MouseButtonLeft, ebiten.MouseButtonMiddle, ebiten.MouseButtonRight})
+}
+
+func TestWidget_MouseButtonPressedEvent_Outside(t *testing.T) {
+	is := is.New(t)
+
+	var eventArgs *WidgetMouseButtonPressedEventArgs
+
+	w := newWidget(t,
+		WidgetOpts.MouseButtonPressedHandler(func(args *WidgetMouseButtonPressedEventArgs) {
+			eventArgs = args
+		}))
+	w.Rect = image.Rect(10, 10, 20, 20)
+
+	mouseButtonInput(w, ebiten.MouseButtonRight, true, 5, 5, t)
+	mouseButtonInput(w, ebiten.MouseButtonRight, false, 5, 5, t)
+
+	is.True(eventArgs == nil)
+}
+
+func TestWidget_MouseButtonReleasedEvent_PerButton(t *testing.T) {
+	is := is.New(t)
+
+	var eventArgs *WidgetMouseButtonReleasedEventArgs
+
+	w := newWidget(t,
+		WidgetOpts.MouseButtonReleasedHandler(func(args *WidgetMouseButtonReleasedEventArgs) {
+			eventArgs = args
+		}))
+	w.Rect = image.Rect(10, 10, 20, 20)
+
+	mouseButtonInput(w, ebiten.MouseButtonLeft, true, 15, 15, t)
+	mouseButtonInput(w, ebiten.MouseButtonRight, true, 15, 15, t)
+
+	mouseButtonInput(w, ebiten.MouseButtonRight, false, 25, 25, t)
+	is.True(eventArgs != nil)
+	is.Equal(eventArgs.Button, ebiten.MouseButtonRight)
+	is.Equal(eventArgs.Inside, false)
+
+	eventArgs = nil
+	mouseButtonInput(w, ebiten.MouseButtonLeft, false, 15, 15, t)
+	is.True(eventArgs != nil)
+	is.Equal(eventArgs.Button, ebiten.MouseButtonLeft)
+	is.True(eventArgs.Inside)
+}
+
+func TestWidgetFireKeyPressedEvent_Bubbles(t *testing.T) {
+	is := is.New(t)
+
+	fired := []*WidgetKeyPressedEventArgs{}
+	handler := WidgetOpts.KeyPressedHandler(func(args *WidgetKeyPressedEventArgs) {
+		fired = append(fired, args)
+	})
+
+	c := newContainer(t, ContainerOpts.WidgetOpts(handler))
+	b := NewButton(ButtonOpts.WidgetOpts(handler))
+	c.AddChild(b)
+	event.ExecuteDeferred()
+
+	WidgetFireKeyPressedEvent(b.GetWidget(), ebiten.KeyA, input.ModifierShift, true)
+	event.ExecuteDeferred()
+
+	is.Equal(len(fired), 2)
+	is.Equal(fired[0].Widget, b.GetWidget())
+	is.Equal(fired[1].Widget, c.GetWidget())
+	for _, args := range fired {
+		is.Equal(args.Target, b.GetWidget())
+		is.Equal(args.Key, ebiten.KeyA)
+		is.Equal(args.Modifiers, input.ModifierShift)
+		is.True(args.Repeat)
+	}
+}
+
+func TestWidgetFireKeyReleasedEvent_Bubbles(t *testing.T) {
+	is := is.New(t)
+
+	fired := []*Widget{}
+	handler := WidgetOpts.KeyReleasedHandler(func(args *WidgetKeyReleasedEventArgs) {
+		is.Equal(args.Key, ebiten.KeyB)
+		fired = append(fired, args.Widget)
+	})
+
+	c := newContainer(t, ContainerOpts.WidgetOpts(handler))
+	b := NewButton(ButtonOpts.WidgetOpts(handler))
+	c.AddChild(b)
+	event.ExecuteDeferred()
+
+	WidgetFireKeyReleasedEvent(b.GetWidget(), ebiten.KeyB, 0)
+	event.ExecuteDeferred()
+
+	is.Equal(fired, []*Widget{b.GetWidget(), c.GetWidget()})
+}
+
+func TestWidget_EventQueue(t *testing.T) {
+	is := is.New(t)
+
+	pressed := 0
+	c := newContainer(t)
+	b := NewButton(ButtonOpts.WidgetOpts(WidgetOpts.KeyPressedHandler(func(args *WidgetKeyPressedEventArgs) {
+		pressed++
+	})))
+	c.AddChild(b)
+	event.ExecuteDeferred()
+
+	q := &event.Queue{}
+	c.GetWidget().SetEventQueue(q)
+	is.Equal(b.GetWidget().EventQueue(), q)
+
+	WidgetFireKeyPressedEvent(b.GetWidget(), ebiten.KeyA, 0, false)
+	event.ExecuteDeferred()
+	is.Equal(pressed, 0)
+
+	q.Execute()
+	is.Equal(pressed, 1)
+}
+
+func newWidget(t *testing.T, opts ...WidgetOpt) *Widget {
+	t.Helper()
+	return NewWidget(opts...)
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/blizzy78/ebitenui/event"
 	"github.com/blizzy78/ebitenui/image"
+	"github.com/blizzy78/ebitenui/input"
+	internalinput "github.com/blizzy78/ebitenui/internal/input"
 
 	"github.com/golang/freetype/truetype"
 	"github.com/hajimehoshi/ebiten/v2"
@@ -20,6 +22,8 @@ type simpleWidget struct {
 	preferredHeight int
 }
 
+var fakeInput = &input.FakeInputSource{}
+
 var loadFontOnce sync.Once
 var fontFace2 font.Face
 
@@ -43,6 +47,21 @@ func (s *simpleWidget) SetLocation(rect img.Rectangle) {
 	s.widget.Rect = rect
 }
 
+// heightForWidthWidget is a widget that keeps its area constant, making its height depend on its width.
+type heightForWidthWidget struct {
+	simpleWidget
+}
+
+func newHeightForWidthWidget(preferredWidth int, preferredHeight int, ld interface{}) *heightForWidthWidget {
+	return &heightForWidthWidget{
+		simpleWidget: *newSimpleWidget(preferredWidth, preferredHeight, ld),
+	}
+}
+
+func (h *heightForWidthWidget) PreferredHeightForWidth(width int) int {
+	return h.preferredWidth * h.preferredHeight / width
+}
+
 func loadFont(t *testing.T) font.Face {
 	t.Helper()
 
@@ -73,6 +92,12 @@ func newImageEmpty(t *testing.T) *ebiten.Image {
 
 func newImageEmptySize(width int, height int, t *testing.T) *ebiten.Image {
 	t.Helper()
+	if width < 1 {
+		width = 1
+	}
+	if height < 1 {
+		height = 1
+	}
 	return ebiten.NewImage(width, height)
 }
 
@@ -114,10 +139,71 @@ func leftMouseButtonRelease(w HasWidget, t *testing.T) {
 	event.ExecuteDeferred()
 }
 
+// mouseButtonInput simulates the user pressing or releasing mouse button b with the cursor at x,y,
+// then lets w fire its events.
+func mouseButtonInput(w *Widget, b ebiten.MouseButton, pressed bool, x int, y int, t *testing.T) {
+	t.Helper()
+
+	fakeInput.SetCursorPosition(x, y)
+	fakeInput.SetMouseButton(b, pressed)
+	inputFrame(t)
+
+	w.fireEvents()
+	event.ExecuteDeferred()
+}
+
+// cursorInput simulates the user moving the cursor to x,y, then lets w fire its events.
+func cursorInput(w *Widget, x int, y int, t *testing.T) {
+	t.Helper()
+
+	fakeInput.SetCursorPosition(x, y)
+	inputFrame(t)
+
+	w.fireEvents()
+	event.ExecuteDeferred()
+}
+
+// touchInput simulates the touches ts for a single frame.
+func touchInput(t *testing.T, ts ...input.Touch) {
+	t.Helper()
+
+	fakeInput.SetTouches(ts...)
+	inputFrame(t)
+}
+
+// gamepadInput simulates the gamepads gs for a single frame.
+func gamepadInput(t *testing.T, gs ...input.Gamepad) {
+	t.Helper()
+
+	fakeInput.SetGamepads(gs...)
+	inputFrame(t)
+}
+
+// keyInput simulates the user pressing key k for a single frame while r renders, then releasing it.
+func keyInput(r Renderer, k ebiten.Key, t *testing.T) {
+	t.Helper()
+
+	fakeInput.SetKey(k, true)
+	inputFrame(t)
+	render(r, t)
+
+	fakeInput.SetKey(k, false)
+	inputFrame(t)
+}
+
+// inputFrame makes the input system read the current state of fakeInput, as the UI would do for a new frame.
+func inputFrame(t *testing.T) {
+	t.Helper()
+
+	internalinput.AfterDraw()
+	internalinput.Update(fakeInput)
+	internalinput.Draw()
+}
+
 func render(r Renderer, t *testing.T) {
 	t.Helper()
 
-	screen := ebiten.NewImage(0, 0)
+	screen := ebiten.NewImage(1, 1)
 	RenderWithDeferred(screen, []Renderer{r})
 	event.ExecuteDeferred()
 }
@@ -3,6 +3,7 @@ package widget
 import (
 	"image"
 
+	"github.com/blizzy78/ebitenui/event"
 	"github.com/blizzy78/ebitenui/input"
 	"github.com/hajimehoshi/ebiten/v2"
 )
@@ -42,6 +43,51 @@ func (o WindowOptions) Modal() WindowOpt {
 	}
 }
 
+// Contents returns w's contents.
+func (w *Window) Contents() *Container {
+	return w.contents
+}
+
+// GetWidget implements HasWidget. It returns the widget of w's contents.
+func (w *Window) GetWidget() *Widget {
+	return w.contents.GetWidget()
+}
+
+// PreferredSize implements PreferredSizer. It returns the preferred size of w's contents.
+func (w *Window) PreferredSize() (int, int) {
+	return w.contents.PreferredSize()
+}
+
+// Children returns w's contents, so that windows can be walked like Composite widgets, for example
+// using Walk(f, w.Children()...).
+func (w *Window) Children() []HasWidget {
+	if w.contents == nil {
+		return nil
+	}
+	return []HasWidget{w.contents}
+}
+
+// SetEventQueue sets the event queue of w's contents to q. This is usually called by the UI.
+func (w *Window) SetEventQueue(q *event.Queue) {
+	if w.contents != nil {
+		w.contents.GetWidget().SetEventQueue(q)
+	}
+}
+
+// SetActionMap sets the action map of w's contents to m. This is usually called by the UI.
+func (w *Window) SetActionMap(m *input.ActionMap) {
+	if w.contents != nil {
+		w.contents.GetWidget().SetActionMap(m)
+	}
+}
+
+// SetTheme sets the theme of w's contents to t. This is usually called by the UI.
+func (w *Window) SetTheme(t *Theme) {
+	if w.contents != nil {
+		setTheme(w.contents, t)
+	}
+}
+
 func (w *Window) SetLocation(rect image.Rectangle) {
 	w.contents.SetLocation(rect)
 }
@@ -62,5 +108,13 @@ func (w *Window) SetupInputLayer(def input.DeferredSetupInputLayerFunc) {
 }
 
 func (w *Window) Render(screen *ebiten.Image, def DeferredRenderFunc) {
+	cw := w.contents.GetWidget()
+
+	if w.contents.BackgroundImage == nil {
+		if t := themeSection(cw, func(t *Theme) *WindowTheme { return t.Window }); t != nil && t.BackgroundImage != nil {
+			t.BackgroundImage.Draw(screen, cw.Rect.Dx(), cw.Rect.Dy(), cw.drawImageOptions)
+		}
+	}
+
 	w.contents.Render(screen, def)
 }